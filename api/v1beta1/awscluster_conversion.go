@@ -54,6 +54,12 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	restoreControlPlaneLoadBalancerStatus(&restored.Status.Network.SecondaryAPIServerELB, &dst.Status.Network.SecondaryAPIServerELB)
 
 	dst.Spec.S3Bucket = restored.Spec.S3Bucket
+	dst.Spec.KMSKey = restored.Spec.KMSKey
+	dst.Status.KMSKey = restored.Status.KMSKey
+	dst.Spec.RolesAnywhere = restored.Spec.RolesAnywhere
+	dst.Status.RolesAnywhere = restored.Status.RolesAnywhere
+	dst.Spec.Karpenter = restored.Spec.Karpenter
+	dst.Status.Karpenter = restored.Status.Karpenter
 	if restored.Status.Bastion != nil {
 		if dst.Status.Bastion == nil {
 			dst.Status.Bastion = &infrav1.Instance{}
@@ -64,6 +70,7 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Bastion.PrivateDNSName = restored.Status.Bastion.PrivateDNSName
 		dst.Status.Bastion.PublicIPOnLaunch = restored.Status.Bastion.PublicIPOnLaunch
 		dst.Status.Bastion.NetworkInterfaceType = restored.Status.Bastion.NetworkInterfaceType
+		dst.Status.Bastion.AdditionalNetworkInterfaces = restored.Status.Bastion.AdditionalNetworkInterfaces
 		dst.Status.Bastion.AssignPrimaryIPv6 = restored.Status.Bastion.AssignPrimaryIPv6
 		dst.Status.Bastion.CapacityReservationID = restored.Status.Bastion.CapacityReservationID
 		dst.Status.Bastion.MarketType = restored.Status.Bastion.MarketType
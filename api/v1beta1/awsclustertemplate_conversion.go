@@ -38,6 +38,9 @@ func (r *AWSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+	dst.Spec.Template.Spec.KMSKey = restored.Spec.Template.Spec.KMSKey
+	dst.Spec.Template.Spec.RolesAnywhere = restored.Spec.Template.Spec.RolesAnywhere
+	dst.Spec.Template.Spec.Karpenter = restored.Spec.Template.Spec.Karpenter
 
 	return nil
 }
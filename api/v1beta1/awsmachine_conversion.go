@@ -48,6 +48,7 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.HostAffinity = restored.Spec.HostAffinity
 	dst.Spec.CapacityReservationPreference = restored.Spec.CapacityReservationPreference
 	dst.Spec.NetworkInterfaceType = restored.Spec.NetworkInterfaceType
+	dst.Spec.AdditionalNetworkInterfaces = restored.Spec.AdditionalNetworkInterfaces
 	dst.Spec.AssignPrimaryIPv6 = restored.Spec.AssignPrimaryIPv6
 	dst.Spec.CPUOptions = restored.Spec.CPUOptions
 	if restored.Spec.DynamicHostAllocation != nil {
@@ -65,7 +66,12 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 		}
 	}
 
+	dst.Spec.ElasticIPClaim = restored.Spec.ElasticIPClaim
+	dst.Spec.RolesAnywhere = restored.Spec.RolesAnywhere
+
 	dst.Status.DedicatedHost = restored.Status.DedicatedHost
+	dst.Status.ElasticIPClaim = restored.Status.ElasticIPClaim
+	dst.Status.RolesAnywhere = restored.Status.RolesAnywhere
 	return nil
 }
 
@@ -121,6 +127,7 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.HostAffinity = restored.Spec.Template.Spec.HostAffinity
 	dst.Spec.Template.Spec.CapacityReservationPreference = restored.Spec.Template.Spec.CapacityReservationPreference
 	dst.Spec.Template.Spec.NetworkInterfaceType = restored.Spec.Template.Spec.NetworkInterfaceType
+	dst.Spec.Template.Spec.AdditionalNetworkInterfaces = restored.Spec.Template.Spec.AdditionalNetworkInterfaces
 	dst.Spec.Template.Spec.AssignPrimaryIPv6 = restored.Spec.Template.Spec.AssignPrimaryIPv6
 	dst.Spec.Template.Spec.CPUOptions = restored.Spec.Template.Spec.CPUOptions
 	if restored.Spec.Template.Spec.DynamicHostAllocation != nil {
@@ -137,6 +144,8 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 			dst.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder = restored.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder
 		}
 	}
+	dst.Spec.Template.Spec.ElasticIPClaim = restored.Spec.Template.Spec.ElasticIPClaim
+	dst.Spec.Template.Spec.RolesAnywhere = restored.Spec.Template.Spec.RolesAnywhere
 
 	// Restore Status fields that don't exist in v1beta1.
 	dst.Status.NodeInfo = restored.Status.NodeInfo
@@ -111,6 +111,23 @@ type AWSClusterSpec struct {
 	// BootstrapFormatIgnition feature flag to be enabled).
 	// +optional
 	S3Bucket *S3Bucket `json:"s3Bucket,omitempty"`
+
+	// KMSKey, when set, makes the provider create a customer managed KMS key for the cluster,
+	// used by default to encrypt EBS volumes and bootstrap data secrets.
+	// The key is scheduled for deletion when the cluster is deleted.
+	// +optional
+	KMSKey *KMSKeySpec `json:"kmsKey,omitempty"`
+
+	// RolesAnywhere, when set, makes the provider create an IAM Roles Anywhere trust anchor trusting
+	// a CA dedicated to IAM Roles Anywhere, stored in the <cluster-name>-rolesanywhere-ca secret, and a
+	// profile for the given roles, so that machines can authenticate with certificates issued by this CA
+	// instead of, or in addition to, their instance profile. The CA is generated unless the secret exists.
+	// +optional
+	RolesAnywhere *RolesAnywhereSpec `json:"rolesAnywhere,omitempty"`
+
+	// Karpenter, when set, makes the provider create the AWS resources required to run Karpenter in the cluster.
+	// +optional
+	Karpenter *KarpenterSpec `json:"karpenter,omitempty"`
 }
 
 // AWSIdentityKind defines allowed AWS identity types.
@@ -125,6 +142,9 @@ var (
 
 	// ClusterStaticIdentityKind defines identity reference kind as AWSClusterStaticIdentity.
 	ClusterStaticIdentityKind = AWSIdentityKind("AWSClusterStaticIdentity")
+
+	// ClusterWebIdentityKind defines identity reference kind as AWSClusterWebIdentity.
+	ClusterWebIdentityKind = AWSIdentityKind("AWSClusterWebIdentity")
 )
 
 // AWSIdentityReference specifies a identity.
@@ -134,7 +154,7 @@ type AWSIdentityReference struct {
 	Name string `json:"name"`
 
 	// Kind of the identity.
-	// +kubebuilder:validation:Enum=AWSClusterControllerIdentity;AWSClusterRoleIdentity;AWSClusterStaticIdentity
+	// +kubebuilder:validation:Enum=AWSClusterControllerIdentity;AWSClusterRoleIdentity;AWSClusterStaticIdentity;AWSClusterWebIdentity
 	Kind AWSIdentityKind `json:"kind"`
 }
 
@@ -319,6 +339,18 @@ type AWSClusterStatus struct {
 	FailureDomains clusterv1beta1.FailureDomains `json:"failureDomains,omitempty"`
 	Bastion        *Instance                     `json:"bastion,omitempty"`
 	Conditions     clusterv1beta1.Conditions     `json:"conditions,omitempty"`
+
+	// KMSKey describes the KMS key created for the cluster.
+	// +optional
+	KMSKey *KMSKeyStatus `json:"kmsKey,omitempty"`
+
+	// RolesAnywhere describes the IAM Roles Anywhere resources created for the cluster.
+	// +optional
+	RolesAnywhere *RolesAnywhereStatus `json:"rolesAnywhere,omitempty"`
+
+	// Karpenter describes the AWS resources created to run Karpenter in the cluster.
+	// +optional
+	Karpenter *KarpenterStatus `json:"karpenter,omitempty"`
 }
 
 // AdditionalIAMRole defines an additional IAM role
@@ -381,6 +413,15 @@ type S3Bucket struct {
 	// BestEffortDeleteObjects defines whether access/permission errors during object deletion should be ignored.
 	// +optional
 	BestEffortDeleteObjects *bool `json:"bestEffortDeleteObjects,omitempty"`
+
+	// SecretsKMSKeyARN is the ARN of the KMS key used to encrypt bootstrap data secrets stored in the
+	// bucket by the cluster-object-store secret backend. When set, the bucket policy denies storing
+	// secrets encrypted with any other key. The key policy must allow the node roles to decrypt.
+	// When unset, the cluster KMS key configured at `AWSCluster.spec.kmsKey` is used if any,
+	// or else the AWS managed key for S3.
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:kms:`
+	// +optional
+	SecretsKMSKeyARN string `json:"secretsKMSKeyARN,omitempty"`
 }
 
 // +kubebuilder:object:root=true
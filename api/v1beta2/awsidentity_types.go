@@ -181,6 +181,61 @@ type AWSClusterControllerIdentitySpec struct {
 	AWSClusterIdentitySpec `json:",inline"`
 }
 
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=awsclusterwebidentities,scope=Cluster,categories=cluster-api,shortName=awswi
+// +kubebuilder:storageversion
+// +k8s:defaulter-gen=true
+
+// AWSClusterWebIdentity is the Schema for the awsclusterwebidentities API
+// It is used to assume a role with an OpenID Connect token, such as a projected service account token.
+type AWSClusterWebIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec for this AWSClusterWebIdentity.
+	Spec AWSClusterWebIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:defaulter-gen=true
+
+// AWSClusterWebIdentityList contains a list of AWSClusterWebIdentity.
+type AWSClusterWebIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSClusterWebIdentity `json:"items"`
+}
+
+// AWSClusterWebIdentitySpec defines the specifications for AWSClusterWebIdentity.
+// +kubebuilder:validation:XValidation:rule="has(self.tokenFile) != has(self.tokenSecretRef)",message="exactly one of tokenFile or tokenSecretRef must be set"
+type AWSClusterWebIdentitySpec struct {
+	AWSClusterIdentitySpec `json:",inline"`
+	AWSRoleSpec            `json:",inline"`
+
+	// TokenFile is the path of a file containing the OpenID Connect token, such as a projected
+	// service account token mounted in the controller pod. The file is read again whenever the
+	// credentials are renewed, so that rotated tokens are picked up.
+	// +optional
+	TokenFile string `json:"tokenFile,omitempty"`
+
+	// TokenSecretRef is a reference to a secret, in the namespace of the controller, containing
+	// the OpenID Connect token.
+	// +optional
+	TokenSecretRef *WebIdentityTokenSecretReference `json:"tokenSecretRef,omitempty"`
+}
+
+// WebIdentityTokenSecretReference is a reference to a key of a secret holding an OpenID Connect token.
+type WebIdentityTokenSecretReference struct {
+	// Name of the secret.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key of the secret holding the token.
+	// +kubebuilder:default=token
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
 func init() {
 	SchemeBuilder.Register(
 		&AWSClusterStaticIdentity{},
@@ -189,5 +244,7 @@ func init() {
 		&AWSClusterRoleIdentityList{},
 		&AWSClusterControllerIdentity{},
 		&AWSClusterControllerIdentityList{},
+		&AWSClusterWebIdentity{},
+		&AWSClusterWebIdentityList{},
 	)
 }
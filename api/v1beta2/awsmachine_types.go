@@ -50,6 +50,10 @@ var (
 
 	// SecretBackendSecretsManager defines AWS Secrets Manager as the secret backend.
 	SecretBackendSecretsManager = SecretBackend("secrets-manager")
+
+	// SecretBackendClusterObjectStore defines the cluster wide object storage, configured at
+	// `AWSCluster.spec.s3Bucket`, as the secret backend.
+	SecretBackendClusterObjectStore = SecretBackend("cluster-object-store")
 )
 
 // IgnitionStorageTypeOption defines the different storage types for Ignition.
@@ -144,6 +148,23 @@ type AWSMachineSpec struct {
 	// +optional
 	ElasticIPPool *ElasticIPPool `json:"elasticIpPool,omitempty"`
 
+	// ElasticIPClaim is the configuration to associate the machine with a slot of a named, cluster-owned
+	// set of Elastic IP addresses. Unlike an Elastic IP allocated for the instance, the address is not
+	// released when the machine is deleted, but returned to the claim and re-associated to the replacement
+	// machine. When ElasticIPPool is set, the addresses of the claim are allocated from the given pool.
+	//
+	// +optional
+	ElasticIPClaim *ElasticIPClaim `json:"elasticIpClaim,omitempty"`
+
+	// RolesAnywhere, when set, issues a certificate from the IAM Roles Anywhere CA of the cluster to the machine
+	// and configures the AWS signing helper in its bootstrap data, so that the machine authenticates with the
+	// IAM Roles Anywhere profile of the cluster. It requires AWSCluster.spec.rolesAnywhere to be set, the signing helper to be
+	// installed at /usr/local/bin/aws_signing_helper, and the bootstrap data not to be stored unencrypted in
+	// the instance user data.
+	//
+	// +optional
+	RolesAnywhere *MachineRolesAnywhere `json:"rolesAnywhere,omitempty"`
+
 	// AdditionalSecurityGroups is an array of references to security groups that should be applied to the
 	// instance. These security groups would be set in addition to any security groups defined
 	// at the cluster level or in the actuator. It is possible to specify either IDs of Filters. Using Filters
@@ -185,6 +206,16 @@ type AWSMachineSpec struct {
 	// +optional
 	NetworkInterfaceType NetworkInterfaceType `json:"networkInterfaceType,omitempty"`
 
+	// AdditionalNetworkInterfaces is a list of network interfaces that are created by the provider,
+	// attached to the instance on launch and deleted together with the instance.
+	// Unlike NetworkInterfaces, which references pre-existing ENIs, these are fully managed.
+	// Public IP assignment on launch is not supported when additional network interfaces are specified.
+	// +optional
+	// +kubebuilder:validation:MaxItems=15
+	// +listType=map
+	// +listMapKey=deviceIndex
+	AdditionalNetworkInterfaces []NetworkInterfaceSpec `json:"additionalNetworkInterfaces,omitempty"`
+
 	// AssignPrimaryIPv6 specifies whether to enable assigning a primary IPv6 address to the primary network Interface.
 	// When set to enabled, the instance will be assigned a primary IPv6 address from the subnet's IPv6 CIDR block.
 	// This is required when registering instances by ID to IPv6 target groups of dual-stack load balancers.
@@ -319,10 +350,12 @@ type CloudInit struct {
 	SecretPrefix string `json:"secretPrefix,omitempty"`
 
 	// SecureSecretsBackend, when set to parameter-store will utilize the AWS Systems Manager
-	// Parameter Storage to distribute secrets. By default or with the value of secrets-manager,
+	// Parameter Storage to distribute secrets. When set to cluster-object-store, the secrets are stored
+	// as a single SSE-KMS encrypted object in the cluster S3 bucket, which is deleted by the node
+	// after the first successful fetch. By default or with the value of secrets-manager,
 	// will use AWS Secrets Manager instead.
 	// +optional
-	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store
+	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store;cluster-object-store
 	SecureSecretsBackend SecretBackend `json:"secureSecretsBackend,omitempty"`
 }
 
@@ -482,6 +515,16 @@ type AWSMachineStatus struct {
 	// This field is populated when DynamicHostAllocation is used.
 	// +optional
 	DedicatedHost *DedicatedHostStatus `json:"dedicatedHost,omitempty"`
+
+	// ElasticIPClaim is the Elastic IP claim slot held by the machine.
+	// This field is populated when ElasticIPClaim is used.
+	// +optional
+	ElasticIPClaim *ElasticIPClaimStatus `json:"elasticIpClaim,omitempty"`
+
+	// RolesAnywhere describes the certificate issued to the machine for IAM Roles Anywhere.
+	// This field is populated when RolesAnywhere is used.
+	// +optional
+	RolesAnywhere *MachineRolesAnywhereStatus `json:"rolesAnywhere,omitempty"`
 }
 
 // DedicatedHostStatus defines the observed state of a dynamically allocated dedicated host
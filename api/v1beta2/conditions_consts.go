@@ -196,6 +196,26 @@ const (
 	// S3BucketFailedReason is used when any errors occur during reconciliation of an S3 bucket.
 	S3BucketFailedReason = "S3BucketCreationFailed"
 
+	// KMSKeyReadyCondition indicates the cluster KMS key has been created and configured successfully.
+	KMSKeyReadyCondition clusterv1beta1.ConditionType = "KMSKeyReady"
+
+	// KMSKeyFailedReason is used when any errors occur during reconciliation of the cluster KMS key.
+	KMSKeyFailedReason = "KMSKeyReconciliationFailed"
+
+	// RolesAnywhereReadyCondition indicates the IAM Roles Anywhere trust anchor and profile of the cluster
+	// have been created and configured successfully.
+	RolesAnywhereReadyCondition clusterv1beta1.ConditionType = "RolesAnywhereReady"
+
+	// RolesAnywhereFailedReason is used when any errors occur during reconciliation of the IAM Roles Anywhere resources.
+	RolesAnywhereFailedReason = "RolesAnywhereReconciliationFailed"
+
+	// KarpenterReadyCondition indicates the AWS resources required to run Karpenter in the cluster
+	// have been created and configured successfully.
+	KarpenterReadyCondition clusterv1beta1.ConditionType = "KarpenterReady"
+
+	// KarpenterFailedReason is used when any errors occur during reconciliation of the Karpenter resources.
+	KarpenterFailedReason = "KarpenterReconciliationFailed"
+
 	// DedicatedHostReleaseFailedReason used when the dedicated host release fails.
 	DedicatedHostReleaseFailedReason = "DedicatedHostReleaseFailed"
 )
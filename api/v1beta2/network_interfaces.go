@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateNetworkInterfaceSpecs validates a list of additional network interfaces.
+// reservedDeviceIndexes is the number of device indexes, starting at 0, that are
+// already taken by the primary or pre-existing network interfaces.
+func ValidateNetworkInterfaceSpecs(fldPath *field.Path, specs []NetworkInterfaceSpec, reservedDeviceIndexes int) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := map[int32]bool{}
+	for i, spec := range specs {
+		idxPath := fldPath.Index(i)
+
+		if int(spec.DeviceIndex) < reservedDeviceIndexes || spec.DeviceIndex < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("deviceIndex"), spec.DeviceIndex,
+				fmt.Sprintf("must be greater than or equal to %d, lower device indexes are used by the primary or pre-existing network interfaces", max(reservedDeviceIndexes, 1))))
+		}
+		if seen[spec.DeviceIndex] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("deviceIndex"), spec.DeviceIndex))
+		}
+		seen[spec.DeviceIndex] = true
+
+		if spec.Subnet != nil && spec.Subnet.ID != nil && len(spec.Subnet.Filters) > 0 {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("subnet"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
+		for j, sg := range spec.SecurityGroups {
+			if sg.ID != nil && len(sg.Filters) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("securityGroups").Index(j), "only one of ID or Filters may be specified, specifying both is forbidden"))
+			}
+		}
+	}
+
+	return allErrs
+}
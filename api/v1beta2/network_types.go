@@ -1210,3 +1210,43 @@ func (r PublicIpv4PoolFallbackOrder) String() string {
 func (r PublicIpv4PoolFallbackOrder) Equal(e PublicIpv4PoolFallbackOrder) bool {
 	return r == e
 }
+
+// ElasticIPClaim defines a named set of cluster-owned Elastic IP addresses that outlive the machines
+// holding them. Each address of the claim is bound to a slot; a machine holds at most one slot at a
+// time and a slot is handed over to a replacement machine once its previous holder is deleted.
+type ElasticIPClaim struct {
+	// Name identifies the claim within the cluster. Machines referencing the same claim name share
+	// its slots, e.g. all the machines of a MachineDeployment.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Slots is the maximum number of Elastic IP addresses allocated for the claim. It should be at least
+	// the number of replicas, plus the surge, of the MachineDeployment referencing the claim, otherwise
+	// replacement machines wait for a slot to be released before an Elastic IP is associated to them.
+	//
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Slots int32 `json:"slots,omitempty"`
+}
+
+// ElasticIPClaimStatus describes the Elastic IP claim slot held by a machine.
+type ElasticIPClaimStatus struct {
+	// Name is the name of the claim.
+	Name string `json:"name"`
+
+	// Slot is the index of the slot held by the machine.
+	Slot int32 `json:"slot"`
+
+	// AllocationID is the allocation ID of the Elastic IP address bound to the slot.
+	// +optional
+	AllocationID string `json:"allocationID,omitempty"`
+
+	// PublicIP is the public IPv4 address bound to the slot.
+	// +optional
+	PublicIP string `json:"publicIP,omitempty"`
+}
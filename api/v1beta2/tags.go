@@ -170,6 +170,18 @@ const (
 	// dedicated to this cluster api provider implementation.
 	NameAWSSubnetAssociation = NameAWSProviderPrefix + "association"
 
+	// NameAWSElasticIPClaimSlot is the tag name we use to mark the slot of an Elastic IP
+	// address allocated for a named Elastic IP claim.
+	NameAWSElasticIPClaimSlot = NameAWSProviderPrefix + "eip-claim-slot"
+
+	// NameAWSElasticIPClaimHolder is the tag name we use to mark the instance claiming the slot
+	// of an Elastic IP claim, before the address is associated to it.
+	NameAWSElasticIPClaimHolder = NameAWSProviderPrefix + "eip-claim-holder"
+
+	// NameAWSElasticIPClaimOwner is the tag name we use to mark the MachineDeployment owning
+	// the slots of an Elastic IP claim.
+	NameAWSElasticIPClaimOwner = NameAWSProviderPrefix + "eip-claim-owner"
+
 	// SecondarySubnetTagValue is the secondary subnet tag constant value.
 	SecondarySubnetTagValue = "secondary"
 
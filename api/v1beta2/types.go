@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -223,6 +224,10 @@ type Instance struct {
 	// NetworkInterfaceType is the interface type of the primary network Interface.
 	NetworkInterfaceType NetworkInterfaceType `json:"networkInterfaceType,omitempty"`
 
+	// AdditionalNetworkInterfaces are the network interfaces created and attached by the provider on launch.
+	// +optional
+	AdditionalNetworkInterfaces []NetworkInterfaceSpec `json:"additionalNetworkInterfaces,omitempty"`
+
 	// AssignPrimaryIPv6 specifies whether to enable assigning a primary IPv6 address to the primary network Interface.
 	AssignPrimaryIPv6 *PrimaryIPv6AssignmentState `json:"assignPrimaryIPv6,omitempty"`
 
@@ -657,3 +662,216 @@ type CPUOptions struct {
 	// +optional
 	NestedVirtualization NestedVirtualizationPolicy `json:"nestedVirtualization,omitempty"`
 }
+
+// NetworkInterfaceSpec defines an additional network interface that is created by the provider
+// together with the instance. The interface is attached at launch, tagged like the instance and
+// deleted when the instance is terminated.
+type NetworkInterfaceSpec struct {
+	// DeviceIndex is the position of the network interface in the attachment order.
+	// The primary network interface always uses device index 0, so additional interfaces
+	// must use a device index of 1 or above. Device indexes must be unique within the list,
+	// and must not collide with the ones used by pre-existing network interfaces.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=31
+	DeviceIndex int32 `json:"deviceIndex"`
+
+	// Description is an optional description applied to the network interface.
+	// +optional
+	// +kubebuilder:validation:MaxLength:=255
+	Description string `json:"description,omitempty"`
+
+	// Subnet is a reference to the subnet in which the network interface is created.
+	// If not specified, the subnet of the primary network interface is used.
+	// The subnet must be in the same availability zone as the instance.
+	// +optional
+	Subnet *AWSResourceReference `json:"subnet,omitempty"`
+
+	// SecurityGroups is a list of references to security groups applied to the network interface.
+	// If not specified, the security groups of the primary network interface are used.
+	// +optional
+	SecurityGroups []AWSResourceReference `json:"securityGroups,omitempty"`
+
+	// SecondaryPrivateIPAddressCount is the number of secondary private IPv4 addresses
+	// assigned to the network interface.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	SecondaryPrivateIPAddressCount *int32 `json:"secondaryPrivateIPAddressCount,omitempty"`
+
+	// IPv4PrefixCount is the number of IPv4 delegated prefixes (/28) assigned to the network interface.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	IPv4PrefixCount *int32 `json:"ipv4PrefixCount,omitempty"`
+
+	// IPv6PrefixCount is the number of IPv6 delegated prefixes (/80) assigned to the network interface.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	IPv6PrefixCount *int32 `json:"ipv6PrefixCount,omitempty"`
+
+	// NetworkInterfaceType is the interface type of the network interface.
+	// If not specified, AWS applies a default value.
+	// +kubebuilder:validation:Enum=interface;efa
+	// +optional
+	NetworkInterfaceType NetworkInterfaceType `json:"networkInterfaceType,omitempty"`
+}
+
+// KMSKeySpec configures a customer managed KMS key created and owned by the provider for a cluster.
+// The key is aliased with the cluster name, has automatic rotation enabled and is used by default to
+// encrypt EBS volumes, bootstrap data secrets and, for EKS clusters, Kubernetes secrets.
+type KMSKeySpec struct {
+	// NodeRoles are the names or ARNs of the IAM roles assumed by the instances of the cluster.
+	// They are granted the use of the key, e.g. to decrypt their bootstrap data and to attach
+	// encrypted volumes. As the volumes of the instances are encrypted with the key by default,
+	// the roles of all their instance profiles must be listed. The roles must exist.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	NodeRoles []string `json:"nodeRoles"`
+
+	// RotationPeriodInDays is the number of days between automatic rotations of the key material.
+	// +kubebuilder:default=365
+	// +kubebuilder:validation:Minimum=90
+	// +kubebuilder:validation:Maximum=2560
+	// +optional
+	RotationPeriodInDays int32 `json:"rotationPeriodInDays,omitempty"`
+
+	// PendingWindowInDays is the waiting period, in days, after which the key is deleted once its
+	// deletion has been scheduled on cluster deletion. The deletion can be canceled during this period.
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	PendingWindowInDays int32 `json:"pendingWindowInDays,omitempty"`
+}
+
+// KMSKeyStatus describes the KMS key owned by a cluster.
+type KMSKeyStatus struct {
+	// ARN is the Amazon Resource Name of the key.
+	ARN string `json:"arn"`
+
+	// AliasName is the alias of the key.
+	// +optional
+	AliasName string `json:"aliasName,omitempty"`
+}
+
+// RolesAnywhereSpec configures the IAM Roles Anywhere trust anchor and profile created and owned by the provider
+// for a cluster. The trust anchor trusts the IAM Roles Anywhere CA of the cluster, which issues the certificates of the machines opting in
+// to authenticate with IAM Roles Anywhere, e.g. machines on AWS Outposts or running workloads outside EC2.
+type RolesAnywhereSpec struct {
+	// Roles are the names or ARNs of the IAM roles that machines can assume through the profile.
+	// The roles must exist and trust the rolesanywhere.amazonaws.com service principal.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=250
+	Roles []string `json:"roles"`
+
+	// SessionDurationSeconds is the duration of the sessions vended to the machines.
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=900
+	// +kubebuilder:validation:Maximum=43200
+	// +optional
+	SessionDurationSeconds int32 `json:"sessionDurationSeconds,omitempty"`
+}
+
+// RolesAnywhereStatus describes the IAM Roles Anywhere resources owned by a cluster.
+type RolesAnywhereStatus struct {
+	// TrustAnchorARN is the Amazon Resource Name of the trust anchor.
+	// +optional
+	TrustAnchorARN string `json:"trustAnchorARN,omitempty"`
+
+	// ProfileARN is the Amazon Resource Name of the profile.
+	// +optional
+	ProfileARN string `json:"profileARN,omitempty"`
+
+	// RoleARNs are the Amazon Resource Names of the roles allowed by the profile.
+	// +optional
+	RoleARNs []string `json:"roleARNs,omitempty"`
+}
+
+// MachineRolesAnywhere configures a machine to authenticate with the IAM Roles Anywhere profile of its cluster,
+// using a certificate issued by the IAM Roles Anywhere CA of the cluster.
+type MachineRolesAnywhere struct {
+	// Role is the name or ARN of the IAM role assumed by the machine, which must be one of the roles
+	// of the cluster profile. Defaults to the first role of the cluster profile.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// CertificateValidity is the validity of the certificate issued to the machine. The machine can't
+	// authenticate with IAM Roles Anywhere anymore once its certificate has expired, so it should be
+	// replaced before then.
+	// +kubebuilder:default="168h"
+	// +optional
+	CertificateValidity *metav1.Duration `json:"certificateValidity,omitempty"`
+}
+
+// MachineRolesAnywhereStatus describes the certificate issued to a machine for IAM Roles Anywhere.
+type MachineRolesAnywhereStatus struct {
+	// RoleARN is the Amazon Resource Name of the role assumed by the machine.
+	RoleARN string `json:"roleARN"`
+
+	// CertificateSerialNumber is the serial number of the certificate issued to the machine.
+	CertificateSerialNumber string `json:"certificateSerialNumber"`
+
+	// CertificateNotAfter is the expiration time of the certificate issued to the machine.
+	CertificateNotAfter metav1.Time `json:"certificateNotAfter"`
+}
+
+// KarpenterSpec configures the AWS resources required to run Karpenter (https://karpenter.sh) in a cluster,
+// created and owned by the provider: the discovery tags of the subnets and security groups, the IAM role of
+// the Karpenter controller and the SQS queue and EventBridge rules used for interruption handling.
+type KarpenterSpec struct {
+	// DiscoveryTagValue is the value of the karpenter.sh/discovery tag added to the private subnets and the node
+	// security group of the cluster, to be used in the subnet and security group selectors of the EC2NodeClasses.
+	// Defaults to the name of the cluster.
+	// +optional
+	DiscoveryTagValue string `json:"discoveryTagValue,omitempty"`
+
+	// NodeRole is the name or ARN of the existing IAM role of the nodes launched by Karpenter. The Karpenter
+	// controller is allowed to pass it to the instance profiles it creates. On EKS, an EC2_LINUX access entry
+	// is created for it.
+	// +kubebuilder:validation:MinLength=1
+	NodeRole string `json:"nodeRole"`
+
+	// ServiceAccountNamespace is the namespace of the service account of the Karpenter controller. On EKS,
+	// the service account is associated with the controller role through EKS Pod Identity.
+	// +kubebuilder:default="kube-system"
+	// +optional
+	ServiceAccountNamespace string `json:"serviceAccountNamespace,omitempty"`
+
+	// ServiceAccountName is the name of the service account of the Karpenter controller.
+	// +kubebuilder:default="karpenter"
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// TrustedPrincipalARNs are the ARNs of the IAM principals allowed to assume the controller role, such as
+	// the role of the instance profile of the control plane nodes when Karpenter runs on a self-managed
+	// control plane. Required unless the controller role is assumed through EKS Pod Identity.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	TrustedPrincipalARNs []string `json:"trustedPrincipalARNs,omitempty"`
+
+	// InterruptionHandling, when enabled, creates the SQS queue to which EventBridge rules forward the spot
+	// interruption, rebalance recommendation, scheduled change and instance state change events handled by
+	// Karpenter.
+	// +kubebuilder:default=true
+	// +optional
+	InterruptionHandling *bool `json:"interruptionHandling,omitempty"`
+}
+
+// InterruptionHandlingEnabled returns whether the interruption queue and rules are created.
+func (k *KarpenterSpec) InterruptionHandlingEnabled() bool {
+	return k.InterruptionHandling == nil || *k.InterruptionHandling
+}
+
+// KarpenterStatus describes the AWS resources created to run Karpenter in a cluster.
+type KarpenterStatus struct {
+	// ControllerRoleARN is the Amazon Resource Name of the IAM role of the Karpenter controller.
+	// +optional
+	ControllerRoleARN string `json:"controllerRoleARN,omitempty"`
+
+	// NodeRoleARN is the Amazon Resource Name of the IAM role of the nodes launched by Karpenter.
+	// +optional
+	NodeRoleARN string `json:"nodeRoleARN,omitempty"`
+
+	// InterruptionQueueName is the name of the SQS interruption queue, to be set as the interruptionQueue
+	// setting of Karpenter.
+	// +optional
+	InterruptionQueueName string `json:"interruptionQueueName,omitempty"`
+}
@@ -323,6 +323,21 @@ func (in *AWSClusterSpec) DeepCopyInto(out *AWSClusterSpec) {
 		*out = new(S3Bucket)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(KMSKeySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolesAnywhere != nil {
+		in, out := &in.RolesAnywhere, &out.RolesAnywhere
+		*out = new(RolesAnywhereSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterSpec.
@@ -432,6 +447,21 @@ func (in *AWSClusterStatus) DeepCopyInto(out *AWSClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(KMSKeyStatus)
+		**out = **in
+	}
+	if in.RolesAnywhere != nil {
+		in, out := &in.RolesAnywhere, &out.RolesAnywhere
+		*out = new(RolesAnywhereStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(KarpenterStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterStatus.
@@ -535,6 +565,86 @@ func (in *AWSClusterTemplateSpec) DeepCopy() *AWSClusterTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentity) DeepCopyInto(out *AWSClusterWebIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentity.
+func (in *AWSClusterWebIdentity) DeepCopy() *AWSClusterWebIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterWebIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentityList) DeepCopyInto(out *AWSClusterWebIdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSClusterWebIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentityList.
+func (in *AWSClusterWebIdentityList) DeepCopy() *AWSClusterWebIdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSClusterWebIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClusterWebIdentitySpec) DeepCopyInto(out *AWSClusterWebIdentitySpec) {
+	*out = *in
+	in.AWSClusterIdentitySpec.DeepCopyInto(&out.AWSClusterIdentitySpec)
+	in.AWSRoleSpec.DeepCopyInto(&out.AWSRoleSpec)
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(WebIdentityTokenSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClusterWebIdentitySpec.
+func (in *AWSClusterWebIdentitySpec) DeepCopy() *AWSClusterWebIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClusterWebIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSIdentityReference) DeepCopyInto(out *AWSIdentityReference) {
 	*out = *in
@@ -715,6 +825,16 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(ElasticIPPool)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ElasticIPClaim != nil {
+		in, out := &in.ElasticIPClaim, &out.ElasticIPClaim
+		*out = new(ElasticIPClaim)
+		**out = **in
+	}
+	if in.RolesAnywhere != nil {
+		in, out := &in.RolesAnywhere, &out.RolesAnywhere
+		*out = new(MachineRolesAnywhere)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalSecurityGroups != nil {
 		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
 		*out = make([]AWSResourceReference, len(*in))
@@ -756,6 +876,13 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]NetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AssignPrimaryIPv6 != nil {
 		in, out := &in.AssignPrimaryIPv6, &out.AssignPrimaryIPv6
 		*out = new(PrimaryIPv6AssignmentState)
@@ -849,6 +976,16 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 		*out = new(DedicatedHostStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ElasticIPClaim != nil {
+		in, out := &in.ElasticIPClaim, &out.ElasticIPClaim
+		*out = new(ElasticIPClaimStatus)
+		**out = **in
+	}
+	if in.RolesAnywhere != nil {
+		in, out := &in.RolesAnywhere, &out.RolesAnywhere
+		*out = new(MachineRolesAnywhereStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineStatus.
@@ -1553,6 +1690,36 @@ func (in *DynamicHostAllocationSpec) DeepCopy() *DynamicHostAllocationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPClaim) DeepCopyInto(out *ElasticIPClaim) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPClaim.
+func (in *ElasticIPClaim) DeepCopy() *ElasticIPClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPClaimStatus) DeepCopyInto(out *ElasticIPClaimStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPClaimStatus.
+func (in *ElasticIPClaimStatus) DeepCopy() *ElasticIPClaimStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPClaimStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
 	*out = *in
@@ -1854,6 +2021,13 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]NetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AssignPrimaryIPv6 != nil {
 		in, out := &in.AssignPrimaryIPv6, &out.AssignPrimaryIPv6
 		*out = new(PrimaryIPv6AssignmentState)
@@ -1939,6 +2113,81 @@ func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSKeySpec) DeepCopyInto(out *KMSKeySpec) {
+	*out = *in
+	if in.NodeRoles != nil {
+		in, out := &in.NodeRoles, &out.NodeRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSKeySpec.
+func (in *KMSKeySpec) DeepCopy() *KMSKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSKeyStatus) DeepCopyInto(out *KMSKeyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSKeyStatus.
+func (in *KMSKeyStatus) DeepCopy() *KMSKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterSpec) DeepCopyInto(out *KarpenterSpec) {
+	*out = *in
+	if in.TrustedPrincipalARNs != nil {
+		in, out := &in.TrustedPrincipalARNs, &out.TrustedPrincipalARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InterruptionHandling != nil {
+		in, out := &in.InterruptionHandling, &out.InterruptionHandling
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterSpec.
+func (in *KarpenterSpec) DeepCopy() *KarpenterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterStatus) DeepCopyInto(out *KarpenterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterStatus.
+func (in *KarpenterStatus) DeepCopy() *KarpenterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Listener) DeepCopyInto(out *Listener) {
 	*out = *in
@@ -2026,6 +2275,84 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRolesAnywhere) DeepCopyInto(out *MachineRolesAnywhere) {
+	*out = *in
+	if in.CertificateValidity != nil {
+		in, out := &in.CertificateValidity, &out.CertificateValidity
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRolesAnywhere.
+func (in *MachineRolesAnywhere) DeepCopy() *MachineRolesAnywhere {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRolesAnywhere)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRolesAnywhereStatus) DeepCopyInto(out *MachineRolesAnywhereStatus) {
+	*out = *in
+	in.CertificateNotAfter.DeepCopyInto(&out.CertificateNotAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRolesAnywhereStatus.
+func (in *MachineRolesAnywhereStatus) DeepCopy() *MachineRolesAnywhereStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRolesAnywhereStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]AWSResourceReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecondaryPrivateIPAddressCount != nil {
+		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPv4PrefixCount != nil {
+		in, out := &in.IPv4PrefixCount, &out.IPv4PrefixCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPv6PrefixCount != nil {
+		in, out := &in.IPv6PrefixCount, &out.IPv6PrefixCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -2154,6 +2481,46 @@ func (in *PrivateDNSName) DeepCopy() *PrivateDNSName {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolesAnywhereSpec) DeepCopyInto(out *RolesAnywhereSpec) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolesAnywhereSpec.
+func (in *RolesAnywhereSpec) DeepCopy() *RolesAnywhereSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolesAnywhereSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolesAnywhereStatus) DeepCopyInto(out *RolesAnywhereStatus) {
+	*out = *in
+	if in.RoleARNs != nil {
+		in, out := &in.RoleARNs, &out.RoleARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolesAnywhereStatus.
+func (in *RolesAnywhereStatus) DeepCopy() *RolesAnywhereStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolesAnywhereStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -2603,3 +2970,18 @@ func (in *VpcCidrBlock) DeepCopy() *VpcCidrBlock {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebIdentityTokenSecretReference) DeepCopyInto(out *WebIdentityTokenSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebIdentityTokenSecretReference.
+func (in *WebIdentityTokenSecretReference) DeepCopy() *WebIdentityTokenSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WebIdentityTokenSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
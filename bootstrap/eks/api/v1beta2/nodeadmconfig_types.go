@@ -65,6 +65,72 @@ type NodeadmConfigSpec struct {
 	// Mounts specifies a list of mount points to be setup.
 	// +optional
 	Mounts []MountPoints `json:"mounts,omitempty"`
+
+	// Hybrid configures the node as an EKS hybrid node, running outside of AWS on a machine provisioned
+	// by another infrastructure provider. The user data installs and runs nodeadm, which must be able
+	// to reach the EKS cluster from the remote node networks of the AWSManagedControlPlane.
+	// +optional
+	Hybrid *HybridOptions `json:"hybrid,omitempty"`
+}
+
+// HybridOptions configures how an EKS hybrid node obtains its AWS credentials.
+// +kubebuilder:validation:XValidation:rule="has(self.ssm) != has(self.iamRolesAnywhere)",message="exactly one of ssm or iamRolesAnywhere must be set"
+type HybridOptions struct {
+	// SSM configures the node to obtain credentials with an AWS Systems Manager hybrid activation.
+	// +optional
+	SSM *HybridSSMOptions `json:"ssm,omitempty"`
+
+	// IAMRolesAnywhere configures the node to obtain credentials with IAM Roles Anywhere.
+	// +optional
+	IAMRolesAnywhere *HybridIAMRolesAnywhereOptions `json:"iamRolesAnywhere,omitempty"`
+
+	// NodeadmVersion is the version of the nodeadm release installed on the node, such as v1.0.0.
+	// Pinning it makes nodes bootstrapped at different times install the same nodeadm.
+	// Defaults to the latest release.
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+$`
+	// +optional
+	NodeadmVersion string `json:"nodeadmVersion,omitempty"`
+}
+
+// HybridSSMOptions configures an AWS Systems Manager hybrid activation.
+type HybridSSMOptions struct {
+	// ActivationID is the ID of the hybrid activation.
+	// +kubebuilder:validation:MinLength=1
+	ActivationID string `json:"activationID"`
+
+	// ActivationCodeSecret references the secret key holding the code of the hybrid activation.
+	ActivationCodeSecret SecretFileSource `json:"activationCodeSecret"`
+}
+
+// HybridIAMRolesAnywhereOptions configures IAM Roles Anywhere for a hybrid node.
+// The certificate and private key of the node can be provided with Files.
+type HybridIAMRolesAnywhereOptions struct {
+	// NodeName is the name of the node, which must match the common name of its certificate.
+	// Defaults to the name of the Machine.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// TrustAnchorARN is the ARN of the IAM Roles Anywhere trust anchor.
+	// +kubebuilder:validation:MinLength=1
+	TrustAnchorARN string `json:"trustAnchorARN"`
+
+	// ProfileARN is the ARN of the IAM Roles Anywhere profile.
+	// +kubebuilder:validation:MinLength=1
+	ProfileARN string `json:"profileARN"`
+
+	// RoleARN is the ARN of the IAM role of the hybrid nodes.
+	// +kubebuilder:validation:MinLength=1
+	RoleARN string `json:"roleARN"`
+
+	// CertificatePath is the path of the certificate of the node.
+	// +kubebuilder:default="/etc/iam/pki/server.pem"
+	// +optional
+	CertificatePath string `json:"certificatePath,omitempty"`
+
+	// PrivateKeyPath is the path of the private key of the node.
+	// +kubebuilder:default="/etc/iam/pki/server.key"
+	// +optional
+	PrivateKeyPath string `json:"privateKeyPath,omitempty"`
 }
 
 // KubeletOptions are additional parameters passed to kubelet.
@@ -423,6 +423,62 @@ func (in *Filesystem) DeepCopy() *Filesystem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HybridIAMRolesAnywhereOptions) DeepCopyInto(out *HybridIAMRolesAnywhereOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HybridIAMRolesAnywhereOptions.
+func (in *HybridIAMRolesAnywhereOptions) DeepCopy() *HybridIAMRolesAnywhereOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HybridIAMRolesAnywhereOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HybridOptions) DeepCopyInto(out *HybridOptions) {
+	*out = *in
+	if in.SSM != nil {
+		in, out := &in.SSM, &out.SSM
+		*out = new(HybridSSMOptions)
+		**out = **in
+	}
+	if in.IAMRolesAnywhere != nil {
+		in, out := &in.IAMRolesAnywhere, &out.IAMRolesAnywhere
+		*out = new(HybridIAMRolesAnywhereOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HybridOptions.
+func (in *HybridOptions) DeepCopy() *HybridOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HybridOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HybridSSMOptions) DeepCopyInto(out *HybridSSMOptions) {
+	*out = *in
+	out.ActivationCodeSecret = in.ActivationCodeSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HybridSSMOptions.
+func (in *HybridSSMOptions) DeepCopy() *HybridSSMOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HybridSSMOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletOptions) DeepCopyInto(out *KubeletOptions) {
 	*out = *in
@@ -631,6 +687,11 @@ func (in *NodeadmConfigSpec) DeepCopyInto(out *NodeadmConfigSpec) {
 			}
 		}
 	}
+	if in.Hybrid != nil {
+		in, out := &in.Hybrid, &out.Hybrid
+		*out = new(HybridOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeadmConfigSpec.
@@ -21,13 +21,18 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -40,6 +45,8 @@ import (
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/internal/userdata"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/util/paused"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
@@ -53,6 +60,8 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+const kindAWSManagedMachinePool = "AWSManagedMachinePool"
+
 // NodeadmConfigReconciler reconciles a NodeadmConfig object.
 type NodeadmConfigReconciler struct {
 	client.Client
@@ -65,6 +74,7 @@ type NodeadmConfigReconciler struct {
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machinepools;clusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmanagedmachinepools,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;delete;
 
 func (r *NodeadmConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, rerr error) {
@@ -245,6 +255,26 @@ func (r *NodeadmConfigReconciler) joinWorker(ctx context.Context, cluster *clust
 	if config.Spec.FeatureGates != nil {
 		nodeInput.FeatureGates = config.Spec.FeatureGates
 	}
+	if configOwner.IsMachinePool() {
+		nodegroupFlags, err := r.managedNodegroupKubeletFlags(ctx, config.Namespace, configOwner)
+		if err != nil {
+			log.Info("Failed to resolve the managed node group of the machine pool for user data")
+			v1beta1conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return ctrl.Result{}, err
+		}
+		// The flags of the node group come first so that the ones of the config take precedence.
+		nodeInput.KubeletFlags = slices.Concat(nodegroupFlags, nodeInput.KubeletFlags)
+	}
+	if config.Spec.Hybrid != nil {
+		hybridInput, err := hybridNodeadmInput(ctx, fileResolver, config, controlPlane, configOwner)
+		if err != nil {
+			log.Info("Failed to resolve hybrid node configuration for user data")
+			v1beta1conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+			return ctrl.Result{}, err
+		}
+		nodeInput.Region = controlPlane.Spec.Region
+		nodeInput.Hybrid = hybridInput
+	}
 
 	// Fetch CA cert from KubeConfig secret
 	obj := client.ObjectKey{
@@ -467,6 +497,114 @@ func (r *NodeadmConfigReconciler) ClusterToNodeadmConfigs(_ context.Context, o c
 	return result
 }
 
+// hybridNodeadmInput returns the configuration of a hybrid node, resolving the SSM activation code from its secret.
+func hybridNodeadmInput(ctx context.Context, fileResolver FileResolver, config *eksbootstrapv1.NodeadmConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane, configOwner *bsutil.ConfigOwner) (*userdata.NodeadmHybridInput, error) {
+	kubernetesVersion := configOwner.KubernetesVersion()
+	if kubernetesVersion == "" {
+		kubernetesVersion = ptr.Deref(controlPlane.Spec.Version, "")
+	}
+	v, err := version.ParseGeneric(kubernetesVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse kubernetes version %q of hybrid node", kubernetesVersion)
+	}
+	hybrid := config.Spec.Hybrid
+	hybridInput := &userdata.NodeadmHybridInput{
+		KubernetesVersion: fmt.Sprintf("%d.%d", v.Major(), v.Minor()),
+		NodeadmVersion:    hybrid.NodeadmVersion,
+	}
+
+	if hybrid.SSM != nil {
+		activationCode, err := fileResolver.ResolveSecretFileContent(ctx, config.Namespace, eksbootstrapv1.File{
+			ContentFrom: &eksbootstrapv1.FileSource{Secret: hybrid.SSM.ActivationCodeSecret},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve SSM activation code")
+		}
+		hybridInput.SSM = &userdata.NodeadmHybridSSMInput{
+			ActivationID:   hybrid.SSM.ActivationID,
+			ActivationCode: string(activationCode),
+		}
+	}
+	if hybrid.IAMRolesAnywhere != nil {
+		hybridInput.IAMRolesAnywhere = hybrid.IAMRolesAnywhere.DeepCopy()
+		if hybridInput.IAMRolesAnywhere.NodeName == "" {
+			hybridInput.IAMRolesAnywhere.NodeName = configOwner.GetName()
+		}
+	}
+	return hybridInput, nil
+}
+
+// managedNodegroupKubeletFlags returns the kubelet flags registering the nodes of an EKS managed node group using a
+// launch template AMI with the labels and taints of the node group. EKS considers the AMI of a launch template as a
+// custom AMI and doesn't add them to the user data of the nodes, which it still does for launch templates without AMI.
+func (r *NodeadmConfigReconciler) managedNodegroupKubeletFlags(ctx context.Context, namespace string, configOwner *bsutil.ConfigOwner) ([]string, error) {
+	infraRef, _, err := unstructured.NestedStringMap(configOwner.Object, "spec", "template", "spec", "infrastructureRef")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the infrastructure reference of the machine pool")
+	}
+	if infraRef["kind"] != kindAWSManagedMachinePool || infraRef["name"] == "" {
+		return nil, nil
+	}
+
+	pool := &expinfrav1.AWSManagedMachinePool{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: infraRef["name"]}, pool); err != nil {
+		return nil, errors.Wrapf(err, "failed to get AWSManagedMachinePool %s", infraRef["name"])
+	}
+	if pool.Spec.AWSLaunchTemplate == nil || pool.Spec.AWSLaunchTemplate.AMI.ID == nil {
+		return nil, nil
+	}
+
+	return nodegroupKubeletFlags(pool)
+}
+
+// nodegroupKubeletFlags returns the kubelet flags setting the labels EKS expects on the nodes of a managed node group,
+// the labels of the node group and its taints.
+func nodegroupKubeletFlags(pool *expinfrav1.AWSManagedMachinePool) ([]string, error) {
+	capacityType, err := converters.CapacityTypeToSDK(ptr.Deref(pool.Spec.CapacityType, expinfrav1.ManagedMachinePoolCapacityTypeOnDemand))
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string]string{
+		"eks.amazonaws.com/nodegroup":    pool.Spec.EKSNodegroupName,
+		"eks.amazonaws.com/capacityType": string(capacityType),
+	}
+	maps.Copy(labels, pool.Spec.Labels)
+
+	nodeLabels := make([]string, 0, len(labels))
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		nodeLabels = append(nodeLabels, fmt.Sprintf("%s=%s", k, labels[k]))
+	}
+	flags := []string{"--node-labels=" + strings.Join(nodeLabels, ",")}
+
+	if len(pool.Spec.Taints) > 0 {
+		taints := make([]string, 0, len(pool.Spec.Taints))
+		for _, taint := range pool.Spec.Taints {
+			effect, err := taintEffect(taint.Effect)
+			if err != nil {
+				return nil, err
+			}
+			taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, effect))
+		}
+		flags = append(flags, "--register-with-taints="+strings.Join(taints, ","))
+	}
+
+	return flags, nil
+}
+
+// taintEffect converts the effect of a node group taint to the effect of a Kubernetes taint.
+func taintEffect(effect expinfrav1.TaintEffect) (corev1.TaintEffect, error) {
+	switch effect {
+	case expinfrav1.TaintEffectNoSchedule:
+		return corev1.TaintEffectNoSchedule, nil
+	case expinfrav1.TaintEffectNoExecute:
+		return corev1.TaintEffectNoExecute, nil
+	case expinfrav1.TaintEffectPreferNoSchedule:
+		return corev1.TaintEffectPreferNoSchedule, nil
+	default:
+		return "", errors.Errorf("unknown taint effect %q", effect)
+	}
+}
+
 func extractCAFromSecret(ctx context.Context, c client.Client, obj client.ObjectKey) (string, error) {
 	data, err := kubeconfigutil.FromSecret(ctx, c, obj)
 	if err != nil {
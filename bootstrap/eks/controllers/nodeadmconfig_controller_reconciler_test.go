@@ -25,13 +25,19 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
 )
 
 func TestNodeadmConfigReconciler_CreateSecret(t *testing.T) {
@@ -191,6 +197,159 @@ func TestNodeadmConfigReconciler_ResolvesSecretFileReference(t *testing.T) {
 	}
 }
 
+func TestNodeadmConfigReconciler_HybridNodeWithSSM(t *testing.T) {
+	g := NewWithT(t)
+
+	amcp := newAMCP("test-cluster")
+	amcp.Spec.Region = "us-west-2"
+	amcp.Spec.Version = ptr.To("v1.31.0")
+	endpoint := clusterv1.APIEndpoint{Host: "https://9.9.9.9", Port: 6443}
+	cluster := newCluster(amcp.Name)
+	cluster.Spec.ControlPlaneEndpoint = endpoint
+	newStatus := cluster.Status
+	amcpStatus := amcp.Status
+	g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+	g.Expect(testEnv.Client.Create(ctx, cluster)).To(Succeed())
+	cluster.Status = newStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, cluster)).To(Succeed())
+	amcp.Status = amcpStatus
+	g.Expect(testEnv.Client.Status().Update(ctx, amcp)).To(Succeed())
+	kubeconfigSecret := newKubeconfigSecret("https://9.9.9.9:6443", cluster)
+	g.Expect(testEnv.Client.Create(ctx, kubeconfigSecret)).To(Succeed())
+
+	machine := newMachine(cluster, "test-machine")
+	cfg := newNodeadmConfig(machine)
+	cfg.Spec.Hybrid = &eksbootstrapv1.HybridOptions{
+		SSM: &eksbootstrapv1.HybridSSMOptions{
+			ActivationID:         "test-activation-id",
+			ActivationCodeSecret: eksbootstrapv1.SecretFileSource{Name: "hybrid-activation", Key: "code"},
+		},
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "hybrid-activation"},
+		Data:       map[string][]byte{"code": []byte("test-activation-code")},
+	}
+	g.Expect(testEnv.Client.Create(ctx, secret)).To(Succeed())
+
+	expectedContains := []string{
+		"region: us-west-2",
+		`activationCode: "test-activation-code"`,
+		`activationId: "test-activation-id"`,
+		"nodeadm install 1.31 --credential-provider ssm",
+	}
+
+	reconciler := NodeadmConfigReconciler{Client: testEnv.Client}
+	g.Eventually(func(gomega Gomega) {
+		_, err := reconciler.joinWorker(ctx, cluster, cfg, configOwner("Machine"))
+		gomega.Expect(err).NotTo(HaveOccurred())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	got := &corev1.Secret{}
+	g.Eventually(func(gomega Gomega) {
+		gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{Name: cfg.Name, Namespace: "default"}, got)).To(Succeed())
+	}, time.Minute, time.Second*5).Should(Succeed())
+
+	for _, s := range expectedContains {
+		g.Expect(string(got.Data["value"])).To(ContainSubstring(s), "userdata should contain %q", s)
+	}
+}
+
+func TestNodegroupKubeletFlags(t *testing.T) {
+	g := NewWithT(t)
+
+	pool := &expinfrav1.AWSManagedMachinePool{
+		Spec: expinfrav1.AWSManagedMachinePoolSpec{
+			EKSNodegroupName: "default_pool-0",
+			CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeSpot),
+			Labels:           map[string]string{"tier": "workers"},
+			Taints: expinfrav1.Taints{
+				{Key: "dedicated", Value: "gpu", Effect: expinfrav1.TaintEffectNoSchedule},
+				{Key: "spot", Value: "true", Effect: expinfrav1.TaintEffectPreferNoSchedule},
+			},
+		},
+	}
+
+	flags, err := nodegroupKubeletFlags(pool)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(flags).To(Equal([]string{
+		"--node-labels=eks.amazonaws.com/capacityType=SPOT,eks.amazonaws.com/nodegroup=default_pool-0,tier=workers",
+		"--register-with-taints=dedicated=gpu:NoSchedule,spot=true:PreferNoSchedule",
+	}))
+
+	pool.Spec.CapacityType = nil
+	pool.Spec.Labels = nil
+	pool.Spec.Taints = nil
+	flags, err = nodegroupKubeletFlags(pool)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(flags).To(Equal([]string{
+		"--node-labels=eks.amazonaws.com/capacityType=ON_DEMAND,eks.amazonaws.com/nodegroup=default_pool-0",
+	}))
+}
+
+func TestManagedNodegroupKubeletFlags(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(expinfrav1.AddToScheme(scheme)).To(Succeed())
+
+	owner := &bsutil.ConfigOwner{Unstructured: &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "MachinePool",
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"infrastructureRef": map[string]interface{}{
+						"kind": kindAWSManagedMachinePool,
+						"name": "pool-0",
+					},
+				},
+			},
+		},
+	}}}
+
+	tests := []struct {
+		name           string
+		launchTemplate *expinfrav1.AWSLaunchTemplate
+		expectedFlags  []string
+	}{
+		{
+			name: "no launch template",
+		},
+		{
+			name:           "launch template without AMI",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "pool-0"},
+		},
+		{
+			name: "launch template with AMI",
+			launchTemplate: &expinfrav1.AWSLaunchTemplate{
+				Name: "pool-0",
+				AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+			},
+			expectedFlags: []string{
+				"--node-labels=eks.amazonaws.com/capacityType=ON_DEMAND,eks.amazonaws.com/nodegroup=default_pool-0",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			pool := &expinfrav1.AWSManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pool-0"},
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "default_pool-0",
+					AWSLaunchTemplate: tc.launchTemplate,
+				},
+			}
+			reconciler := NodeadmConfigReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(pool).Build()}
+
+			flags, err := reconciler.managedNodegroupKubeletFlags(ctx, "default", owner)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(flags).To(Equal(tc.expectedFlags))
+		})
+	}
+}
+
 func TestNodeadmConfigReconcilerReturnEarlyIfClusterInfraNotReady(t *testing.T) {
 	g := NewWithT(t)
 
@@ -73,11 +73,8 @@ set -o nounset
 {{- end}}
 --{{ .Boundary }}`
 
-	// Node config part template for nodeadm.
-	nodeConfigPartTemplate = `
---{{.Boundary}}
-Content-Type: application/node.eks.aws
-
+	// Node config template for nodeadm.
+	nodeConfigTemplate = `{{define "nodeConfig" -}}
 ---
 apiVersion: node.eks.aws/v1alpha1
 kind: NodeConfig
@@ -87,6 +84,26 @@ spec:
     apiServerEndpoint: {{.APIServerEndpoint}}
     certificateAuthority: {{.CACert}}
     cidr: {{if .ServiceCIDR}}{{.ServiceCIDR}}{{else}}172.20.0.0/16{{end}}
+    {{- if .Region }}
+    region: {{.Region}}
+    {{- end }}
+  {{- if .Hybrid }}
+  hybrid:
+    {{- if .Hybrid.SSM }}
+    ssm:
+      activationCode: "{{.Hybrid.SSM.ActivationCode}}"
+      activationId: "{{.Hybrid.SSM.ActivationID}}"
+    {{- end }}
+    {{- if .Hybrid.IAMRolesAnywhere }}
+    iamRolesAnywhere:
+      nodeName: {{.Hybrid.IAMRolesAnywhere.NodeName}}
+      trustAnchorArn: {{.Hybrid.IAMRolesAnywhere.TrustAnchorARN}}
+      profileArn: {{.Hybrid.IAMRolesAnywhere.ProfileARN}}
+      roleArn: {{.Hybrid.IAMRolesAnywhere.RoleARN}}
+      certificatePath: {{.Hybrid.IAMRolesAnywhere.CertificatePath}}
+      privateKeyPath: {{.Hybrid.IAMRolesAnywhere.PrivateKeyPath}}
+    {{- end }}
+  {{- end }}
   {{- if .FeatureGates }}
   featureGates:
   {{- range $k, $v := .FeatureGates }}
@@ -117,10 +134,85 @@ spec:
 {{ Indent 6 (toYaml .ContainerdBaseRuntimeSpec) }}
     {{- end }}
   {{- end }}
+{{- end}}`
+
+	// Node config part template for nodeadm.
+	nodeConfigPartTemplate = `
+--{{.Boundary}}
+Content-Type: application/node.eks.aws
+
+{{template "nodeConfig" .}}
 
 --{{.Boundary}}`
+
+	// Hybrid node part template, which installs nodeadm and initializes the node with the node config
+	// as hybrid nodes are not started from an EKS AMI that runs nodeadm.
+	hybridScriptPartTemplate = `
+--{{.Boundary}}
+Content-Type: text/x-shellscript; charset="us-ascii"
+MIME-Version: 1.0
+Content-Transfer-Encoding: 7bit
+Content-Disposition: attachment; filename="nodeadm-hybrid.sh"
+
+#!/bin/bash
+set -o errexit
+set -o pipefail
+set -o nounset
+mkdir -p "$(dirname {{.Hybrid.NodeConfigPath}})"
+cat > {{.Hybrid.NodeConfigPath}} <<'EOF'
+{{template "nodeConfig" .}}
+EOF
+if ! command -v nodeadm >/dev/null 2>&1; then
+  case "$(uname -m)" in
+    aarch64) arch=arm64 ;;
+    *) arch=amd64 ;;
+  esac
+  curl -sSfL -o /usr/local/bin/nodeadm "{{.Hybrid.NodeadmURL}}/${arch}/nodeadm"
+  chmod +x /usr/local/bin/nodeadm
+fi
+nodeadm install {{.Hybrid.KubernetesVersion}} --credential-provider {{.Hybrid.CredentialProvider}}
+nodeadm init --config-source file://{{.Hybrid.NodeConfigPath}}
+--{{.Boundary}}`
+
+	// hybridNodeConfigPath is the path of the node config of hybrid nodes.
+	hybridNodeConfigPath = "/etc/eks/hybrid/nodeConfig.yaml"
+
+	// hybridNodeadmURLFormat is the location of a nodeadm release for hybrid nodes.
+	hybridNodeadmURLFormat = "https://hybrid-assets.eks.amazonaws.com/releases/%s/bin/linux"
+
+	// latestNodeadmVersion is the nodeadm release installed on hybrid nodes when none is pinned.
+	latestNodeadmVersion = "latest"
 )
 
+// NodeadmHybridInput contains the information required to join a hybrid node to the cluster.
+type NodeadmHybridInput struct {
+	// KubernetesVersion is the MAJOR.MINOR Kubernetes version of the components installed by nodeadm.
+	KubernetesVersion string
+
+	SSM              *NodeadmHybridSSMInput
+	IAMRolesAnywhere *eksbootstrapv1.HybridIAMRolesAnywhereOptions
+
+	// NodeadmVersion is the nodeadm release installed on the node. Defaults to the latest release.
+	NodeadmVersion string
+
+	NodeConfigPath string
+	NodeadmURL     string
+}
+
+// NodeadmHybridSSMInput contains the AWS Systems Manager hybrid activation of a hybrid node.
+type NodeadmHybridSSMInput struct {
+	ActivationID   string
+	ActivationCode string
+}
+
+// CredentialProvider returns the nodeadm credential provider of the hybrid node.
+func (h *NodeadmHybridInput) CredentialProvider() string {
+	if h.IAMRolesAnywhere != nil {
+		return "iam-ra"
+	}
+	return "ssm"
+}
+
 // NodeadmInput contains all the information required to generate user data for a node.
 type NodeadmInput struct {
 	ClusterName               string
@@ -143,6 +235,10 @@ type NodeadmInput struct {
 	CACert            string
 	ServiceCIDR       string // Service CIDR range for the cluster
 	ClusterDNS        string
+
+	// Region and Hybrid are only set for hybrid nodes.
+	Region string
+	Hybrid *NodeadmHybridInput
 }
 
 // validateNodeInput validates the input for nodeadm user data generation.
@@ -159,6 +255,38 @@ func validateNodeadmInput(input *NodeadmInput) error {
 	if input.Boundary == "" {
 		input.Boundary = boundary
 	}
+	if input.Hybrid != nil {
+		if err := validateNodeadmHybridInput(input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNodeadmHybridInput validates the input for hybrid nodes and sets its defaults.
+func validateNodeadmHybridInput(input *NodeadmInput) error {
+	if input.Region == "" {
+		return fmt.Errorf("region is required for hybrid nodes")
+	}
+	if input.Hybrid.KubernetesVersion == "" {
+		return fmt.Errorf("kubernetes version is required for hybrid nodes")
+	}
+	if (input.Hybrid.SSM == nil) == (input.Hybrid.IAMRolesAnywhere == nil) {
+		return fmt.Errorf("exactly one of SSM or IAM Roles Anywhere is required for hybrid nodes")
+	}
+	if input.Hybrid.IAMRolesAnywhere != nil && input.Hybrid.IAMRolesAnywhere.NodeName == "" {
+		return fmt.Errorf("node name is required for hybrid nodes using IAM Roles Anywhere")
+	}
+	if input.Hybrid.NodeConfigPath == "" {
+		input.Hybrid.NodeConfigPath = hybridNodeConfigPath
+	}
+	if input.Hybrid.NodeadmVersion == "" {
+		input.Hybrid.NodeadmVersion = latestNodeadmVersion
+	}
+	if input.Hybrid.NodeadmURL == "" {
+		input.Hybrid.NodeadmURL = fmt.Sprintf(hybridNodeadmURLFormat, input.Hybrid.NodeadmVersion)
+	}
 
 	return nil
 }
@@ -187,13 +315,19 @@ func NewNodeadmUserdata(input *NodeadmInput) ([]byte, error) {
 		}
 	}
 
-	// Write node config part
-	nodeConfigTemplate := template.Must(
-		template.New("node").
-			Funcs(defaultTemplateFuncMap).
-			Parse(nodeConfigPartTemplate),
+	// Write node config part, hybrid nodes get it from the script that installs nodeadm
+	partTemplate := nodeConfigPartTemplate
+	if input.Hybrid != nil {
+		partTemplate = hybridScriptPartTemplate
+	}
+	nodeTemplate := template.Must(
+		template.Must(
+			template.New("node").
+				Funcs(defaultTemplateFuncMap).
+				Parse(nodeConfigTemplate),
+		).Parse(partTemplate),
 	)
-	if err := nodeConfigTemplate.Execute(&buf, input); err != nil {
+	if err := nodeTemplate.Execute(&buf, input); err != nil {
 		return nil, fmt.Errorf("failed to execute node config template: %v", err)
 	}
 
@@ -380,6 +380,92 @@ evictionHard:
 			},
 			expectErr: true,
 		},
+		{
+			name: "hybrid node with ssm",
+			args: args{
+				input: &NodeadmInput{
+					ClusterName:       "test-cluster",
+					APIServerEndpoint: "https://example.com",
+					CACert:            "test-ca-cert",
+					Region:            "us-west-2",
+					Hybrid: &NodeadmHybridInput{
+						KubernetesVersion: "1.31",
+						SSM:               &NodeadmHybridSSMInput{ActivationID: "test-id", ActivationCode: "test-code"},
+					},
+				},
+			},
+			expectErr: false,
+			verifyOutput: func(output string) bool {
+				return !strings.Contains(output, "Content-Type: application/node.eks.aws") &&
+					strings.Contains(output, "cat > /etc/eks/hybrid/nodeConfig.yaml <<'EOF'") &&
+					strings.Contains(output, "region: us-west-2") &&
+					strings.Contains(output, "  hybrid:\n    ssm:\n      activationCode: \"test-code\"\n      activationId: \"test-id\"") &&
+					strings.Contains(output, "https://hybrid-assets.eks.amazonaws.com/releases/latest/bin/linux/${arch}/nodeadm") &&
+					strings.Contains(output, "nodeadm install 1.31 --credential-provider ssm") &&
+					strings.Contains(output, "nodeadm init --config-source file:///etc/eks/hybrid/nodeConfig.yaml")
+			},
+		},
+		{
+			name: "hybrid node with iam roles anywhere",
+			args: args{
+				input: &NodeadmInput{
+					ClusterName:       "test-cluster",
+					APIServerEndpoint: "https://example.com",
+					CACert:            "test-ca-cert",
+					Region:            "us-west-2",
+					Hybrid: &NodeadmHybridInput{
+						KubernetesVersion: "1.31",
+						NodeadmVersion:    "v1.0.0",
+						IAMRolesAnywhere: &eksbootstrapv1.HybridIAMRolesAnywhereOptions{
+							NodeName:        "test-node",
+							TrustAnchorARN:  "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/ta",
+							ProfileARN:      "arn:aws:rolesanywhere:us-west-2:123456789012:profile/p",
+							RoleARN:         "arn:aws:iam::123456789012:role/hybrid-nodes",
+							CertificatePath: "/etc/iam/pki/server.pem",
+							PrivateKeyPath:  "/etc/iam/pki/server.key",
+						},
+					},
+				},
+			},
+			expectErr: false,
+			verifyOutput: func(output string) bool {
+				return strings.Contains(output, "iamRolesAnywhere:\n      nodeName: test-node") &&
+					strings.Contains(output, "roleArn: arn:aws:iam::123456789012:role/hybrid-nodes") &&
+					strings.Contains(output, "certificatePath: /etc/iam/pki/server.pem") &&
+					strings.Contains(output, "https://hybrid-assets.eks.amazonaws.com/releases/v1.0.0/bin/linux/${arch}/nodeadm") &&
+					strings.Contains(output, "nodeadm install 1.31 --credential-provider iam-ra")
+			},
+		},
+		{
+			name: "hybrid node without region",
+			args: args{
+				input: &NodeadmInput{
+					ClusterName:       "test-cluster",
+					APIServerEndpoint: "https://example.com",
+					CACert:            "test-ca-cert",
+					Hybrid: &NodeadmHybridInput{
+						KubernetesVersion: "1.31",
+						SSM:               &NodeadmHybridSSMInput{ActivationID: "test-id", ActivationCode: "test-code"},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "hybrid node without credential provider",
+			args: args{
+				input: &NodeadmInput{
+					ClusterName:       "test-cluster",
+					APIServerEndpoint: "https://example.com",
+					CACert:            "test-ca-cert",
+					Region:            "us-west-2",
+					Hybrid: &NodeadmHybridInput{
+						KubernetesVersion: "1.31",
+					},
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, testcase := range tests {
@@ -223,6 +223,9 @@ func autoConvert_v1beta1_AWSIAMConfigurationSpec_To_v1alpha1_AWSIAMConfiguration
 	out.Partition = in.Partition
 	out.SecureSecretsBackends = *(*[]v1beta2.SecretBackend)(unsafe.Pointer(&in.SecureSecretsBackends))
 	// WARNING: in.S3Buckets requires manual conversion: does not exist in peer-type
+	// WARNING: in.KMSKeys requires manual conversion: does not exist in peer-type
+	// WARNING: in.RolesAnywhere requires manual conversion: does not exist in peer-type
+	// WARNING: in.Karpenter requires manual conversion: does not exist in peer-type
 	// WARNING: in.AllowAssumeRole requires manual conversion: does not exist in peer-type
 	return nil
 }
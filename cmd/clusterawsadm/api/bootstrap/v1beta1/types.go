@@ -178,6 +178,27 @@ type S3Buckets struct {
 	NamePrefix string `json:"namePrefix"`
 }
 
+// KMSKeys controls the configuration of the AWS IAM role for the KMS keys
+// which can be created for encrypting the volumes and secrets of a cluster.
+type KMSKeys struct {
+	// Enable controls whether permissions are granted to manage KMS keys.
+	Enable bool `json:"enable"`
+}
+
+// RolesAnywhere controls the configuration of the AWS IAM role for the IAM Roles Anywhere
+// trust anchors and profiles which can be created for the nodes of a cluster.
+type RolesAnywhere struct {
+	// Enable controls whether permissions are granted to manage IAM Roles Anywhere trust anchors and profiles.
+	Enable bool `json:"enable"`
+}
+
+// Karpenter controls the configuration of the AWS IAM role for the Karpenter controller roles
+// and interruption queues which can be created for a cluster.
+type Karpenter struct {
+	// Enable controls whether permissions are granted to manage Karpenter controller roles and interruption queues.
+	Enable bool `json:"enable"`
+}
+
 // AWSIAMConfigurationSpec defines the specification of the AWSIAMConfiguration.
 type AWSIAMConfigurationSpec struct {
 	// NamePrefix will be prepended to every AWS IAM role, user and policy created by clusterawsadm. Defaults to "".
@@ -223,8 +244,9 @@ type AWSIAMConfigurationSpec struct {
 
 	// SecureSecretsBackend, when set to parameter-store will create AWS Systems Manager
 	// Parameter Storage policies. By default or with the value of secrets-manager,
-	// will generate AWS Secrets Manager policies instead.
-	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store
+	// will generate AWS Secrets Manager policies instead. With the value of cluster-object-store,
+	// nodes are allowed to fetch their bootstrap data from the S3 buckets enabled by S3Buckets.
+	// +kubebuilder:validation:Enum=secrets-manager;ssm-parameter-store;cluster-object-store
 	SecureSecretsBackends []infrav1.SecretBackend `json:"secureSecretBackends,omitempty"`
 
 	// S3Buckets, when enabled, will add controller nodes permissions to
@@ -233,6 +255,21 @@ type AWSIAMConfigurationSpec struct {
 	// +optional
 	S3Buckets S3Buckets `json:"s3Buckets,omitempty"`
 
+	// KMSKeys, when enabled, will add controller nodes permissions to
+	// create KMS keys for workload clusters.
+	// +optional
+	KMSKeys KMSKeys `json:"kmsKeys,omitempty"`
+
+	// RolesAnywhere, when enabled, will add controller nodes permissions to
+	// create IAM Roles Anywhere trust anchors and profiles for workload clusters.
+	// +optional
+	RolesAnywhere RolesAnywhere `json:"rolesAnywhere,omitempty"`
+
+	// Karpenter, when enabled, will add controller nodes permissions to
+	// create Karpenter controller roles and interruption queues for workload clusters.
+	// +optional
+	Karpenter Karpenter `json:"karpenter,omitempty"`
+
 	// AllowAssumeRole enables the sts:AssumeRole permission within the CAPA policies
 	AllowAssumeRole bool `json:"allowAssumeRole,omitempty"`
 }
@@ -86,6 +86,9 @@ func (in *AWSIAMConfigurationSpec) DeepCopyInto(out *AWSIAMConfigurationSpec) {
 		copy(*out, *in)
 	}
 	out.S3Buckets = in.S3Buckets
+	out.KMSKeys = in.KMSKeys
+	out.RolesAnywhere = in.RolesAnywhere
+	out.Karpenter = in.Karpenter
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSIAMConfigurationSpec.
@@ -256,6 +259,36 @@ func (in *EventBridgeConfig) DeepCopy() *EventBridgeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSKeys) DeepCopyInto(out *KMSKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSKeys.
+func (in *KMSKeys) DeepCopy() *KMSKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Karpenter) DeepCopyInto(out *Karpenter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Karpenter.
+func (in *Karpenter) DeepCopy() *Karpenter {
+	if in == nil {
+		return nil
+	}
+	out := new(Karpenter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Nodes) DeepCopyInto(out *Nodes) {
 	*out = *in
@@ -272,6 +305,21 @@ func (in *Nodes) DeepCopy() *Nodes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolesAnywhere) DeepCopyInto(out *RolesAnywhere) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolesAnywhere.
+func (in *RolesAnywhere) DeepCopy() *RolesAnywhere {
+	if in == nil {
+		return nil
+	}
+	out := new(RolesAnywhere)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Buckets) DeepCopyInto(out *S3Buckets) {
 	*out = *in
@@ -316,6 +316,114 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 			},
 		})
 	}
+	if t.Spec.KMSKeys.Enable {
+		statement = append(statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"kms:CreateKey",
+			},
+		}, iamv1.StatementEntry{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:kms:*:*:key/*",
+				"arn:*:kms:*:*:alias/cluster-api-provider-aws/*",
+			},
+			Action: iamv1.Actions{
+				"kms:CreateAlias",
+				"kms:CreateGrant",
+				"kms:Decrypt",
+				"kms:DeleteAlias",
+				"kms:DescribeKey",
+				"kms:EnableKeyRotation",
+				"kms:GenerateDataKeyWithoutPlaintext",
+				"kms:GetKeyPolicy",
+				"kms:GetKeyRotationStatus",
+				"kms:ListResourceTags",
+				"kms:PutKeyPolicy",
+				"kms:ReEncrypt*",
+				"kms:ScheduleKeyDeletion",
+				"kms:TagResource",
+			},
+		}, iamv1.StatementEntry{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:iam::*:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling",
+			},
+			Action: iamv1.Actions{
+				"iam:GetRole",
+			},
+		})
+	}
+	if t.Spec.RolesAnywhere.Enable {
+		statement = append(statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"rolesanywhere:CreateProfile",
+				"rolesanywhere:CreateTrustAnchor",
+				"rolesanywhere:ListProfiles",
+				"rolesanywhere:ListTrustAnchors",
+			},
+		}, iamv1.StatementEntry{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:rolesanywhere:*:*:profile/*",
+				"arn:*:rolesanywhere:*:*:trust-anchor/*",
+			},
+			Action: iamv1.Actions{
+				"rolesanywhere:DeleteProfile",
+				"rolesanywhere:DeleteTrustAnchor",
+				"rolesanywhere:GetProfile",
+				"rolesanywhere:GetTrustAnchor",
+				"rolesanywhere:ListTagsForResource",
+				"rolesanywhere:TagResource",
+				"rolesanywhere:UpdateProfile",
+				"rolesanywhere:UpdateTrustAnchor",
+			},
+		})
+	}
+	if t.Spec.Karpenter.Enable {
+		statement = append(statement, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{"arn:*:iam::*:role/*"},
+			Action: iamv1.Actions{
+				"iam:GetRole",
+			},
+		}, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{"arn:*:iam::*:role/karpenter-controller-*"},
+			Action: iamv1.Actions{
+				"iam:CreateRole",
+				"iam:DeleteRole",
+				"iam:DeleteRolePolicy",
+				"iam:DetachRolePolicy",
+				"iam:ListAttachedRolePolicies",
+				"iam:PutRolePolicy",
+				"iam:TagRole",
+				"iam:UntagRole",
+				"iam:UpdateAssumeRolePolicy",
+			},
+		}, iamv1.StatementEntry{
+			Effect:   iamv1.EffectAllow,
+			Resource: iamv1.Resources{iamv1.Any},
+			Action: iamv1.Actions{
+				"events:DeleteRule",
+				"events:DescribeRule",
+				"events:ListTargetsByRule",
+				"events:PutRule",
+				"events:PutTargets",
+				"events:RemoveTargets",
+				"events:TagResource",
+				"sqs:CreateQueue",
+				"sqs:DeleteQueue",
+				"sqs:GetQueueAttributes",
+				"sqs:GetQueueUrl",
+				"sqs:SetQueueAttributes",
+				"sqs:TagQueue",
+			},
+		})
+	}
 	if t.Spec.EventBridge.Enable {
 		statement = append(statement, iamv1.StatementEntry{
 			Effect:   iamv1.EffectAllow,
@@ -463,6 +571,7 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:AssociateIdentityProviderConfig",
 				"eks:DescribeIdentityProviderConfig",
 				"eks:DisassociateIdentityProviderConfig",
+				"eks:ListInsights",
 			},
 			Resource: iamv1.Resources{
 				"arn:*:eks:*:*:cluster/*",
@@ -484,6 +593,11 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:DescribeFargateProfile",
 				"eks:CreateFargateProfile",
 				"eks:DeleteFargateProfile",
+				"eks:ListPodIdentityAssociations",
+				"eks:DescribePodIdentityAssociation",
+				"eks:CreatePodIdentityAssociation",
+				"eks:UpdatePodIdentityAssociation",
+				"eks:DeletePodIdentityAssociation",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -504,6 +618,20 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			},
 			Effect: iamv1.EffectAllow,
 		},
+		{
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Condition: iamv1.Conditions{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "pods.eks.amazonaws.com",
+				},
+			},
+			Effect: iamv1.EffectAllow,
+		},
 		{
 			Action: iamv1.Actions{
 				"kms:CreateGrant",
@@ -17,6 +17,8 @@ limitations under the License.
 package bootstrap
 
 import (
+	"fmt"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 )
@@ -45,6 +47,17 @@ func (t Template) secretPolicy(secureSecretsBackend infrav1.SecretBackend) iamv1
 				"ssm:GetParameter",
 			},
 		}
+	case infrav1.SecretBackendClusterObjectStore:
+		return iamv1.StatementEntry{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				fmt.Sprintf("arn:*:s3:::%s*/secrets/*", t.Spec.S3Buckets.NamePrefix),
+			},
+			Action: iamv1.Actions{
+				"s3:DeleteObject",
+				"s3:GetObject",
+			},
+		}
 	}
 	return iamv1.StatementEntry{}
 }
@@ -61,6 +61,17 @@ func TestRenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_cluster_object_store_secret_backend",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.S3Buckets.Enable = true
+				t.Spec.SecureSecretsBackends = []infrav1.SecretBackend{
+					infrav1.SecretBackendClusterObjectStore,
+				}
+				return t
+			},
+		},
 		{
 			fixture: "with_s3_bucket",
 			template: func() Template {
@@ -69,6 +80,30 @@ func TestRenderCloudformation(t *testing.T) {
 				return t
 			},
 		},
+		{
+			fixture: "with_kms_keys",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.KMSKeys.Enable = true
+				return t
+			},
+		},
+		{
+			fixture: "with_roles_anywhere",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.RolesAnywhere.Enable = true
+				return t
+			},
+		},
+		{
+			fixture: "with_karpenter",
+			template: func() Template {
+				t := NewTemplate()
+				t.Spec.Karpenter.Enable = true
+				return t
+			},
+		},
 		{
 			fixture: "customsuffix",
 			template: func() Template {
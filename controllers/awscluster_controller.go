@@ -24,6 +24,7 @@ import (
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
@@ -45,7 +46,10 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/elb"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/karpenter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/rolesanywhere"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -57,6 +61,7 @@ import (
 	capiannotations "sigs.k8s.io/cluster-api/util/annotations"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/secret"
 )
 
 const (
@@ -136,7 +141,7 @@ func (r *AWSClusterReconciler) getSecurityGroupService(scope scope.ClusterScope)
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclusterwebidentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclustercontrolleridentities,verbs=get;list;watch;create
 
 func (r *AWSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -262,6 +267,11 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting bastion"))
 	}
 
+	// The discovery tags of Karpenter are removed before the security groups are deleted.
+	if err := karpenter.NewService(clusterScope).DeleteKarpenter(ctx); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting Karpenter resources"))
+	}
+
 	if err := sgService.DeleteSecurityGroups(); err != nil {
 		allErrs = append(allErrs, errors.Wrap(err, "error deleting security groups"))
 	}
@@ -277,6 +287,18 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		allErrs = append(allErrs, errors.Wrap(err, "error deleting network"))
 	}
 
+	if err := rolesanywhere.NewService(clusterScope).DeleteRolesAnywhere(ctx); err != nil {
+		allErrs = append(allErrs, errors.Wrap(err, "error deleting IAM Roles Anywhere resources"))
+	}
+
+	// The cluster KMS key is deleted last, as the volumes of the instances and the secrets
+	// of the cluster may be encrypted with it.
+	if clusterScope.KMSKey() != nil {
+		if err := kms.NewService(clusterScope).DeleteKey(ctx); err != nil {
+			allErrs = append(allErrs, errors.Wrap(err, "error deleting KMS key"))
+		}
+	}
+
 	if len(allErrs) > 0 {
 		return reconcile.Result{}, kerrors.NewAggregate(allErrs)
 	}
@@ -358,6 +380,14 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 		return reconcile.Result{}, err
 	}
 
+	if clusterScope.KMSKey() != nil {
+		if err := kms.NewService(clusterScope).ReconcileKey(ctx); err != nil {
+			v1beta1conditions.MarkFalse(awsCluster, infrav1.KMSKeyReadyCondition, infrav1.KMSKeyFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile KMS key for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+		}
+		v1beta1conditions.MarkTrue(awsCluster, infrav1.KMSKeyReadyCondition)
+	}
+
 	if err := ec2Service.ReconcileBastion(); err != nil {
 		v1beta1conditions.MarkFalse(awsCluster, infrav1.BastionHostReadyCondition, infrav1.BastionHostFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
 		clusterScope.Error(err, "failed to reconcile bastion host")
@@ -384,6 +414,21 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 	}
 	v1beta1conditions.MarkTrue(awsCluster, infrav1.S3BucketReadyCondition)
 
+	// The Karpenter resources are deleted when Karpenter is removed from the cluster.
+	if err := karpenter.NewService(clusterScope).ReconcileKarpenter(ctx); err != nil {
+		v1beta1conditions.MarkFalse(awsCluster, infrav1.KarpenterReadyCondition, infrav1.KarpenterFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile Karpenter resources for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	if clusterScope.Karpenter() != nil {
+		v1beta1conditions.MarkTrue(awsCluster, infrav1.KarpenterReadyCondition)
+	} else {
+		v1beta1conditions.Delete(awsCluster, infrav1.KarpenterReadyCondition)
+	}
+
+	if err := r.reconcileRolesAnywhere(ctx, clusterScope); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	for _, subnet := range clusterScope.Subnets().FilterPrivate() {
 		found := false
 		for _, az := range awsCluster.Status.Network.APIServerELB.AvailabilityZones {
@@ -399,9 +444,35 @@ func (r *AWSClusterReconciler) reconcileNormal(ctx context.Context, clusterScope
 	}
 
 	awsCluster.Status.Ready = true
+
 	return reconcile.Result{}, nil
 }
 
+// reconcileRolesAnywhere reconciles the IAM Roles Anywhere trust anchor and profile of the cluster. The trust anchor
+// trusts the IAM Roles Anywhere CA of the cluster, which is generated unless its secret is provided by the user.
+func (r *AWSClusterReconciler) reconcileRolesAnywhere(ctx context.Context, clusterScope *scope.ClusterScope) error {
+	awsCluster := clusterScope.AWSCluster
+	if clusterScope.RolesAnywhere() == nil {
+		return nil
+	}
+
+	ca := secret.Certificates{&secret.Certificate{Purpose: rolesAnywhereCA}}
+	owner := *metav1.NewControllerRef(awsCluster, infrav1.GroupVersion.WithKind(kindAWSCluster))
+	if err := ca.LookupOrGenerate(ctx, r.Client, types.NamespacedName{Namespace: awsCluster.Namespace, Name: clusterScope.Name()}, owner); err != nil {
+		v1beta1conditions.MarkFalse(awsCluster, infrav1.RolesAnywhereReadyCondition, infrav1.RolesAnywhereFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return errors.Wrapf(err, "failed to get the IAM Roles Anywhere CA for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	caCert := ca[0].KeyPair.Cert
+
+	if err := rolesanywhere.NewService(clusterScope).ReconcileRolesAnywhere(ctx, caCert); err != nil {
+		v1beta1conditions.MarkFalse(awsCluster, infrav1.RolesAnywhereReadyCondition, infrav1.RolesAnywhereFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+		return errors.Wrapf(err, "failed to reconcile IAM Roles Anywhere for AWSCluster %s/%s", awsCluster.Namespace, awsCluster.Name)
+	}
+	v1beta1conditions.MarkTrue(awsCluster, infrav1.RolesAnywhereReadyCondition)
+
+	return nil
+}
+
 func (r *AWSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	log := logger.FromContext(ctx)
 	controller, err := ctrl.NewControllerManagedBy(mgr).
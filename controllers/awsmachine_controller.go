@@ -92,6 +92,7 @@ type AWSMachineReconciler struct {
 	secretsManagerServiceFactory func(cloud.ClusterScoper) services.SecretInterface
 	SSMServiceFactory            func(cloud.ClusterScoper) services.SecretInterface
 	objectStoreServiceFactory    func(cloud.ClusterScoper) services.ObjectStoreInterface
+	objectStoreSecretFactory     func(scope.S3Scope) services.SecretInterface
 	WatchFilterValue             string
 	TagUnmanagedNetworkResources bool
 	MaxWaitActiveUpdateDelete    time.Duration
@@ -125,12 +126,25 @@ func (r *AWSMachineReconciler) getSSMService(scope cloud.ClusterScoper) services
 	return ssm.NewService(scope)
 }
 
-func (r *AWSMachineReconciler) getSecretService(machineScope *scope.MachineScope, scope cloud.ClusterScoper) (services.SecretInterface, error) {
+func (r *AWSMachineReconciler) getObjectStoreSecretService(objectStoreScope scope.S3Scope) services.SecretInterface {
+	if r.objectStoreSecretFactory != nil {
+		return r.objectStoreSecretFactory(objectStoreScope)
+	}
+	return s3.NewSecretService(objectStoreScope)
+}
+
+func (r *AWSMachineReconciler) getSecretService(machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper) (services.SecretInterface, error) {
 	switch machineScope.SecureSecretsBackend() {
 	case infrav1.SecretBackendSSMParameterStore:
-		return r.getSSMService(scope), nil
+		return r.getSSMService(clusterScope), nil
 	case infrav1.SecretBackendSecretsManager:
-		return r.getSecretsManagerService(scope), nil
+		return r.getSecretsManagerService(clusterScope), nil
+	case infrav1.SecretBackendClusterObjectStore:
+		objectStoreScope, ok := clusterScope.(scope.S3Scope)
+		if !ok || objectStoreScope.Bucket() == nil {
+			return nil, errors.New("using the cluster-object-store secret backend requires a cluster wide object storage configured at `AWSCluster.spec.s3Bucket`")
+		}
+		return r.getObjectStoreSecretService(objectStoreScope), nil
 	}
 	return nil, errors.New("invalid secret backend")
 }
@@ -451,9 +465,16 @@ func (r *AWSMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 			v1beta1conditions.MarkFalse(machineScope.AWSMachine, infrav1.SecurityGroupsReadyCondition, clusterv1beta1.DeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
 		}
 
-		// Release an Elastic IP when the machine has public IP Address (EIP) with a cluster-wide config
+		// Return the Elastic IP to its claim, keeping it allocated for the replacement machine, or
+		// release an Elastic IP when the machine has public IP Address (EIP) with a cluster-wide config
 		// to consume from BYO IPv4 Pool.
-		if machineScope.GetElasticIPPool() != nil {
+		if claim := machineScope.GetElasticIPClaim(); claim != nil {
+			if err := ec2Service.ReleaseElasticIPClaim(claim, instance.ID); err != nil {
+				machineScope.Error(err, "failed to return elastic IP address to its claim")
+				return ctrl.Result{}, err
+			}
+			machineScope.SetElasticIPClaimStatus(nil)
+		} else if machineScope.GetElasticIPPool() != nil {
 			if err := ec2Service.ReleaseElasticIP(instance.ID); err != nil {
 				machineScope.Error(err, "failed to release elastic IP address")
 				return ctrl.Result{}, err
@@ -592,7 +613,8 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 	// after the instance is created and transictioned to Running state.
 	// The CreateInstance() is enforcing to not assign public IP address when PublicIP is set with
 	// BYOIpv4 Pool, preventing a duplicated EIP creation.
-	if pool := machineScope.GetElasticIPPool(); pool != nil {
+	// Elastic IP claims allocate their addresses from the pool themselves, see reconcileElasticIPClaim.
+	if pool := machineScope.GetElasticIPPool(); pool != nil && machineScope.GetElasticIPClaim() == nil {
 		requeue, err := ec2svc.ReconcileElasticIPFromPublicPool(pool, instance)
 		if err != nil {
 			machineScope.Error(err, "Failed to reconcile BYO Public IPv4")
@@ -707,6 +729,12 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+
+		claimPending, err := r.reconcileElasticIPClaim(ec2svc, machineScope, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		shouldRequeue = shouldRequeue || claimPending
 	}
 
 	machineScope.Debug("done reconciling instance", "instance", instance)
@@ -717,6 +745,31 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 	return ctrl.Result{}, nil
 }
 
+// reconcileElasticIPClaim associates the instance with a slot of its Elastic IP claim. The machine does not
+// wait for a slot to become ready, as the previous holder of the slot may be the machine it replaces, it
+// returns true instead so that the association is retried once the slot has been released.
+func (r *AWSMachineReconciler) reconcileElasticIPClaim(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) (bool, error) {
+	claim := machineScope.GetElasticIPClaim()
+	if claim == nil || instance.State != infrav1.InstanceStateRunning {
+		return false, nil
+	}
+
+	// The slots of the claim belong to the MachineDeployment of the machine, so that they aren't handed over to
+	// the machines of another MachineDeployment referencing the same claim name.
+	owner := machineScope.Machine.Labels[clusterv1.MachineDeploymentNameLabel]
+	status, err := ec2svc.ReconcileElasticIPClaim(claim, machineScope.GetElasticIPPool(), instance, owner)
+	if err != nil {
+		machineScope.Error(err, "failed to reconcile Elastic IP claim")
+		return false, err
+	}
+	machineScope.SetElasticIPClaimStatus(status)
+	if status == nil {
+		machineScope.Debug("Waiting for a free slot of the Elastic IP claim, requeue", "claim", claim.Name, "instance", instance.ID)
+		return true, nil
+	}
+	return false, nil
+}
+
 func (r *AWSMachineReconciler) reconcileOperationalState(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
 	machineScope.SetAddresses(instance.Addresses)
 
@@ -800,6 +853,14 @@ func (r *AWSMachineReconciler) resolveUserData(ctx context.Context, machineScope
 		return nil, "", err
 	}
 
+	if machineScope.AWSMachine.Spec.RolesAnywhere != nil {
+		userData, err = r.injectRolesAnywhereCredentials(ctx, machineScope, clusterScope, userData, userDataFormat)
+		if err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedRolesAnywhereCredentials", err.Error())
+			return nil, "", err
+		}
+	}
+
 	if machineScope.UseSecretsManager(userDataFormat) {
 		userData, err = r.cloudInitUserData(machineScope, clusterScope, userData)
 	}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/rolesanywhere"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/userdata"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+// rolesAnywhereCA is the suffix of the secret holding the CA trusted by the IAM Roles Anywhere trust anchor of a cluster.
+// The CA is dedicated to IAM Roles Anywhere so that certificates issued to machines don't grant access to the API server.
+const rolesAnywhereCA = secret.Purpose("rolesanywhere-ca")
+
+// rolesAnywhereCAKeyPair returns the PEM encoded certificate and private key of the IAM Roles Anywhere CA of a cluster.
+func rolesAnywhereCAKeyPair(ctx context.Context, c client.Client, namespace, clusterName string) ([]byte, []byte, error) {
+	caSecret, err := secret.GetFromNamespacedName(ctx, c, types.NamespacedName{Namespace: namespace, Name: clusterName}, rolesAnywhereCA)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get the IAM Roles Anywhere CA of cluster %s/%s", namespace, clusterName)
+	}
+	caCert, ok := caSecret.Data[secret.TLSCrtDataName]
+	if !ok {
+		return nil, nil, errors.Errorf("IAM Roles Anywhere CA secret %s/%s has no %s", caSecret.Namespace, caSecret.Name, secret.TLSCrtDataName)
+	}
+	return caCert, caSecret.Data[secret.TLSKeyDataName], nil
+}
+
+// injectRolesAnywhereCredentials signs a certificate for the machine with the IAM Roles Anywhere CA and adds it to the user data,
+// along with the AWS configuration to obtain credentials with it through IAM Roles Anywhere.
+func (r *AWSMachineReconciler) injectRolesAnywhereCredentials(ctx context.Context, machineScope *scope.MachineScope, clusterScope cloud.ClusterScoper, userData []byte, userDataFormat string) ([]byte, error) {
+	spec := machineScope.AWSMachine.Spec.RolesAnywhere
+
+	rolesAnywhereScope, ok := clusterScope.(scope.RolesAnywhereScope)
+	if !ok {
+		return nil, errors.Errorf("IAM Roles Anywhere is not supported for %s", clusterScope.InfraCluster().GetObjectKind().GroupVersionKind().Kind)
+	}
+	status := rolesAnywhereScope.RolesAnywhereStatus()
+	if status == nil || status.ProfileARN == "" {
+		return nil, errors.New("waiting for the IAM Roles Anywhere profile of the cluster")
+	}
+
+	roleARN, err := rolesAnywhereRoleARN(spec.Role, status.RoleARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	caCert, caKey, err := rolesAnywhereCAKeyPair(ctx, r.Client, machineScope.Namespace(), machineScope.Cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	validity := rolesanywhere.DefaultCertificateValidity
+	if spec.CertificateValidity != nil {
+		validity = spec.CertificateValidity.Duration
+	}
+	cert, err := rolesanywhere.IssueCertificate(caCert, caKey, machineScope.Name(), validity)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to issue IAM Roles Anywhere certificate")
+	}
+
+	files := userdata.RolesAnywhereFiles(&userdata.RolesAnywhereInput{
+		TrustAnchorARN: status.TrustAnchorARN,
+		ProfileARN:     status.ProfileARN,
+		RoleARN:        roleARN,
+		Region:         clusterScope.Region(),
+		Certificate:    cert.CertificatePEM,
+		PrivateKey:     cert.PrivateKeyPEM,
+	})
+
+	if machineScope.UseIgnition(userDataFormat) {
+		userData, err = userdata.InjectIgnitionFiles(userData, files)
+	} else {
+		userData, err = userdata.InjectCloudInitFiles(userData, files)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add IAM Roles Anywhere credentials to user data")
+	}
+
+	machineScope.AWSMachine.Status.RolesAnywhere = &infrav1.MachineRolesAnywhereStatus{
+		RoleARN:                 roleARN,
+		CertificateSerialNumber: cert.SerialNumber,
+		CertificateNotAfter:     metav1.NewTime(cert.NotAfter),
+	}
+	return userData, nil
+}
+
+// rolesAnywhereRoleARN returns the ARN of the given role among the roles allowed by the cluster profile,
+// or the first of them if no role is given.
+func rolesAnywhereRoleARN(role string, roleARNs []string) (string, error) {
+	if len(roleARNs) == 0 {
+		return "", errors.New("the IAM Roles Anywhere profile of the cluster has no roles")
+	}
+	if role == "" {
+		return roleARNs[0], nil
+	}
+	for _, roleARN := range roleARNs {
+		if roleARN == role || strings.HasSuffix(roleARN, "/"+role) {
+			return roleARN, nil
+		}
+	}
+	return "", errors.Errorf("role %q is not allowed by the IAM Roles Anywhere profile of the cluster", role)
+}
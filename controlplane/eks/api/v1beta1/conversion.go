@@ -122,6 +122,20 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Status.Version = restored.Status.Version
 	dst.Spec.BootstrapSelfManagedAddons = restored.Spec.BootstrapSelfManagedAddons
 	dst.Spec.UpgradePolicy = restored.Spec.UpgradePolicy
+	dst.Spec.KMSKey = restored.Spec.KMSKey
+	dst.Status.KMSKey = restored.Status.KMSKey
+	dst.Spec.PodIdentityAssociations = restored.Spec.PodIdentityAssociations
+	dst.Spec.PodIdentityAgent = restored.Spec.PodIdentityAgent
+	dst.Status.PodIdentityAssociations = restored.Status.PodIdentityAssociations
+	dst.Spec.AutoMode = restored.Spec.AutoMode
+	dst.Status.AutoModeNodePools = restored.Status.AutoModeNodePools
+	dst.Spec.RemoteNetworkConfig = restored.Spec.RemoteNetworkConfig
+	dst.Spec.ZonalShiftConfig = restored.Spec.ZonalShiftConfig
+	dst.Spec.Karpenter = restored.Spec.Karpenter
+	dst.Status.Karpenter = restored.Status.Karpenter
+	dst.Status.ConfigMapMigration = restored.Status.ConfigMapMigration
+	restoreAddons(dst.Spec.Addons, restored.Spec.Addons)
+	restoreAddonStates(dst.Status.Addons, restored.Status.Addons)
 	return nil
 }
 
@@ -233,6 +247,46 @@ func Convert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControlPlan
 	return autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControlPlaneSpec(in, out, s)
 }
 
+// Convert_v1beta2_Addon_To_v1beta1_Addon is a conversion function.
+func Convert_v1beta2_Addon_To_v1beta1_Addon(in *ekscontrolplanev1.Addon, out *Addon, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
+}
+
+// Convert_v1beta2_AddonState_To_v1beta1_AddonState is a conversion function.
+func Convert_v1beta2_AddonState_To_v1beta1_AddonState(in *ekscontrolplanev1.AddonState, out *AddonState, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_AddonState_To_v1beta1_AddonState(in, out, s)
+}
+
+// restoreAddons restores the pod identity associations, the version policy and the dependencies of the addons,
+// which do not exist in v1beta1.
+func restoreAddons(dst, restored *[]ekscontrolplanev1.Addon) {
+	if dst == nil || restored == nil {
+		return
+	}
+	for i := range *dst {
+		for _, addon := range *restored {
+			if addon.Name == (*dst)[i].Name {
+				(*dst)[i].PodIdentityAssociations = addon.PodIdentityAssociations
+				(*dst)[i].VersionPolicy = addon.VersionPolicy
+				(*dst)[i].DependsOn = addon.DependsOn
+			}
+		}
+	}
+}
+
+// restoreAddonStates restores the progress and the resolved version of the addons, which do not exist in v1beta1.
+func restoreAddonStates(dst, restored []ekscontrolplanev1.AddonState) {
+	for i := range dst {
+		for _, state := range restored {
+			if state.Name == dst[i].Name {
+				dst[i].Progress = state.Progress
+				dst[i].WaitingFor = state.WaitingFor
+				dst[i].ResolvedVersion = state.ResolvedVersion
+			}
+		}
+	}
+}
+
 func Convert_v1beta2_VpcCni_To_v1beta1_VpcCni(in *ekscontrolplanev1.VpcCni, out *VpcCni, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_VpcCni_To_v1beta1_VpcCni(in, out, s)
 }
@@ -264,3 +318,35 @@ func Convert_v1beta2_EKSTokenMethod_To_v1beta1_EKSTokenMethod(src *ekscontrolpla
 	tokenMethod := EKSTokenMethod(*src)
 	*dst = &tokenMethod
 }
+
+// Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon is a conversion function.
+func Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon(in **[]Addon, out **[]ekscontrolplanev1.Addon, s apiconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	addons := make([]ekscontrolplanev1.Addon, len(**in))
+	for i := range **in {
+		if err := Convert_v1beta1_Addon_To_v1beta2_Addon(&(**in)[i], &addons[i], s); err != nil {
+			return err
+		}
+	}
+	*out = &addons
+	return nil
+}
+
+// Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon is a conversion function.
+func Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon(in **[]ekscontrolplanev1.Addon, out **[]Addon, s apiconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	addons := make([]Addon, len(**in))
+	for i := range **in {
+		if err := Convert_v1beta2_Addon_To_v1beta1_Addon(&(**in)[i], &addons[i], s); err != nil {
+			return err
+		}
+	}
+	*out = &addons
+	return nil
+}
@@ -69,11 +69,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Addon)(nil), (*Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Addon_To_v1beta1_Addon(a.(*v1beta2.Addon), b.(*Addon), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AddonIssue)(nil), (*v1beta2.AddonIssue)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AddonIssue_To_v1beta2_AddonIssue(a.(*AddonIssue), b.(*v1beta2.AddonIssue), scope)
 	}); err != nil {
@@ -89,11 +84,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AddonState)(nil), (*AddonState)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AddonState_To_v1beta1_AddonState(a.(*v1beta2.AddonState), b.(*AddonState), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneLoggingSpec)(nil), (*v1beta2.ControlPlaneLoggingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControlPlaneLoggingSpec_To_v1beta2_ControlPlaneLoggingSpec(a.(*ControlPlaneLoggingSpec), b.(*v1beta2.ControlPlaneLoggingSpec), scope)
 	}); err != nil {
@@ -209,6 +199,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((**[]Addon)(nil), (**[]v1beta2.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon(a.(**[]Addon), b.(**[]v1beta2.Addon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**[]v1beta2.Addon)(nil), (**[]Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon(a.(**[]v1beta2.Addon), b.(**[]Addon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*AWSManagedControlPlaneSpec)(nil), (*v1beta2.AWSManagedControlPlaneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControlPlaneSpec(a.(*AWSManagedControlPlaneSpec), b.(*v1beta2.AWSManagedControlPlaneSpec), scope)
 	}); err != nil {
@@ -224,6 +224,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.AddonState)(nil), (*AddonState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AddonState_To_v1beta1_AddonState(a.(*v1beta2.AddonState), b.(*AddonState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.Addon)(nil), (*Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Addon_To_v1beta1_Addon(a.(*v1beta2.Addon), b.(*Addon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.VpcCni)(nil), (*VpcCni)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VpcCni_To_v1beta1_VpcCni(a.(*v1beta2.VpcCni), b.(*VpcCni), scope)
 	}); err != nil {
@@ -330,7 +340,9 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControl
 	out.Bastion = in.Bastion
 	out.TokenMethod = (*v1beta2.EKSTokenMethod)(unsafe.Pointer(in.TokenMethod))
 	out.AssociateOIDCProvider = in.AssociateOIDCProvider
-	out.Addons = (*[]v1beta2.Addon)(unsafe.Pointer(in.Addons))
+	if err := Convert_Pointer_Slice_v1beta1_Addon_To_Pointer_Slice_v1beta2_Addon(&in.Addons, &out.Addons, s); err != nil {
+		return err
+	}
 	out.OIDCIdentityProviderConfig = (*v1beta2.OIDCIdentityProviderConfig)(unsafe.Pointer(in.OIDCIdentityProviderConfig))
 	// WARNING: in.DisableVPCCNI requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta1_VpcCni_To_v1beta2_VpcCni(&in.VpcCni, &out.VpcCni, s); err != nil {
@@ -357,6 +369,7 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 	// WARNING: in.RolePermissionsBoundary requires manual conversion: does not exist in peer-type
 	out.Logging = (*ControlPlaneLoggingSpec)(unsafe.Pointer(in.Logging))
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
+	// WARNING: in.KMSKey requires manual conversion: does not exist in peer-type
 	out.AdditionalTags = *(*apiv1beta2.Tags)(unsafe.Pointer(&in.AdditionalTags))
 	out.IAMAuthenticatorConfig = (*IAMAuthenticatorConfig)(unsafe.Pointer(in.IAMAuthenticatorConfig))
 	if err := Convert_v1beta2_EndpointAccess_To_v1beta1_EndpointAccess(&in.EndpointAccess, &out.EndpointAccess, s); err != nil {
@@ -369,10 +382,14 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 	out.Bastion = in.Bastion
 	out.TokenMethod = (*EKSTokenMethod)(unsafe.Pointer(in.TokenMethod))
 	out.AssociateOIDCProvider = in.AssociateOIDCProvider
-	out.Addons = (*[]Addon)(unsafe.Pointer(in.Addons))
+	if err := Convert_Pointer_Slice_v1beta2_Addon_To_Pointer_Slice_v1beta1_Addon(&in.Addons, &out.Addons, s); err != nil {
+		return err
+	}
 	out.OIDCIdentityProviderConfig = (*OIDCIdentityProviderConfig)(unsafe.Pointer(in.OIDCIdentityProviderConfig))
 	// WARNING: in.AccessConfig requires manual conversion: does not exist in peer-type
 	// WARNING: in.AccessEntries requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodIdentityAssociations requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodIdentityAgent requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_VpcCni_To_v1beta1_VpcCni(&in.VpcCni, &out.VpcCni, s); err != nil {
 		return err
 	}
@@ -382,6 +399,10 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 		return err
 	}
 	// WARNING: in.UpgradePolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemoteNetworkConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.ZonalShiftConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.Karpenter requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -397,7 +418,17 @@ func autoConvert_v1beta1_AWSManagedControlPlaneStatus_To_v1beta2_AWSManagedContr
 	out.Ready = in.Ready
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*corev1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
-	out.Addons = *(*[]v1beta2.AddonState)(unsafe.Pointer(&in.Addons))
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]v1beta2.AddonState, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_AddonState_To_v1beta2_AddonState(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Addons = nil
+	}
 	if err := Convert_v1beta1_IdentityProviderStatus_To_v1beta2_IdentityProviderStatus(&in.IdentityProviderStatus, &out.IdentityProviderStatus, s); err != nil {
 		return err
 	}
@@ -414,6 +445,7 @@ func autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedContr
 	out.Network = in.Network
 	out.FailureDomains = *(*corev1beta1.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
 	out.Bastion = (*apiv1beta2.Instance)(unsafe.Pointer(in.Bastion))
+	// WARNING: in.KMSKey requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_OIDCProviderStatus_To_v1beta1_OIDCProviderStatus(&in.OIDCProvider, &out.OIDCProvider, s); err != nil {
 		return err
 	}
@@ -422,7 +454,21 @@ func autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedContr
 	out.Ready = in.Ready
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*corev1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
-	out.Addons = *(*[]AddonState)(unsafe.Pointer(&in.Addons))
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]AddonState, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_AddonState_To_v1beta1_AddonState(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Addons = nil
+	}
+	// WARNING: in.PodIdentityAssociations requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoModeNodePools requires manual conversion: does not exist in peer-type
+	// WARNING: in.Karpenter requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConfigMapMigration requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_IdentityProviderStatus_To_v1beta1_IdentityProviderStatus(&in.IdentityProviderStatus, &out.IdentityProviderStatus, s); err != nil {
 		return err
 	}
@@ -449,18 +495,16 @@ func Convert_v1beta1_Addon_To_v1beta2_Addon(in *Addon, out *v1beta2.Addon, s con
 func autoConvert_v1beta2_Addon_To_v1beta1_Addon(in *v1beta2.Addon, out *Addon, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
+	// WARNING: in.VersionPolicy requires manual conversion: does not exist in peer-type
 	out.Configuration = in.Configuration
 	out.ConflictResolution = (*AddonResolution)(unsafe.Pointer(in.ConflictResolution))
 	out.ServiceAccountRoleArn = (*string)(unsafe.Pointer(in.ServiceAccountRoleArn))
+	// WARNING: in.PodIdentityAssociations requires manual conversion: does not exist in peer-type
 	out.PreserveOnDelete = in.PreserveOnDelete
+	// WARNING: in.DependsOn requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Addon_To_v1beta1_Addon is an autogenerated conversion function.
-func Convert_v1beta2_Addon_To_v1beta1_Addon(in *v1beta2.Addon, out *Addon, s conversion.Scope) error {
-	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
-}
-
 func autoConvert_v1beta1_AddonIssue_To_v1beta2_AddonIssue(in *AddonIssue, out *v1beta2.AddonIssue, s conversion.Scope) error {
 	out.Code = (*string)(unsafe.Pointer(in.Code))
 	out.Message = (*string)(unsafe.Pointer(in.Message))
@@ -511,14 +555,12 @@ func autoConvert_v1beta2_AddonState_To_v1beta1_AddonState(in *v1beta2.AddonState
 	out.ModifiedAt = in.ModifiedAt
 	out.Status = (*string)(unsafe.Pointer(in.Status))
 	out.Issues = *(*[]AddonIssue)(unsafe.Pointer(&in.Issues))
+	// WARNING: in.Progress requires manual conversion: does not exist in peer-type
+	// WARNING: in.WaitingFor requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResolvedVersion requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AddonState_To_v1beta1_AddonState is an autogenerated conversion function.
-func Convert_v1beta2_AddonState_To_v1beta1_AddonState(in *v1beta2.AddonState, out *AddonState, s conversion.Scope) error {
-	return autoConvert_v1beta2_AddonState_To_v1beta1_AddonState(in, out, s)
-}
-
 func autoConvert_v1beta1_ControlPlaneLoggingSpec_To_v1beta2_ControlPlaneLoggingSpec(in *ControlPlaneLoggingSpec, out *v1beta2.ControlPlaneLoggingSpec, s conversion.Scope) error {
 	out.APIServer = in.APIServer
 	out.Audit = in.Audit
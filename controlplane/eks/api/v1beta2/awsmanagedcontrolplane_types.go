@@ -30,6 +30,10 @@ const (
 
 	// AWSManagedControlPlaneKind is the Kind of AWSManagedControlPlane.
 	AWSManagedControlPlaneKind = "AWSManagedControlPlane"
+
+	// SkipUpgradeInsightsAnnotation, when set to "true", allows the EKS control plane to be upgraded
+	// even if the EKS upgrade insights for the next Kubernetes version are failing.
+	SkipUpgradeInsightsAnnotation = "controlplane.cluster.x-k8s.io/awsmanagedcontrolplane-skip-upgrade-insights"
 )
 
 // AWSManagedControlPlaneSpec defines the desired state of an Amazon EKS Cluster.
@@ -120,6 +124,13 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
 
+	// KMSKey, when set, makes the provider create a customer managed KMS key for the cluster.
+	// Unless EncryptionConfig is set, the key is used for the envelope encryption of Kubernetes
+	// secrets. It is also used by default to encrypt EBS volumes and bootstrap data secrets.
+	// The key is scheduled for deletion when the cluster is deleted.
+	// +optional
+	KMSKey *infrav1.KMSKeySpec `json:"kmsKey,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to AWS resources managed by the AWS provider, in addition to the
 	// ones added by default.
 	// +optional
@@ -201,6 +212,16 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	AccessEntries []AccessEntry `json:"accessEntries,omitempty"`
 
+	// PodIdentityAssociations specifies the EKS Pod Identity associations of the cluster, which give
+	// the pods running with a service account the credentials of an IAM role.
+	// Pod identity associations require the EKS Pod Identity agent, see PodIdentityAgent.
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
+
+	// PodIdentityAgent, when set, installs the EKS Pod Identity agent addon in the cluster.
+	// +optional
+	PodIdentityAgent *PodIdentityAgent `json:"podIdentityAgent,omitempty"`
+
 	// VpcCni is used to set configuration options for the VPC CNI plugin
 	// +optional
 	VpcCni VpcCni `json:"vpcCni,omitempty"`
@@ -226,6 +247,37 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:validation:Enum=extended;standard
 	// +optional
 	UpgradePolicy UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// AutoMode configures EKS Auto Mode for the cluster. With Auto Mode enabled, EKS manages the compute,
+	// load balancing and block storage capabilities of the cluster and the cluster does not need any
+	// AWSManagedMachinePool, AWSMachinePool or AWSFargateProfile.
+	// Enabling Auto Mode disables BootstrapSelfManagedAddons and requires the "api" or "api_and_config_map"
+	// authentication mode.
+	// (Official AWS docs for Auto Mode: https://docs.aws.amazon.com/eks/latest/userguide/automode.html)
+	// If omitted, the Auto Mode configuration of the cluster is left unchanged.
+	// +optional
+	AutoMode *AutoMode `json:"autoMode,omitempty"`
+
+	// RemoteNetworkConfig specifies the networks of the EKS hybrid nodes of the cluster, which run outside
+	// of AWS and join the cluster with nodeadm. Hybrid nodes require the "api" or "api_and_config_map"
+	// authentication mode and an access entry of type "hybrid_linux" for their IAM role.
+	// (Official AWS docs for hybrid nodes: https://docs.aws.amazon.com/eks/latest/userguide/hybrid-nodes-overview.html)
+	// If omitted, the remote network configuration of the cluster is left unchanged.
+	// +optional
+	RemoteNetworkConfig *RemoteNetworkConfig `json:"remoteNetworkConfig,omitempty"`
+
+	// ZonalShiftConfig configures Amazon Application Recovery Controller (ARC) zonal shift for the cluster,
+	// which allows shifting the traffic of the cluster away from an impaired availability zone.
+	// (Official AWS docs for zonal shift: https://docs.aws.amazon.com/eks/latest/userguide/zone-shift.html)
+	// If omitted, the zonal shift configuration of the cluster is left unchanged.
+	// +optional
+	ZonalShiftConfig *ZonalShiftConfig `json:"zonalShiftConfig,omitempty"`
+
+	// Karpenter, when set, makes the provider create the AWS resources required to run Karpenter in the cluster.
+	// The controller role is associated with the Karpenter service account through EKS Pod Identity, which
+	// requires the EKS Pod Identity agent, and an access entry is created for the node role.
+	// +optional
+	Karpenter *infrav1.KarpenterSpec `json:"karpenter,omitempty"`
 }
 
 // KubeProxy specifies how the kube-proxy daemonset is managed.
@@ -279,6 +331,43 @@ type AccessConfig struct {
 	// ignored when updating existing clusters. Defaults to true.
 	// +kubebuilder:default=true
 	BootstrapClusterCreatorAdminPermissions *bool `json:"bootstrapClusterCreatorAdminPermissions,omitempty"`
+
+	// ConfigMapMigration migrates the role and user mappings of the aws-auth ConfigMap, including the node roles
+	// of the cluster, to access entries. The authentication mode is switched by the migration and doesn't need
+	// to be changed in AuthenticationMode.
+	// +optional
+	ConfigMapMigration *ConfigMapMigration `json:"configMapMigration,omitempty"`
+}
+
+// ConfigMapMigration describes the migration of the aws-auth ConfigMap to access entries.
+type ConfigMapMigration struct {
+	// Step is the step the migration proceeds to. The steps are completed in order:
+	// AccessEntries switches the authentication mode to api_and_config_map and creates access entries for the
+	// mappings of the aws-auth ConfigMap, API switches the authentication mode to api once all the mappings have
+	// an access entry, and ReleaseConfigMap stops managing the aws-auth ConfigMap.
+	// Going back from ReleaseConfigMap to API resumes managing the ConfigMap, the authentication mode of a
+	// cluster can't be switched back by EKS.
+	// +kubebuilder:validation:Enum=AccessEntries;API;ReleaseConfigMap
+	Step ConfigMapMigrationStep `json:"step"`
+}
+
+// ConfigMapMigrationStatus describes the progress of the migration of the aws-auth ConfigMap to access entries.
+type ConfigMapMigrationStatus struct {
+	// Step is the last completed step of the migration.
+	// +optional
+	Step ConfigMapMigrationStep `json:"step,omitempty"`
+
+	// AccessEntries are the access entries equivalent to the mappings of the aws-auth ConfigMap. Principals
+	// which already have an entry in the spec keep it, and aren't listed.
+	// +optional
+	AccessEntries []AccessEntry `json:"accessEntries,omitempty"`
+
+	// UnmigratedMappings are the ARNs of the mapped principals which have no equivalent access entry, because
+	// they are mapped to groups or users reserved by Kubernetes. They must be given an access entry in the
+	// spec, which removes them from this list, or be removed from the ConfigMap, before the authentication
+	// mode is switched to api.
+	// +optional
+	UnmigratedMappings []string `json:"unmigratedMappings,omitempty"`
 }
 
 // AccessEntry represents an AWS EKS access entry for IAM principals
@@ -334,6 +423,127 @@ type AccessScope struct {
 	Namespaces []string `json:"namespaces,omitempty"`
 }
 
+// PodIdentityAssociation associates a Kubernetes service account with an IAM role using EKS Pod Identity.
+// +kubebuilder:validation:XValidation:rule="has(self.roleARN) != has(self.rolePolicies)",message="exactly one of roleARN or rolePolicies must be set"
+type PodIdentityAssociation struct {
+	// Namespace is the namespace of the service account.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// ServiceAccountName is the name of the service account.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// RoleARN is the ARN of an existing IAM role to associate with the service account.
+	// The role must trust the pods.eks.amazonaws.com service principal.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// RolePolicies are the ARNs of the IAM policies to attach to a role created for the service account.
+	// Creating the role requires the EKSEnableIAM feature flag.
+	// +optional
+	// +kubebuilder:validation:MaxItems=20
+	RolePolicies []string `json:"rolePolicies,omitempty"`
+}
+
+// PodIdentityAgent configures the EKS Pod Identity agent addon.
+type PodIdentityAgent struct {
+	// Version is the version of the eks-pod-identity-agent addon to install.
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// Configuration of the eks-pod-identity-agent addon.
+	// +optional
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// PodIdentityAssociationStatus describes a pod identity association managed for the cluster.
+type PodIdentityAssociationStatus struct {
+	// Namespace is the namespace of the service account.
+	Namespace string `json:"namespace"`
+
+	// ServiceAccountName is the name of the service account.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// AssociationID is the ID of the pod identity association.
+	AssociationID string `json:"associationID"`
+
+	// RoleARN is the ARN of the IAM role associated with the service account.
+	RoleARN string `json:"roleARN"`
+}
+
+// AutoMode configures EKS Auto Mode.
+type AutoMode struct {
+	// Enabled turns on EKS Auto Mode for the cluster.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+
+	// Compute configures the compute capability of Auto Mode.
+	// +optional
+	Compute *AutoModeCompute `json:"compute,omitempty"`
+
+	// ElasticLoadBalancing indicates whether Auto Mode manages the load balancers of the cluster.
+	// Defaults to the value of Enabled, which EKS currently requires it to match.
+	// +optional
+	ElasticLoadBalancing *bool `json:"elasticLoadBalancing,omitempty"`
+
+	// BlockStorage indicates whether Auto Mode manages the block storage of the cluster.
+	// Defaults to the value of Enabled, which EKS currently requires it to match.
+	// +optional
+	BlockStorage *bool `json:"blockStorage,omitempty"`
+}
+
+// AutoModeCompute configures the compute capability of EKS Auto Mode.
+type AutoModeCompute struct {
+	// NodePools are the built-in node pools to create in the cluster.
+	// Leave it empty to only use the node pools created in the cluster.
+	// +optional
+	// +listType=set
+	NodePools []AutoModeNodePool `json:"nodePools,omitempty"`
+
+	// NodeRoleARN is the ARN of the IAM role of the nodes launched by the built-in node pools.
+	// It is required when NodePools is not empty and cannot be changed once set.
+	// +optional
+	NodeRoleARN string `json:"nodeRoleARN,omitempty"`
+}
+
+// ElasticLoadBalancingEnabled returns whether Auto Mode manages the load balancers of the cluster.
+func (a *AutoMode) ElasticLoadBalancingEnabled() bool {
+	if a.ElasticLoadBalancing == nil {
+		return a.Enabled
+	}
+	return *a.ElasticLoadBalancing
+}
+
+// BlockStorageEnabled returns whether Auto Mode manages the block storage of the cluster.
+func (a *AutoMode) BlockStorageEnabled() bool {
+	if a.BlockStorage == nil {
+		return a.Enabled
+	}
+	return *a.BlockStorage
+}
+
+// RemoteNetworkConfig specifies the networks of the EKS hybrid nodes.
+type RemoteNetworkConfig struct {
+	// RemoteNodeNetworks are the IPv4 CIDR blocks of the on-premises networks of the hybrid nodes.
+	// They must be in the RFC-1918 or CGNAT ranges and not overlap the VPC or service CIDR blocks.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=15
+	RemoteNodeNetworks []string `json:"remoteNodeNetworks"`
+
+	// RemotePodNetworks are the IPv4 CIDR blocks of the pods running on the hybrid nodes.
+	// They are required to run webhooks on hybrid nodes.
+	// +optional
+	// +kubebuilder:validation:MaxItems=15
+	RemotePodNetworks []string `json:"remotePodNetworks,omitempty"`
+}
+
+// ZonalShiftConfig configures ARC zonal shift for the cluster.
+type ZonalShiftConfig struct {
+	// Enabled indicates whether zonal shift is enabled for the cluster.
+	Enabled bool `json:"enabled"`
+}
+
 // EncryptionConfig specifies the encryption configuration for the EKS clsuter.
 type EncryptionConfig struct {
 	// Provider specifies the ARN or alias of the CMK (in AWS KMS)
@@ -370,6 +580,9 @@ type AWSManagedControlPlaneStatus struct {
 	// Bastion holds details of the instance that is used as a bastion jump box
 	// +optional
 	Bastion *infrav1.Instance `json:"bastion,omitempty"`
+	// KMSKey describes the KMS key created for the cluster
+	// +optional
+	KMSKey *infrav1.KMSKeyStatus `json:"kmsKey,omitempty"`
 	// OIDCProvider holds the status of the identity provider for this cluster
 	// +optional
 	OIDCProvider OIDCProviderStatus `json:"oidcProvider,omitempty"`
@@ -394,6 +607,18 @@ type AWSManagedControlPlaneStatus struct {
 	// Addons holds the current status of the EKS addons
 	// +optional
 	Addons []AddonState `json:"addons,omitempty"`
+	// PodIdentityAssociations holds the pod identity associations managed for the cluster
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociationStatus `json:"podIdentityAssociations,omitempty"`
+	// AutoModeNodePools holds the built-in node pools that are active when EKS Auto Mode is enabled
+	// +optional
+	AutoModeNodePools []AutoModeNodePool `json:"autoModeNodePools,omitempty"`
+	// Karpenter describes the AWS resources created to run Karpenter in the cluster.
+	// +optional
+	Karpenter *infrav1.KarpenterStatus `json:"karpenter,omitempty"`
+	// ConfigMapMigration describes the progress of the migration of the aws-auth ConfigMap to access entries.
+	// +optional
+	ConfigMapMigration *ConfigMapMigrationStatus `json:"configMapMigration,omitempty"`
 	// IdentityProviderStatus holds the status for
 	// associated identity provider
 	// +optional
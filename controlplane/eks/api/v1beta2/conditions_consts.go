@@ -45,11 +45,31 @@ const (
 	IAMAuthenticatorConfigurationFailedReason = "IAMAuthenticatorConfigurationFailed"
 )
 
+const (
+	// EKSConfigMapMigratedCondition condition reports on whether the migration of the aws-auth ConfigMap to access
+	// entries has completed its step.
+	EKSConfigMapMigratedCondition clusterv1beta1.ConditionType = "EKSConfigMapMigrated"
+	// EKSConfigMapMigrationInProgressReason used to report a migration step which is being completed.
+	EKSConfigMapMigrationInProgressReason = "EKSConfigMapMigrationInProgress"
+	// EKSConfigMapMigrationBlockedReason used to report mappings without an equivalent access entry, which block
+	// switching the authentication mode to api.
+	EKSConfigMapMigrationBlockedReason = "EKSConfigMapMigrationBlocked"
+)
+
 const (
 	// EKSAddonsConfiguredCondition condition reports on the successful reconciliation of EKS addons.
 	EKSAddonsConfiguredCondition clusterv1beta1.ConditionType = "EKSAddonsConfigured"
 	// EKSAddonsConfiguredFailedReason used to report failures while reconciling the EKS addons.
 	EKSAddonsConfiguredFailedReason = "EKSAddonsConfiguredFailed"
+	// EKSAddonsWaitingForDependenciesReason used to report addons waiting for their dependencies to be active and healthy.
+	EKSAddonsWaitingForDependenciesReason = "WaitingForAddonDependencies"
+)
+
+const (
+	// EKSPodIdentityAssociationsConfiguredCondition condition reports on the successful reconciliation of the pod identity associations.
+	EKSPodIdentityAssociationsConfiguredCondition clusterv1beta1.ConditionType = "EKSPodIdentityAssociationsConfigured"
+	// EKSPodIdentityAssociationsConfiguredFailedReason used to report failures while reconciling the pod identity associations.
+	EKSPodIdentityAssociationsConfiguredFailedReason = "EKSPodIdentityAssociationsConfiguredFailed"
 )
 
 const (
@@ -58,3 +78,11 @@ const (
 	// EKSIdentityProviderConfiguredFailedReason used to report failures while reconciling the identity provider config association.
 	EKSIdentityProviderConfiguredFailedReason = "EKSIdentityProviderConfiguredFailed"
 )
+
+const (
+	// EKSControlPlaneUpgradeReadyCondition condition reports on whether the EKS upgrade insights allow
+	// the control plane to be upgraded to the next Kubernetes version.
+	EKSControlPlaneUpgradeReadyCondition clusterv1beta1.ConditionType = "EKSControlPlaneUpgradeReady"
+	// EKSControlPlaneUpgradeBlockedReason used to report that failing upgrade insights block the upgrade of the control plane.
+	EKSControlPlaneUpgradeBlockedReason = "EKSControlPlaneUpgradeBlocked"
+)
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -100,6 +101,19 @@ var (
 	EKSAuthenticationModeAPIAndConfigMap = EKSAuthenticationMode("api_and_config_map")
 )
 
+// AtLeast returns whether the authentication mode is the given mode or one that can't be downgraded to it. The
+// config_map mode, which is the default one when the mode isn't set, is followed by api_and_config_map and api.
+func (e EKSAuthenticationMode) AtLeast(mode EKSAuthenticationMode) bool {
+	modes := []EKSAuthenticationMode{EKSAuthenticationModeConfigMap, EKSAuthenticationModeAPIAndConfigMap, EKSAuthenticationModeAPI}
+	if e == "" {
+		e = EKSAuthenticationModeConfigMap
+	}
+	if mode == "" {
+		mode = EKSAuthenticationModeConfigMap
+	}
+	return slices.Index(modes, e) >= slices.Index(modes, mode)
+}
+
 // AccessEntryType represents the different types of access entries that can be used in an Amazon EKS cluster
 type AccessEntryType string
 
@@ -136,6 +150,38 @@ var (
 	AccessScopeTypeNamespace = AccessScopeType("namespace")
 )
 
+// ConfigMapMigrationStep is a step of the migration of the aws-auth ConfigMap to access entries.
+type ConfigMapMigrationStep string
+
+var (
+	// ConfigMapMigrationStepAccessEntries switches the authentication mode to api_and_config_map and creates
+	// access entries equivalent to the mappings of the aws-auth ConfigMap.
+	ConfigMapMigrationStepAccessEntries = ConfigMapMigrationStep("AccessEntries")
+	// ConfigMapMigrationStepAPI switches the authentication mode to api.
+	ConfigMapMigrationStepAPI = ConfigMapMigrationStep("API")
+	// ConfigMapMigrationStepReleaseConfigMap stops managing the aws-auth ConfigMap.
+	ConfigMapMigrationStepReleaseConfigMap = ConfigMapMigrationStep("ReleaseConfigMap")
+
+	configMapMigrationSteps = []ConfigMapMigrationStep{
+		ConfigMapMigrationStepAccessEntries,
+		ConfigMapMigrationStepAPI,
+		ConfigMapMigrationStepReleaseConfigMap,
+	}
+)
+
+// AtLeast returns whether the step is the given step or a later one. An empty step precedes all the others.
+func (s ConfigMapMigrationStep) AtLeast(step ConfigMapMigrationStep) bool {
+	return slices.Index(configMapMigrationSteps, s) >= slices.Index(configMapMigrationSteps, step)
+}
+
+// AuthenticationMode returns the authentication mode of the cluster once the step is completed.
+func (s ConfigMapMigrationStep) AuthenticationMode() EKSAuthenticationMode {
+	if s.AtLeast(ConfigMapMigrationStepAPI) {
+		return EKSAuthenticationModeAPI
+	}
+	return EKSAuthenticationModeAPIAndConfigMap
+}
+
 // DefaultEKSControlPlaneRole is the name of the default IAM role to use for the EKS control plane
 // if no other role is supplied in the spec and if iam role creation is not enabled. The default
 // can be created using clusterawsadm or created manually.
@@ -183,8 +229,17 @@ type Addon struct {
 	// +kubebuilder:validation:MinLength:=2
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
-	// Version is the version of the addon to use
-	Version string `json:"version"`
+	// Version is the version of the addon to use. It is required with the pinned version policy,
+	// and must not be set with the other ones.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// VersionPolicy is how the version of the addon is chosen. With pinned, the default, the version
+	// is set in Version. With latestCompatible and clusterDefault, the latest version compatible with
+	// the Kubernetes version of the control plane, or the default one, is resolved again after each
+	// upgrade of the control plane.
+	// +kubebuilder:validation:Enum=pinned;latestCompatible;clusterDefault
+	// +optional
+	VersionPolicy AddonVersionPolicy `json:"versionPolicy,omitempty"`
 	// Configuration of the EKS addon
 	// +optional
 	Configuration string `json:"configuration,omitempty"`
@@ -196,12 +251,49 @@ type Addon struct {
 	// ServiceAccountRoleArn is the ARN of an IAM role to bind to the addons service account
 	// +optional
 	ServiceAccountRoleArn *string `json:"serviceAccountRoleARN,omitempty"`
+	// PodIdentityAssociations associates the addon service accounts with IAM roles using EKS Pod Identity,
+	// as an alternative to ServiceAccountRoleArn. They require the EKS Pod Identity agent.
+	// +optional
+	PodIdentityAssociations []AddonPodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
 	// PreserveOnDelete indicates that the addon resources should be
 	// preserved in the cluster on delete.
 	// +optional
 	PreserveOnDelete bool `json:"preserveOnDelete,omitempty"`
+	// DependsOn is the list of the addons that must be active and without health issues
+	// before this addon is created or updated. When not set, well-known addons depend on the
+	// addons they need, for instance coredns on vpc-cni. Addons using EKS Pod Identity always
+	// depend on the EKS Pod Identity agent.
+	// +optional
+	// +listType=set
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
+// AddonPodIdentityAssociation associates a service account of an addon with an IAM role.
+type AddonPodIdentityAssociation struct {
+	// ServiceAccountName is the name of the addon service account.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+	// RoleARN is the ARN of the IAM role to associate with the service account.
+	// +kubebuilder:validation:MinLength=1
+	RoleARN string `json:"roleARN"`
+}
+
+// AddonVersionPolicy defines how the version of an addon is chosen.
+type AddonVersionPolicy string
+
+var (
+	// AddonVersionPolicyPinned indicates that the version of the addon is set explicitly.
+	AddonVersionPolicyPinned = AddonVersionPolicy("pinned")
+
+	// AddonVersionPolicyLatestCompatible indicates that the addon uses its latest version
+	// compatible with the Kubernetes version of the control plane.
+	AddonVersionPolicyLatestCompatible = AddonVersionPolicy("latestCompatible")
+
+	// AddonVersionPolicyClusterDefault indicates that the addon uses its default version
+	// for the Kubernetes version of the control plane.
+	AddonVersionPolicyClusterDefault = AddonVersionPolicy("clusterDefault")
+)
+
 // AddonResolution defines the method for resolving parameter conflicts.
 type AddonResolution string
 
@@ -245,6 +337,24 @@ var (
 	AddonStatusDegraded = "degraded"
 )
 
+// AddonProgress describes the progress of the rollout of an addon.
+type AddonProgress string
+
+var (
+	// AddonProgressWaitingForDependencies indicates that the addon is not created or updated
+	// until its dependencies are active and healthy.
+	AddonProgressWaitingForDependencies = AddonProgress("WaitingForDependencies")
+
+	// AddonProgressRollingOut indicates that the addon is being created, updated or deleted.
+	AddonProgressRollingOut = AddonProgress("RollingOut")
+
+	// AddonProgressReady indicates that the addon is active and has no health issues.
+	AddonProgressReady = AddonProgress("Ready")
+
+	// AddonProgressDegraded indicates that the addon failed or has health issues.
+	AddonProgressDegraded = AddonProgress("Degraded")
+)
+
 // AddonState represents the state of an addon.
 type AddonState struct {
 	// Name is the name of the addon
@@ -263,6 +373,25 @@ type AddonState struct {
 	Status *string `json:"status,omitempty"`
 	// Issues is a list of issue associated with the addon
 	Issues []AddonIssue `json:"issues,omitempty"`
+	// Progress is the progress of the rollout of the addon
+	// +optional
+	Progress AddonProgress `json:"progress,omitempty"`
+	// WaitingFor is the list of the dependencies the addon is waiting for
+	// +optional
+	WaitingFor []string `json:"waitingFor,omitempty"`
+	// ResolvedVersion is the version resolved from the version policy of the addon
+	// +optional
+	ResolvedVersion *AddonResolvedVersion `json:"resolvedVersion,omitempty"`
+}
+
+// AddonResolvedVersion is a version of an addon resolved from its version policy.
+type AddonResolvedVersion struct {
+	// Version is the resolved version of the addon
+	Version string `json:"version"`
+	// Policy is the version policy the version was resolved with
+	Policy AddonVersionPolicy `json:"policy"`
+	// KubernetesVersion is the Kubernetes version of the control plane the version was resolved for
+	KubernetesVersion string `json:"kubernetesVersion"`
 }
 
 // AddonIssue represents an issue with an addon.
@@ -293,6 +422,18 @@ func (e UpgradePolicy) String() string {
 	return string(e)
 }
 
+// AutoModeNodePool is a built-in node pool of EKS Auto Mode.
+// +kubebuilder:validation:Enum=general-purpose;system
+type AutoModeNodePool string
+
+const (
+	// AutoModeNodePoolGeneralPurpose is the node pool for general purpose workloads.
+	AutoModeNodePoolGeneralPurpose = AutoModeNodePool("general-purpose")
+
+	// AutoModeNodePoolSystem is the node pool for critical add-ons, tainted with CriticalAddonsOnly.
+	AutoModeNodePoolSystem = AutoModeNodePool("system")
+)
+
 const (
 	// SecurityGroupCluster is the security group for communication between EKS
 	// control plane and managed node groups.
@@ -134,6 +134,11 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 		*out = new(EncryptionConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(apiv1beta2.KMSKeySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
 		*out = make(apiv1beta2.Tags, len(*in))
@@ -182,8 +187,40 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodIdentityAgent != nil {
+		in, out := &in.PodIdentityAgent, &out.PodIdentityAgent
+		*out = new(PodIdentityAgent)
+		**out = **in
+	}
 	in.VpcCni.DeepCopyInto(&out.VpcCni)
 	out.KubeProxy = in.KubeProxy
+	if in.AutoMode != nil {
+		in, out := &in.AutoMode, &out.AutoMode
+		*out = new(AutoMode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteNetworkConfig != nil {
+		in, out := &in.RemoteNetworkConfig, &out.RemoteNetworkConfig
+		*out = new(RemoteNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZonalShiftConfig != nil {
+		in, out := &in.ZonalShiftConfig, &out.ZonalShiftConfig
+		*out = new(ZonalShiftConfig)
+		**out = **in
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(apiv1beta2.KarpenterSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneSpec.
@@ -212,6 +249,11 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 		*out = new(apiv1beta2.Instance)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMSKey != nil {
+		in, out := &in.KMSKey, &out.KMSKey
+		*out = new(apiv1beta2.KMSKeyStatus)
+		**out = **in
+	}
 	out.OIDCProvider = in.OIDCProvider
 	if in.ExternalManagedControlPlane != nil {
 		in, out := &in.ExternalManagedControlPlane, &out.ExternalManagedControlPlane
@@ -237,6 +279,26 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoModeNodePools != nil {
+		in, out := &in.AutoModeNodePools, &out.AutoModeNodePools
+		*out = make([]AutoModeNodePool, len(*in))
+		copy(*out, *in)
+	}
+	if in.Karpenter != nil {
+		in, out := &in.Karpenter, &out.Karpenter
+		*out = new(apiv1beta2.KarpenterStatus)
+		**out = **in
+	}
+	if in.ConfigMapMigration != nil {
+		in, out := &in.ConfigMapMigration, &out.ConfigMapMigration
+		*out = new(ConfigMapMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	out.IdentityProviderStatus = in.IdentityProviderStatus
 	if in.Version != nil {
 		in, out := &in.Version, &out.Version
@@ -353,6 +415,11 @@ func (in *AccessConfig) DeepCopyInto(out *AccessConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ConfigMapMigration != nil {
+		in, out := &in.ConfigMapMigration, &out.ConfigMapMigration
+		*out = new(ConfigMapMigration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessConfig.
@@ -441,6 +508,16 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]AddonPodIdentityAssociation, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Addon.
@@ -483,6 +560,36 @@ func (in *AddonIssue) DeepCopy() *AddonIssue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonPodIdentityAssociation) DeepCopyInto(out *AddonPodIdentityAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonPodIdentityAssociation.
+func (in *AddonPodIdentityAssociation) DeepCopy() *AddonPodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonPodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonResolvedVersion) DeepCopyInto(out *AddonResolvedVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonResolvedVersion.
+func (in *AddonResolvedVersion) DeepCopy() *AddonResolvedVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonResolvedVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonState) DeepCopyInto(out *AddonState) {
 	*out = *in
@@ -505,6 +612,16 @@ func (in *AddonState) DeepCopyInto(out *AddonState) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WaitingFor != nil {
+		in, out := &in.WaitingFor, &out.WaitingFor
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedVersion != nil {
+		in, out := &in.ResolvedVersion, &out.ResolvedVersion
+		*out = new(AddonResolvedVersion)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonState.
@@ -517,6 +634,98 @@ func (in *AddonState) DeepCopy() *AddonState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoMode) DeepCopyInto(out *AutoMode) {
+	*out = *in
+	if in.Compute != nil {
+		in, out := &in.Compute, &out.Compute
+		*out = new(AutoModeCompute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticLoadBalancing != nil {
+		in, out := &in.ElasticLoadBalancing, &out.ElasticLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockStorage != nil {
+		in, out := &in.BlockStorage, &out.BlockStorage
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoMode.
+func (in *AutoMode) DeepCopy() *AutoMode {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoModeCompute) DeepCopyInto(out *AutoModeCompute) {
+	*out = *in
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]AutoModeNodePool, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoModeCompute.
+func (in *AutoModeCompute) DeepCopy() *AutoModeCompute {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoModeCompute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapMigration) DeepCopyInto(out *ConfigMapMigration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapMigration.
+func (in *ConfigMapMigration) DeepCopy() *ConfigMapMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapMigrationStatus) DeepCopyInto(out *ConfigMapMigrationStatus) {
+	*out = *in
+	if in.AccessEntries != nil {
+		in, out := &in.AccessEntries, &out.AccessEntries
+		*out = make([]AccessEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnmigratedMappings != nil {
+		in, out := &in.UnmigratedMappings, &out.UnmigratedMappings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapMigrationStatus.
+func (in *ConfigMapMigrationStatus) DeepCopy() *ConfigMapMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneLoggingSpec) DeepCopyInto(out *ControlPlaneLoggingSpec) {
 	*out = *in
@@ -742,6 +951,81 @@ func (in *OIDCProviderStatus) DeepCopy() *OIDCProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAgent) DeepCopyInto(out *PodIdentityAgent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAgent.
+func (in *PodIdentityAgent) DeepCopy() *PodIdentityAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociation) DeepCopyInto(out *PodIdentityAssociation) {
+	*out = *in
+	if in.RolePolicies != nil {
+		in, out := &in.RolePolicies, &out.RolePolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociation.
+func (in *PodIdentityAssociation) DeepCopy() *PodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationStatus) DeepCopyInto(out *PodIdentityAssociationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationStatus.
+func (in *PodIdentityAssociationStatus) DeepCopy() *PodIdentityAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteNetworkConfig) DeepCopyInto(out *RemoteNetworkConfig) {
+	*out = *in
+	if in.RemoteNodeNetworks != nil {
+		in, out := &in.RemoteNodeNetworks, &out.RemoteNodeNetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemotePodNetworks != nil {
+		in, out := &in.RemotePodNetworks, &out.RemotePodNetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteNetworkConfig.
+func (in *RemoteNetworkConfig) DeepCopy() *RemoteNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleMapping) DeepCopyInto(out *RoleMapping) {
 	*out = *in
@@ -795,3 +1079,18 @@ func (in *VpcCni) DeepCopy() *VpcCni {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZonalShiftConfig) DeepCopyInto(out *ZonalShiftConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZonalShiftConfig.
+func (in *ZonalShiftConfig) DeepCopy() *ZonalShiftConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZonalShiftConfig)
+	in.DeepCopyInto(out)
+	return out
+}
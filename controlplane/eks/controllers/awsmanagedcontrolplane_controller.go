@@ -48,6 +48,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/karpenter"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kubeproxy"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
@@ -66,6 +67,10 @@ const (
 	// has dependencies during deletion.
 	deleteRequeueAfter = 20 * time.Second
 
+	// upgradeBlockedRequeueAfter is how long to wait before checking again the EKS upgrade insights
+	// blocking the upgrade of the control plane.
+	upgradeBlockedRequeueAfter = 5 * time.Minute
+
 	awsManagedControlPlaneKind = "AWSManagedControlPlane"
 )
 
@@ -209,7 +214,7 @@ func (r *AWSManagedControlPlaneReconciler) SetupWithManager(ctx context.Context,
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes/finalizers,verbs=update
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclustercontrolleridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusterroleidentities;awsclusterstaticidentities;awsclustercontrolleridentities;awsclusterwebidentities,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmanagedclusters;awsmanagedclusters/status,verbs=get;list;watch
 
 // Reconcile will reconcile AWSManagedControlPlane Resources.
@@ -355,6 +360,19 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile bastion host for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
+	// The Karpenter roles are reconciled first, as the control plane creates the pod identity association and the
+	// access entry of Karpenter. The node security group is tagged once the control plane has created it. The
+	// Karpenter resources are deleted when Karpenter is removed from the cluster.
+	if err := karpenter.NewService(managedScope).ReconcileKarpenter(ctx); err != nil {
+		v1beta1conditions.MarkFalse(awsManagedControlPlane, infrav1.KarpenterReadyCondition, infrav1.KarpenterFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile Karpenter resources for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
+	}
+	if awsManagedControlPlane.Spec.Karpenter != nil {
+		v1beta1conditions.MarkTrue(awsManagedControlPlane, infrav1.KarpenterReadyCondition)
+	} else {
+		v1beta1conditions.Delete(awsManagedControlPlane, infrav1.KarpenterReadyCondition)
+	}
+
 	if err := ekssvc.ReconcileControlPlane(ctx); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
@@ -380,6 +398,7 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile aws-iam-authenticator config for AWSManagedControlPlane %s/%s", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name)
 	}
 	v1beta1conditions.MarkTrue(awsManagedControlPlane, ekscontrolplanev1.IAMAuthenticatorConfiguredCondition)
+	markConfigMapMigrated(awsManagedControlPlane)
 
 	for _, subnet := range managedScope.Subnets().FilterPrivate() {
 		managedScope.SetFailureDomain(subnet.AvailabilityZone, clusterv1.FailureDomain{
@@ -394,9 +413,57 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{RequeueAfter: r.WaitInfraPeriod}, nil
 	}
 
+	// Only a single update of the EKS cluster can be in progress, the remaining ones are initiated once it completes.
+	if v1beta1conditions.IsTrue(awsManagedControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition) {
+		managedScope.Info("EKS control plane is updating, requeueing")
+		return reconcile.Result{RequeueAfter: r.WaitInfraPeriod}, nil
+	}
+
+	if v1beta1conditions.GetReason(awsManagedControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition) == ekscontrolplanev1.EKSControlPlaneUpgradeBlockedReason {
+		managedScope.Info("EKS control plane upgrade is blocked by failing upgrade insights, requeueing")
+		return reconcile.Result{RequeueAfter: upgradeBlockedRequeueAfter}, nil
+	}
+
+	if v1beta1conditions.GetReason(awsManagedControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition) == ekscontrolplanev1.EKSAddonsWaitingForDependenciesReason {
+		managedScope.Info("EKS addons are waiting for their dependencies, requeueing")
+		return reconcile.Result{RequeueAfter: r.WaitInfraPeriod}, nil
+	}
+
+	if v1beta1conditions.GetReason(awsManagedControlPlane, ekscontrolplanev1.EKSConfigMapMigratedCondition) == ekscontrolplanev1.EKSConfigMapMigrationInProgressReason {
+		managedScope.Info("aws-auth ConfigMap migration is in progress, requeueing")
+		return reconcile.Result{RequeueAfter: r.WaitInfraPeriod}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// markConfigMapMigrated reports whether the migration of the aws-auth ConfigMap to access entries has completed
+// its step. The migration is blocked by the mappings which have no equivalent access entry before switching the
+// authentication mode to api.
+func markConfigMapMigrated(controlPlane *ekscontrolplanev1.AWSManagedControlPlane) {
+	var migration *ekscontrolplanev1.ConfigMapMigration
+	if controlPlane.Spec.AccessConfig != nil {
+		migration = controlPlane.Spec.AccessConfig.ConfigMapMigration
+	}
+	if migration == nil {
+		v1beta1conditions.Delete(controlPlane, ekscontrolplanev1.EKSConfigMapMigratedCondition)
+		return
+	}
+
+	status := controlPlane.Status.ConfigMapMigration
+	switch {
+	case status != nil && status.Step == migration.Step:
+		v1beta1conditions.MarkTrue(controlPlane, ekscontrolplanev1.EKSConfigMapMigratedCondition)
+	case status != nil && len(status.UnmigratedMappings) > 0 && migration.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI) &&
+		!status.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI):
+		v1beta1conditions.MarkFalse(controlPlane, ekscontrolplanev1.EKSConfigMapMigratedCondition, ekscontrolplanev1.EKSConfigMapMigrationBlockedReason,
+			clusterv1beta1.ConditionSeverityWarning, "mappings without an equivalent access entry: %s", strings.Join(status.UnmigratedMappings, ", "))
+	default:
+		v1beta1conditions.MarkFalse(controlPlane, ekscontrolplanev1.EKSConfigMapMigratedCondition, ekscontrolplanev1.EKSConfigMapMigrationInProgressReason,
+			clusterv1beta1.ConditionSeverityInfo, "migrating to step %s", migration.Step)
+	}
+}
+
 func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context, managedScope *scope.ManagedControlPlaneScope) (_ ctrl.Result, reterr error) {
 	log := logger.FromContext(ctx)
 
@@ -420,6 +487,13 @@ func (r *AWSManagedControlPlaneReconciler) reconcileDelete(ctx context.Context,
 	networkSvc := network.NewService(managedScope)
 	sgService := securitygroup.NewService(managedScope, securityGroupRolesForControlPlane(managedScope))
 
+	// The Karpenter resources are deleted first, while the node security group to remove the discovery tag from
+	// still exists.
+	if err := karpenter.NewService(managedScope).DeleteKarpenter(ctx); err != nil {
+		log.Error(err, "error deleting Karpenter resources for AWSManagedControlPlane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
+		return reconcile.Result{}, err
+	}
+
 	if err := ekssvc.DeleteControlPlane(ctx); err != nil {
 		log.Error(err, "error deleting EKS cluster for EKS control plane", "namespace", controlPlane.Namespace, "name", controlPlane.Name)
 		return reconcile.Result{}, err
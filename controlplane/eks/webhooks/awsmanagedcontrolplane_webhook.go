@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 	"github.com/pkg/errors"
@@ -28,13 +29,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
 )
 
 const (
@@ -56,10 +61,17 @@ const (
 )
 
 // AWSManagedControlPlane implements a custom validation webhook for AWSManagedControlPlane.
-type AWSManagedControlPlane struct{}
+type AWSManagedControlPlane struct {
+	// Client reads the cluster owning the control plane. Validations needing the
+	// cluster are skipped when it is nil.
+	Client client.Reader
+}
 
 // SetupWebhookWithManager will setup the webhooks for the AWSManagedControlPlane.
 func (w *AWSManagedControlPlane) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	if w.Client == nil {
+		w.Client = mgr.GetClient()
+	}
 	return ctrl.NewWebhookManagedBy(mgr, &ekscontrolplanev1.AWSManagedControlPlane{}).
 		WithCustomValidator(w).
 		WithCustomDefaulter(w).
@@ -81,7 +93,7 @@ func parseEKSVersion(raw string) (*version.Version, error) {
 }
 
 // ValidateCreate will do any extra validation when creating a AWSManagedControlPlane.
-func (w *AWSManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (w *AWSManagedControlPlane) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	r, ok := obj.(*ekscontrolplanev1.AWSManagedControlPlane)
 	if !ok {
 		return nil, fmt.Errorf("expected an AWSManagedControlPlane object but got %T", r)
@@ -109,6 +121,10 @@ func (w *AWSManagedControlPlane) ValidateCreate(_ context.Context, obj runtime.O
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
 	allErrs = append(allErrs, w.validateAccessConfigCreate(r)...)
 	allErrs = append(allErrs, w.validateAccessEntries(r)...)
+	allErrs = append(allErrs, w.validatePodIdentity(r)...)
+	allErrs = append(allErrs, w.validateAutoMode(r, nil)...)
+	allErrs = append(allErrs, w.validateRemoteNetworkConfig(ctx, r)...)
+	allErrs = append(allErrs, w.validateKarpenter(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -152,6 +168,10 @@ func (w *AWSManagedControlPlane) ValidateUpdate(ctx context.Context, oldObj, new
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validatePrivateDNSHostnameTypeOnLaunch(r)...)
 	allErrs = append(allErrs, w.validateAccessEntries(r)...)
+	allErrs = append(allErrs, w.validatePodIdentity(r)...)
+	allErrs = append(allErrs, w.validateAutoMode(r, oldAWSManagedControlplane)...)
+	allErrs = append(allErrs, w.validateKarpenter(r)...)
+	allErrs = append(allErrs, w.validateRemoteNetworkConfig(ctx, r)...)
 
 	if r.Spec.Region != oldAWSManagedControlplane.Spec.Region {
 		allErrs = append(allErrs,
@@ -177,6 +197,14 @@ func (w *AWSManagedControlPlane) ValidateUpdate(ctx context.Context, oldObj, new
 		)
 	}
 
+	// The cluster KMS key may be used for the EKS encryption, do not allow removal of it.
+	if oldAWSManagedControlplane.Spec.KMSKey != nil && r.Spec.KMSKey == nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "kmsKey"),
+				r.Spec.KMSKey, "field cannot be set to nil"),
+		)
+	}
+
 	// If a identityRef is already set, do not allow removal of it.
 	if oldAWSManagedControlplane.Spec.IdentityRef != nil && r.Spec.IdentityRef == nil {
 		allErrs = append(allErrs,
@@ -274,6 +302,11 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 		return allErrs
 	}
 
+	if addons != nil {
+		allErrs = append(allErrs, validateAddonVersionPolicies(*addons, path.Child("addons"))...)
+		allErrs = append(allErrs, validateAddonDependencies(*addons, path.Child("addons"))...)
+	}
+
 	// Version is required for addon validation
 	if eksVersion == nil {
 		return allErrs
@@ -304,7 +337,8 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 		}
 
 		for _, addon := range *addons {
-			if addon.Name == vpcCniAddon {
+			// The version resolved from a version policy is only known by the controller.
+			if addon.Name == vpcCniAddon && addon.Version != "" {
 				v, err := version.ParseGeneric(addon.Version)
 				if err != nil {
 					allErrs = append(allErrs, field.Invalid(addonsPath, addon.Version, err.Error()))
@@ -342,6 +376,8 @@ func (w *AWSManagedControlPlane) validateAccessConfigUpdate(r *ekscontrolplanev1
 		)
 	}
 
+	allErrs = append(allErrs, w.validateConfigMapMigrationUpdate(r, old)...)
+
 	// BootstrapClusterCreatorAdminPermissions only applies on create, but changes should not invalidate updates
 	if old.Spec.AccessConfig != nil && r.Spec.AccessConfig != nil &&
 		old.Spec.AccessConfig.BootstrapClusterCreatorAdminPermissions != r.Spec.AccessConfig.BootstrapClusterCreatorAdminPermissions {
@@ -351,6 +387,37 @@ func (w *AWSManagedControlPlane) validateAccessConfigUpdate(r *ekscontrolplanev1
 	return allErrs
 }
 
+// validateConfigMapMigrationUpdate forbids going back to the access entries step of the aws-auth ConfigMap
+// migration once the authentication mode may have been switched to api, which EKS can't revert, and removing the
+// migration unless authenticationMode keeps the mode it switched to.
+func (w *AWSManagedControlPlane) validateConfigMapMigrationUpdate(r *ekscontrolplanev1.AWSManagedControlPlane, old *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if old.Spec.AccessConfig == nil || old.Spec.AccessConfig.ConfigMapMigration == nil || r.Spec.AccessConfig == nil {
+		return allErrs
+	}
+	oldMigration := old.Spec.AccessConfig.ConfigMapMigration
+	migration := r.Spec.AccessConfig.ConfigMapMigration
+	fldPath := field.NewPath("spec", "accessConfig", "configMapMigration")
+
+	if migration == nil {
+		if mode := oldMigration.Step.AuthenticationMode(); !r.Spec.AccessConfig.AuthenticationMode.AtLeast(mode) {
+			allErrs = append(allErrs,
+				field.Forbidden(fldPath, fmt.Sprintf("removing configMapMigration at step %s requires authenticationMode to be %s", oldMigration.Step, mode)),
+			)
+		}
+		return allErrs
+	}
+
+	if oldMigration.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI) && !migration.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI) {
+		allErrs = append(allErrs,
+			field.Invalid(fldPath.Child("step"), migration.Step, fmt.Sprintf("going back from step %s is not allowed, the authentication mode can't be downgraded", oldMigration.Step)),
+		)
+	}
+
+	return allErrs
+}
+
 func (w *AWSManagedControlPlane) validateAccessConfigCreate(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -376,14 +443,16 @@ func (w *AWSManagedControlPlane) validateAccessEntries(r *ekscontrolplanev1.AWSM
 		return allErrs
 	}
 
-	// AccessEntries require AuthenticationMode to be api or api_and_config_map
+	// AccessEntries require AuthenticationMode to be api or api_and_config_map, which is switched to by the
+	// migration of the aws-auth ConfigMap
 	if r.Spec.AccessConfig == nil ||
 		(r.Spec.AccessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPI &&
-			r.Spec.AccessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap) {
+			r.Spec.AccessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap &&
+			r.Spec.AccessConfig.ConfigMapMigration == nil) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "accessEntries"),
 				r.Spec.AccessEntries,
-				"accessEntries can only be used when authenticationMode is set to api or api_and_config_map",
+				"accessEntries can only be used when authenticationMode is set to api or api_and_config_map, or with configMapMigration",
 			),
 		)
 	}
@@ -426,6 +495,306 @@ func (w *AWSManagedControlPlane) validateAccessEntries(r *ekscontrolplanev1.AWSM
 	return allErrs
 }
 
+// validateAddonVersionPolicies checks that the version of the addons is set with the pinned version policy only.
+func validateAddonVersionPolicies(addons []ekscontrolplanev1.Addon, addonsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, addon := range addons {
+		pinned := addon.VersionPolicy == "" || addon.VersionPolicy == ekscontrolplanev1.AddonVersionPolicyPinned
+		if pinned && addon.Version == "" {
+			allErrs = append(allErrs, field.Required(addonsPath.Index(i).Child("version"), "version must be set with the pinned version policy"))
+		}
+		if !pinned && addon.Version != "" {
+			allErrs = append(allErrs, field.Forbidden(addonsPath.Index(i).Child("version"), fmt.Sprintf("version cannot be set with the %s version policy", addon.VersionPolicy)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAddonDependencies checks that the addons depend on other addons of the spec, or on the
+// pod identity agent which may be set with podIdentityAgent, and that the dependencies have no cycle.
+func validateAddonDependencies(addons []ekscontrolplanev1.Addon, addonsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{eksaddons.PodIdentityAgentAddonName: true}
+	for _, addon := range addons {
+		names[addon.Name] = true
+	}
+
+	converted := make([]*eksaddons.EKSAddon, 0, len(addons))
+	for i, addon := range addons {
+		for j, dependency := range addon.DependsOn {
+			if dependency == addon.Name {
+				allErrs = append(allErrs,
+					field.Invalid(addonsPath.Index(i).Child("dependsOn").Index(j), dependency, "an addon cannot depend on itself"),
+				)
+			} else if !names[dependency] {
+				allErrs = append(allErrs,
+					field.NotFound(addonsPath.Index(i).Child("dependsOn").Index(j), dependency),
+				)
+			}
+		}
+
+		convertedAddon := &eksaddons.EKSAddon{
+			Name:      ptr.To(addon.Name),
+			DependsOn: addon.DependsOn,
+		}
+		for _, association := range addon.PodIdentityAssociations {
+			convertedAddon.PodIdentityAssociations = append(convertedAddon.PodIdentityAssociations, eksaddons.PodIdentityAssociation{
+				ServiceAccount: association.ServiceAccountName,
+				RoleARN:        association.RoleARN,
+			})
+		}
+		converted = append(converted, convertedAddon)
+	}
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	if _, err := eksaddons.OrderByDependencies(converted); err != nil {
+		allErrs = append(allErrs, field.Forbidden(addonsPath, err.Error()))
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validatePodIdentity(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	associationsPath := field.NewPath("spec", "podIdentityAssociations")
+	serviceAccounts := map[string]bool{}
+	for i, association := range r.Spec.PodIdentityAssociations {
+		if (association.RoleARN == "") == (len(association.RolePolicies) == 0) {
+			allErrs = append(allErrs,
+				field.Invalid(associationsPath.Index(i), association.RoleARN, "exactly one of roleARN or rolePolicies must be set"),
+			)
+		}
+
+		serviceAccount := association.Namespace + "/" + association.ServiceAccountName
+		if serviceAccounts[serviceAccount] {
+			allErrs = append(allErrs,
+				field.Duplicate(associationsPath.Index(i), serviceAccount),
+			)
+		}
+		serviceAccounts[serviceAccount] = true
+	}
+
+	if r.Spec.Addons == nil {
+		return allErrs
+	}
+
+	addonsPath := field.NewPath("spec", "addons")
+	for i, addon := range *r.Spec.Addons {
+		if addon.Name == eksaddons.PodIdentityAgentAddonName && r.Spec.PodIdentityAgent != nil {
+			allErrs = append(allErrs,
+				field.Invalid(addonsPath.Index(i), addon.Name, "the pod identity agent addon cannot be specified when podIdentityAgent is set"),
+			)
+		}
+
+		if addon.ServiceAccountRoleArn != nil && len(addon.PodIdentityAssociations) > 0 {
+			allErrs = append(allErrs,
+				field.Invalid(addonsPath.Index(i).Child("podIdentityAssociations"), addon.PodIdentityAssociations, "podIdentityAssociations cannot be specified with serviceAccountRoleARN"),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateAutoMode(r *ekscontrolplanev1.AWSManagedControlPlane, old *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	autoMode := r.Spec.AutoMode
+	if autoMode == nil {
+		return allErrs
+	}
+
+	autoModePath := field.NewPath("spec", "autoMode")
+	if autoMode.Compute != nil && len(autoMode.Compute.NodePools) > 0 {
+		if !autoMode.Enabled {
+			allErrs = append(allErrs,
+				field.Invalid(autoModePath.Child("compute", "nodePools"), autoMode.Compute.NodePools, "nodePools can only be specified when Auto Mode is enabled"),
+			)
+		}
+		if autoMode.Compute.NodeRoleARN == "" {
+			allErrs = append(allErrs,
+				field.Required(autoModePath.Child("compute", "nodeRoleARN"), "nodeRoleARN is required when nodePools are specified"),
+			)
+		}
+	}
+
+	// EKS requires the compute, block storage and load balancing capabilities to be enabled or disabled together.
+	if autoMode.ElasticLoadBalancingEnabled() != autoMode.Enabled {
+		allErrs = append(allErrs,
+			field.Invalid(autoModePath.Child("elasticLoadBalancing"), autoMode.ElasticLoadBalancing, "must match enabled"),
+		)
+	}
+	if autoMode.BlockStorageEnabled() != autoMode.Enabled {
+		allErrs = append(allErrs,
+			field.Invalid(autoModePath.Child("blockStorage"), autoMode.BlockStorage, "must match enabled"),
+		)
+	}
+
+	if autoMode.Enabled && (r.Spec.AccessConfig == nil || r.Spec.AccessConfig.AuthenticationMode == ekscontrolplanev1.EKSAuthenticationModeConfigMap) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "accessConfig", "authenticationMode"), r.Spec.AccessConfig, "Auto Mode requires authentication mode to be either api or api_and_config_map"),
+		)
+	}
+
+	if old != nil && old.Spec.AutoMode != nil && old.Spec.AutoMode.Compute != nil && old.Spec.AutoMode.Compute.NodeRoleARN != "" &&
+		autoMode.Compute != nil && autoMode.Compute.NodeRoleARN != "" &&
+		autoMode.Compute.NodeRoleARN != old.Spec.AutoMode.Compute.NodeRoleARN {
+		allErrs = append(allErrs,
+			field.Invalid(autoModePath.Child("compute", "nodeRoleARN"), autoMode.Compute.NodeRoleARN, "field is immutable"),
+		)
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedControlPlane) validateKarpenter(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	karpenter := r.Spec.Karpenter
+	if karpenter == nil {
+		return allErrs
+	}
+
+	if r.Spec.AccessConfig == nil || r.Spec.AccessConfig.AuthenticationMode == ekscontrolplanev1.EKSAuthenticationModeConfigMap {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "accessConfig", "authenticationMode"), r.Spec.AccessConfig, "Karpenter requires authentication mode to be either api or api_and_config_map"),
+		)
+	}
+
+	associationsPath := field.NewPath("spec", "podIdentityAssociations")
+	for i, association := range r.Spec.PodIdentityAssociations {
+		if association.Namespace == karpenter.ServiceAccountNamespace && association.ServiceAccountName == karpenter.ServiceAccountName {
+			allErrs = append(allErrs,
+				field.Duplicate(associationsPath.Index(i), karpenter.ServiceAccountNamespace+"/"+karpenter.ServiceAccountName),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// remoteNetworkRanges are the IPv4 ranges allowed for the networks of EKS hybrid nodes.
+var remoteNetworkRanges = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "100.64.0.0/10"}
+
+func (w *AWSManagedControlPlane) validateRemoteNetworkConfig(ctx context.Context, r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	remoteNetworkConfig := r.Spec.RemoteNetworkConfig
+	if remoteNetworkConfig == nil {
+		return allErrs
+	}
+
+	remoteNetworkConfigPath := field.NewPath("spec", "remoteNetworkConfig")
+	if r.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+		allErrs = append(allErrs,
+			field.Invalid(remoteNetworkConfigPath, remoteNetworkConfig, "hybrid nodes are not supported with IPv6 clusters"),
+		)
+	}
+
+	if r.Spec.AccessConfig == nil || r.Spec.AccessConfig.AuthenticationMode == ekscontrolplanev1.EKSAuthenticationModeConfigMap {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "accessConfig", "authenticationMode"), r.Spec.AccessConfig, "hybrid nodes require authentication mode to be either api or api_and_config_map"),
+		)
+	}
+
+	var vpcCIDR *net.IPNet
+	if r.Spec.NetworkSpec.VPC.CidrBlock != "" {
+		_, vpcCIDR, _ = net.ParseCIDR(r.Spec.NetworkSpec.VPC.CidrBlock)
+	}
+
+	serviceCIDRBlocks, err := w.serviceCIDRBlocks(ctx, r)
+	if err != nil {
+		return append(allErrs, field.InternalError(remoteNetworkConfigPath, err))
+	}
+	var serviceCIDRs []*net.IPNet
+	for _, cidrBlock := range serviceCIDRBlocks {
+		if _, ipNet, err := net.ParseCIDR(cidrBlock); err == nil {
+			serviceCIDRs = append(serviceCIDRs, ipNet)
+		}
+	}
+
+	var parsed []*net.IPNet
+	validate := func(path *field.Path, cidrs []string) {
+		for i, cidrBlock := range cidrs {
+			_, ipNet, err := net.ParseCIDR(cidrBlock)
+			if err != nil || ipNet.IP.To4() == nil {
+				allErrs = append(allErrs, field.Invalid(path.Index(i), cidrBlock, "must be a valid IPv4 CIDR block"))
+				continue
+			}
+			if !cidrWithinRanges(ipNet, remoteNetworkRanges) {
+				allErrs = append(allErrs, field.Invalid(path.Index(i), cidrBlock, "must be within the RFC-1918 or CGNAT (100.64.0.0/10) ranges"))
+			}
+			if vpcCIDR != nil && cidrsOverlap(ipNet, vpcCIDR) {
+				allErrs = append(allErrs, field.Invalid(path.Index(i), cidrBlock, "must not overlap the VPC CIDR block"))
+			}
+			for _, serviceCIDR := range serviceCIDRs {
+				if cidrsOverlap(ipNet, serviceCIDR) {
+					allErrs = append(allErrs, field.Invalid(path.Index(i), cidrBlock, fmt.Sprintf("must not overlap the service CIDR block %s", serviceCIDR)))
+				}
+			}
+			for _, other := range parsed {
+				if cidrsOverlap(ipNet, other) {
+					allErrs = append(allErrs, field.Invalid(path.Index(i), cidrBlock, fmt.Sprintf("must not overlap %s", other)))
+				}
+			}
+			parsed = append(parsed, ipNet)
+		}
+	}
+	validate(remoteNetworkConfigPath.Child("remoteNodeNetworks"), remoteNetworkConfig.RemoteNodeNetworks)
+	validate(remoteNetworkConfigPath.Child("remotePodNetworks"), remoteNetworkConfig.RemotePodNetworks)
+
+	return allErrs
+}
+
+// serviceCIDRBlocks returns the service CIDR blocks of the cluster owning the control plane.
+// It returns none while the cluster isn't known yet, in which case the EKS service checks them on creation.
+func (w *AWSManagedControlPlane) serviceCIDRBlocks(ctx context.Context, r *ekscontrolplanev1.AWSManagedControlPlane) ([]string, error) {
+	if w.Client == nil {
+		return nil, nil
+	}
+
+	clusterName := r.Labels[clusterv1.ClusterNameLabel]
+	for _, ref := range r.OwnerReferences {
+		if ref.Kind == "Cluster" && strings.HasPrefix(ref.APIVersion, clusterv1.GroupVersion.Group+"/") {
+			clusterName = ref.Name
+		}
+	}
+	if clusterName == "" {
+		return nil, nil
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := w.Client.Get(ctx, client.ObjectKey{Namespace: r.Namespace, Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get cluster %s", clusterName)
+	}
+	return cluster.Spec.ClusterNetwork.Services.CIDRBlocks, nil
+}
+
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func cidrWithinRanges(ipNet *net.IPNet, ranges []string) bool {
+	ones, _ := ipNet.Mask.Size()
+	for _, r := range ranges {
+		_, rangeNet, _ := net.ParseCIDR(r)
+		rangeOnes, _ := rangeNet.Mask.Size()
+		if rangeNet.Contains(ipNet.IP) && ones >= rangeOnes {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *AWSManagedControlPlane) validateIAMAuthConfig(r *ekscontrolplanev1.AWSManagedControlPlane) field.ErrorList {
 	return validateIAMAuthConfig(r.Spec.IAMAuthenticatorConfig, field.NewPath("spec.iamAuthenticatorConfig"))
 }
@@ -679,7 +1048,7 @@ func (w *AWSManagedControlPlane) Default(_ context.Context, obj runtime.Object)
 	infrav1.SetDefaults_Bastion(&r.Spec.Bastion)
 	infrav1.SetDefaults_NetworkSpec(&r.Spec.NetworkSpec)
 
-	// Set default value for BootstrapSelfManagedAddons
-	r.Spec.BootstrapSelfManagedAddons = true
+	// Set default value for BootstrapSelfManagedAddons, EKS Auto Mode manages the networking addons itself
+	r.Spec.BootstrapSelfManagedAddons = r.Spec.AutoMode == nil || !r.Spec.AutoMode.Enabled
 	return nil
 }
@@ -25,7 +25,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
@@ -830,6 +833,28 @@ func TestWebhookUpdate(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "removal of the cluster kms key",
+			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				KMSKey:         &infrav1.KMSKeySpec{NodeRoles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}},
+			},
+			newClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+			},
+			expectError: true,
+		},
+		{
+			name: "addition of the cluster kms key",
+			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+			},
+			newClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: "default_cluster1",
+				KMSKey:         &infrav1.KMSKeySpec{NodeRoles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}},
+			},
+			expectError: false,
+		},
 		{
 			name: "ekscluster specified, same name, invalid tags",
 			oldClusterSpec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
@@ -1110,6 +1135,21 @@ func TestWebhookValidateAccessEntries(t *testing.T) {
 			expectError: true,
 			errorSubstr: "accessEntries can only be used when authenticationMode is set to api or api_and_config_map",
 		},
+		{
+			name: "valid access entries with config_map auth mode being migrated",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAccessEntries},
+			},
+			accessEntries: []ekscontrolplanev1.AccessEntry{
+				{
+					PrincipalARN:     "arn:aws:iam::123456789012:role/EKSAdmin",
+					Type:             ekscontrolplanev1.AccessEntryTypeStandard,
+					KubernetesGroups: []string{"admins"},
+				},
+			},
+			expectError: false,
+		},
 		{
 			name: "invalid ec2_linux access entry with kubernetes groups",
 			accessConfig: &ekscontrolplanev1.AccessConfig{
@@ -1220,3 +1260,653 @@ func TestWebhookValidateAccessEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookValidatePodIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		agent        *ekscontrolplanev1.PodIdentityAgent
+		addons       *[]ekscontrolplanev1.Addon
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name: "valid pod identity associations",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: "arn:aws:iam::123456789012:role/app"},
+				{Namespace: "other", ServiceAccountName: "app", RolePolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}},
+			},
+			agent:       &ekscontrolplanev1.PodIdentityAgent{Version: "v1.3.4-eksbuild.1"},
+			expectError: false,
+		},
+		{
+			name: "invalid with both roleARN and rolePolicies",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: "arn:aws:iam::123456789012:role/app", RolePolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}},
+			},
+			expectError: true,
+			errorSubstr: "exactly one of roleARN or rolePolicies must be set",
+		},
+		{
+			name: "invalid with duplicate service accounts",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: "arn:aws:iam::123456789012:role/app"},
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: "arn:aws:iam::123456789012:role/other"},
+			},
+			expectError: true,
+			errorSubstr: "Duplicate value",
+		},
+		{
+			name:  "invalid with the pod identity agent in the addons",
+			agent: &ekscontrolplanev1.PodIdentityAgent{Version: "v1.3.4-eksbuild.1"},
+			addons: &[]ekscontrolplanev1.Addon{
+				{Name: "eks-pod-identity-agent", Version: "v1.3.4-eksbuild.1"},
+			},
+			expectError: true,
+			errorSubstr: "the pod identity agent addon cannot be specified when podIdentityAgent is set",
+		},
+		{
+			name: "invalid addon with both a service account role and pod identity associations",
+			addons: &[]ekscontrolplanev1.Addon{
+				{
+					Name:                  "vpc-cni",
+					Version:               "v1.19.0-eksbuild.1",
+					ServiceAccountRoleArn: ptr.To("arn:aws:iam::123456789012:role/cni"),
+					PodIdentityAssociations: []ekscontrolplanev1.AddonPodIdentityAssociation{
+						{ServiceAccountName: "aws-node", RoleARN: "arn:aws:iam::123456789012:role/cni"},
+					},
+				},
+			},
+			expectError: true,
+			errorSubstr: "podIdentityAssociations cannot be specified with serviceAccountRoleARN",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          "default_cluster1",
+					Version:                 ptr.To("v1.31.0"),
+					PodIdentityAssociations: tc.associations,
+					PodIdentityAgent:        tc.agent,
+					Addons:                  tc.addons,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateAddonVersionPolicies(t *testing.T) {
+	tests := []struct {
+		name        string
+		addons      []ekscontrolplanev1.Addon
+		ipv6        bool
+		expectError bool
+		errorSubstr string
+	}{
+		{
+			name: "valid version policies",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1"},
+				{Name: "kube-proxy", Version: "v1.31.0-eksbuild.2", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyPinned},
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible},
+				{Name: "aws-ebs-csi-driver", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyClusterDefault},
+			},
+			expectError: false,
+		},
+		{
+			name: "valid resolved vpc-cni version with IPv6",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "vpc-cni", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible},
+			},
+			ipv6:        true,
+			expectError: false,
+		},
+		{
+			name: "invalid without a pinned version",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns"},
+			},
+			expectError: true,
+			errorSubstr: "version must be set with the pinned version policy",
+		},
+		{
+			name: "invalid with a version and a version policy",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", Version: "v1.11.3-eksbuild.1", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyClusterDefault},
+			},
+			expectError: true,
+			errorSubstr: "version cannot be set with the clusterDefault version policy",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        ptr.To("v1.31.0"),
+					Addons:         &tc.addons,
+				},
+			}
+			if tc.ipv6 {
+				mcp.Spec.NetworkSpec.VPC.IPv6 = &infrav1.IPv6{}
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateConfigMapMigrationUpdate(t *testing.T) {
+	migration := func(step ekscontrolplanev1.ConfigMapMigrationStep) *ekscontrolplanev1.ConfigMapMigration {
+		return &ekscontrolplanev1.ConfigMapMigration{Step: step}
+	}
+
+	tests := []struct {
+		name            string
+		oldAccessConfig *ekscontrolplanev1.AccessConfig
+		newAccessConfig *ekscontrolplanev1.AccessConfig
+		expectError     bool
+		errorSubstr     string
+	}{
+		{
+			name:            "starting a migration",
+			oldAccessConfig: &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap},
+			newAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAccessEntries),
+			},
+			expectError: false,
+		},
+		{
+			name: "proceeding to the next step",
+			oldAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAccessEntries),
+			},
+			newAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAPI),
+			},
+			expectError: false,
+		},
+		{
+			name: "going back from releasing the ConfigMap",
+			oldAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepReleaseConfigMap),
+			},
+			newAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAPI),
+			},
+			expectError: false,
+		},
+		{
+			name: "going back from the api step",
+			oldAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAPI),
+			},
+			newAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAccessEntries),
+			},
+			expectError: true,
+			errorSubstr: "going back from step API is not allowed",
+		},
+		{
+			name: "removing a migration keeping its authentication mode",
+			oldAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepReleaseConfigMap),
+			},
+			newAccessConfig: &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPI},
+			expectError:     false,
+		},
+		{
+			name: "removing a migration without its authentication mode",
+			oldAccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: migration(ekscontrolplanev1.ConfigMapMigrationStepAccessEntries),
+			},
+			newAccessConfig: &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap},
+			expectError:     true,
+			errorSubstr:     "removing configMapMigration at step AccessEntries requires authenticationMode to be api_and_config_map",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			oldMCP := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					AccessConfig:   tc.oldAccessConfig,
+				},
+			}
+			newMCP := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					AccessConfig:   tc.newAccessConfig,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateUpdate(context.Background(), oldMCP, newMCP)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateAddonDependencies(t *testing.T) {
+	tests := []struct {
+		name        string
+		addons      []ekscontrolplanev1.Addon
+		expectError bool
+		errorSubstr string
+	}{
+		{
+			name: "valid dependencies",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1"},
+				{Name: "kube-proxy", Version: "v1.31.0-eksbuild.2"},
+				{Name: "coredns", Version: "v1.11.3-eksbuild.1", DependsOn: []string{"vpc-cni", "kube-proxy"}},
+				{Name: "aws-ebs-csi-driver", Version: "v1.35.0-eksbuild.1", DependsOn: []string{"eks-pod-identity-agent"}},
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid with an unknown dependency",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", Version: "v1.11.3-eksbuild.1", DependsOn: []string{"vpc-cni"}},
+			},
+			expectError: true,
+			errorSubstr: "spec.addons[0].dependsOn[0]: Not found: \"vpc-cni\"",
+		},
+		{
+			name: "invalid with a self dependency",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", Version: "v1.11.3-eksbuild.1", DependsOn: []string{"coredns"}},
+			},
+			expectError: true,
+			errorSubstr: "an addon cannot depend on itself",
+		},
+		{
+			name: "invalid with a cycle through default dependencies",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1", DependsOn: []string{"coredns"}},
+				{Name: "coredns", Version: "v1.11.3-eksbuild.1"},
+			},
+			expectError: true,
+			errorSubstr: "addon dependency cycle between vpc-cni, coredns",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        ptr.To("v1.31.0"),
+					Addons:         &tc.addons,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateAutoMode(t *testing.T) {
+	apiAccessConfig := &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPI}
+	nodePools := []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem}
+
+	tests := []struct {
+		name         string
+		autoMode     *ekscontrolplanev1.AutoMode
+		accessConfig *ekscontrolplanev1.AccessConfig
+		oldAutoMode  *ekscontrolplanev1.AutoMode
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name: "valid auto mode with node pools",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{NodePools: nodePools, NodeRoleARN: "arn:aws:iam::123456789012:role/nodes"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  false,
+		},
+		{
+			name:         "valid auto mode without node pools",
+			autoMode:     &ekscontrolplanev1.AutoMode{Enabled: true},
+			accessConfig: apiAccessConfig,
+			expectError:  false,
+		},
+		{
+			name:        "invalid auto mode with config_map authentication mode",
+			autoMode:    &ekscontrolplanev1.AutoMode{Enabled: true},
+			expectError: true,
+			errorSubstr: "Auto Mode requires authentication mode to be either api or api_and_config_map",
+		},
+		{
+			name: "invalid node pools without node role",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{NodePools: nodePools},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "nodeRoleARN is required when nodePools are specified",
+		},
+		{
+			name: "invalid node pools with auto mode disabled",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Compute: &ekscontrolplanev1.AutoModeCompute{NodePools: nodePools, NodeRoleARN: "arn:aws:iam::123456789012:role/nodes"},
+			},
+			expectError: true,
+			errorSubstr: "nodePools can only be specified when Auto Mode is enabled",
+		},
+		{
+			name:         "valid auto mode with all capabilities enabled",
+			autoMode:     &ekscontrolplanev1.AutoMode{Enabled: true, ElasticLoadBalancing: ptr.To(true), BlockStorage: ptr.To(true)},
+			accessConfig: apiAccessConfig,
+			expectError:  false,
+		},
+		{
+			name:         "invalid load balancing not matching enabled",
+			autoMode:     &ekscontrolplanev1.AutoMode{Enabled: true, ElasticLoadBalancing: ptr.To(false)},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "spec.autoMode.elasticLoadBalancing: Invalid value: false: must match enabled",
+		},
+		{
+			name:        "invalid block storage not matching enabled",
+			autoMode:    &ekscontrolplanev1.AutoMode{BlockStorage: ptr.To(true)},
+			expectError: true,
+			errorSubstr: "spec.autoMode.blockStorage: Invalid value: true: must match enabled",
+		},
+		{
+			name: "invalid change of node role",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{NodePools: nodePools, NodeRoleARN: "arn:aws:iam::123456789012:role/other"},
+			},
+			oldAutoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{NodePools: nodePools, NodeRoleARN: "arn:aws:iam::123456789012:role/nodes"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "field is immutable",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        ptr.To("v1.31.0"),
+					AccessConfig:   tc.accessConfig,
+					AutoMode:       tc.autoMode,
+				},
+			}
+
+			var err error
+			if tc.oldAutoMode != nil {
+				oldMCP := mcp.DeepCopy()
+				oldMCP.Spec.AutoMode = tc.oldAutoMode
+				_, err = (&AWSManagedControlPlane{}).ValidateUpdate(context.Background(), oldMCP, mcp)
+			} else {
+				_, err = (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			}
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateKarpenter(t *testing.T) {
+	apiAccessConfig := &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPI}
+	karpenter := &infrav1.KarpenterSpec{
+		NodeRole:                "karpenter-node",
+		ServiceAccountNamespace: "kube-system",
+		ServiceAccountName:      "karpenter",
+	}
+
+	tests := []struct {
+		name         string
+		accessConfig *ekscontrolplanev1.AccessConfig
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		expectError  bool
+		errorSubstr  string
+	}{
+		{
+			name:         "valid karpenter",
+			accessConfig: apiAccessConfig,
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: "arn:aws:iam::123456789012:role/app"},
+			},
+			expectError: false,
+		},
+		{
+			name:        "invalid karpenter with config_map authentication mode",
+			expectError: true,
+			errorSubstr: "Karpenter requires authentication mode to be either api or api_and_config_map",
+		},
+		{
+			name:         "invalid pod identity association of the karpenter service account",
+			accessConfig: apiAccessConfig,
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "kube-system", ServiceAccountName: "karpenter", RoleARN: "arn:aws:iam::123456789012:role/karpenter"},
+			},
+			expectError: true,
+			errorSubstr: "Duplicate value",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          "default_cluster1",
+					Version:                 ptr.To("v1.31.0"),
+					AccessConfig:            tc.accessConfig,
+					PodIdentityAssociations: tc.associations,
+					Karpenter:               karpenter,
+				},
+			}
+
+			_, err := (&AWSManagedControlPlane{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestWebhookValidateRemoteNetworkConfig(t *testing.T) {
+	apiAccessConfig := &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap}
+
+	tests := []struct {
+		name                string
+		remoteNetworkConfig *ekscontrolplanev1.RemoteNetworkConfig
+		accessConfig        *ekscontrolplanev1.AccessConfig
+		serviceCIDRBlocks   []string
+		expectError         bool
+		errorSubstr         string
+	}{
+		{
+			name: "valid remote networks",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"10.201.0.0/16"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  false,
+		},
+		{
+			name: "invalid with config_map authentication mode",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+			},
+			expectError: true,
+			errorSubstr: "hybrid nodes require authentication mode to be either api or api_and_config_map",
+		},
+		{
+			name: "invalid CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "must be a valid IPv4 CIDR block",
+		},
+		{
+			name: "invalid public CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"8.8.0.0/16"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "must be within the RFC-1918 or CGNAT (100.64.0.0/10) ranges",
+		},
+		{
+			name: "invalid CIDR block overlapping the VPC",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.0.128.0/24"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "must not overlap the VPC CIDR block",
+		},
+		{
+			name: "invalid overlapping node and pod networks",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"10.200.128.0/17"},
+			},
+			accessConfig: apiAccessConfig,
+			expectError:  true,
+			errorSubstr:  "must not overlap 10.200.0.0/16",
+		},
+		{
+			name: "valid remote networks outside the service CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"10.201.0.0/16"},
+			},
+			accessConfig:      apiAccessConfig,
+			serviceCIDRBlocks: []string{"10.96.0.0/12"},
+			expectError:       false,
+		},
+		{
+			name: "invalid CIDR block overlapping the service CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"10.100.0.0/16"},
+			},
+			accessConfig:      apiAccessConfig,
+			serviceCIDRBlocks: []string{"10.96.0.0/12"},
+			expectError:       true,
+			errorSubstr:       "must not overlap the service CIDR block 10.96.0.0/12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cp",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        ptr.To("v1.31.0"),
+					AccessConfig:   tc.accessConfig,
+					NetworkSpec: infrav1.NetworkSpec{
+						VPC: infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"},
+					},
+					RemoteNetworkConfig: tc.remoteNetworkConfig,
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					ClusterNetwork: clusterv1.ClusterNetwork{
+						Services: clusterv1.NetworkRanges{CIDRBlocks: tc.serviceCIDRBlocks},
+					},
+				},
+			}
+			webhook := &AWSManagedControlPlane{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build(),
+			}
+
+			_, err := webhook.ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Error()).To(ContainSubstring(tc.errorSubstr))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// BreakGlassCredentialsRotateAnnotation can be set on a ROSAControlPlane to issue new break glass credentials
+	// for all the credentials of spec.breakGlassCredentials. The credentials issued before remain valid until
+	// they expire or are revoked. The annotation is removed once all the credentials are rotated.
+	BreakGlassCredentialsRotateAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-rotate-break-glass-credentials"
+
+	// BreakGlassCredentialsRevokeAnnotation can be set on a ROSAControlPlane to revoke all the break glass
+	// credentials of the cluster, including the ones not issued through spec.breakGlassCredentials.
+	// The credentials of spec.breakGlassCredentials and the kubeconfig of the cluster are issued again afterwards.
+	// The annotation is removed once the credentials are revoked.
+	BreakGlassCredentialsRevokeAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-revoke-break-glass-credentials"
+
+	// BreakGlassCredentialNameLabel is set on the secrets holding the kubeconfig of a break glass credential
+	// of spec.breakGlassCredentials, to the name of the credential.
+	BreakGlassCredentialNameLabel = "controlplane.cluster.x-k8s.io/break-glass-credential"
+)
+
+// BreakGlassCredential is a named break glass credential, giving cluster-admin access to a cluster using
+// external auth providers when the providers are unavailable.
+type BreakGlassCredential struct {
+	// Name of the credential. The kubeconfig of the credential is stored in the
+	// "<cluster name>-break-glass-<name>" secret, and its username is prefixed with the name.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// TTL is how long the credential is valid for, between 10 minutes and 24 hours. The credential is issued
+	// again before it expires. Defaults to 24 hours.
+	//
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// BreakGlassCredentialStatus is an active break glass credential of the cluster.
+type BreakGlassCredentialStatus struct {
+	// ID of the credential in OCM.
+	ID string `json:"id"`
+
+	// Username of the credential.
+	Username string `json:"username"`
+
+	// Name is the name of the credential in spec.breakGlassCredentials, if it was issued for it.
+	//
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ExpirationTimestamp is when the credential expires.
+	//
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty"`
+}
@@ -31,6 +31,21 @@ const (
 	// ExternalAuthConfiguredCondition condition reports whether external auth has beed correctly configured.
 	ExternalAuthConfiguredCondition clusterv1beta1.ConditionType = "ExternalAuthConfigured"
 
+	// BreakGlassCredentialsConfiguredCondition condition reports whether the break glass credentials have been correctly issued.
+	BreakGlassCredentialsConfiguredCondition clusterv1beta1.ConditionType = "BreakGlassCredentialsConfigured"
+
+	// DefaultIngressConfiguredCondition condition reports whether the default ingress controller has been correctly configured.
+	DefaultIngressConfiguredCondition clusterv1beta1.ConditionType = "DefaultIngressConfigured"
+
+	// IdentityProvidersConfiguredCondition condition reports whether the identity providers have been correctly configured.
+	IdentityProvidersConfiguredCondition clusterv1beta1.ConditionType = "IdentityProvidersConfigured"
+
+	// ClusterAutoscalerConfiguredCondition condition reports whether the cluster autoscaler has been correctly configured.
+	ClusterAutoscalerConfiguredCondition clusterv1beta1.ConditionType = "ClusterAutoscalerConfigured"
+
+	// NodeConfigsConfiguredCondition condition reports whether the kubelet and tuning configs have been correctly configured.
+	NodeConfigsConfiguredCondition clusterv1beta1.ConditionType = "NodeConfigsConfigured"
+
 	// ROSARoleConfigReadyCondition condition reports whether the referenced RosaRoleConfig is ready.
 	ROSARoleConfigReadyCondition clusterv1beta1.ConditionType = "ROSARoleConfigReady"
 
@@ -43,6 +58,16 @@ const (
 	// ROSAControlPlaneInvalidConfigurationReason used to report invalid user input.
 	ROSAControlPlaneInvalidConfigurationReason = "InvalidConfiguration"
 
+	// WaitingForMaintenanceWindowReason used when a version upgrade is held until the next maintenance window.
+	WaitingForMaintenanceWindowReason = "WaitingForMaintenanceWindow"
+
+	// IdentityProvidersNotManagedReason used when identity providers of the spec exist in the cluster but weren't
+	// created from the spec, and are left untouched.
+	IdentityProvidersNotManagedReason = "IdentityProvidersNotManaged"
+
+	// NodeConfigsInUseReason used when kubelet or tuning configs removed from the spec are kept while node pools use them.
+	NodeConfigsInUseReason = "NodeConfigsInUse"
+
 	// ROSARoleConfigNotReadyReason used to report when referenced RosaRoleConfig is not ready.
 	ROSARoleConfigNotReadyReason = "ROSARoleConfigNotReady"
 
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+// IdentityProviderType is the type of an identity provider.
+type IdentityProviderType string
+
+const (
+	// GitHubIdentityProviderType authenticates users with GitHub or GitHub Enterprise.
+	GitHubIdentityProviderType IdentityProviderType = "GitHub"
+
+	// GitLabIdentityProviderType authenticates users with GitLab.
+	GitLabIdentityProviderType IdentityProviderType = "GitLab"
+
+	// GoogleIdentityProviderType authenticates users with Google.
+	GoogleIdentityProviderType IdentityProviderType = "Google"
+
+	// LDAPIdentityProviderType authenticates users with an LDAP server.
+	LDAPIdentityProviderType IdentityProviderType = "LDAP"
+
+	// OpenIDIdentityProviderType authenticates users with an OpenID Connect provider.
+	OpenIDIdentityProviderType IdentityProviderType = "OpenID"
+)
+
+// IdentityProviderMappingMethod specifies how identities of an identity provider are mapped to users.
+type IdentityProviderMappingMethod string
+
+const (
+	// IdentityProviderMappingMethodClaim provisions a user with the preferred username of the identity,
+	// failing if the user is already mapped to another identity.
+	IdentityProviderMappingMethodClaim IdentityProviderMappingMethod = "claim"
+
+	// IdentityProviderMappingMethodLookup looks up an existing user, without provisioning users.
+	IdentityProviderMappingMethodLookup IdentityProviderMappingMethod = "lookup"
+
+	// IdentityProviderMappingMethodGenerate provisions a user with the preferred username of the identity,
+	// generating a unique username if it is already mapped to another identity.
+	IdentityProviderMappingMethodGenerate IdentityProviderMappingMethod = "generate"
+
+	// IdentityProviderMappingMethodAdd provisions a user with the preferred username of the identity,
+	// adding the identity to the user if it already exists.
+	IdentityProviderMappingMethodAdd IdentityProviderMappingMethod = "add"
+)
+
+// IdentityProvider is an identity provider users log in to the cluster with through the OpenShift OAuth server.
+// The configuration matching its type must be set.
+type IdentityProvider struct {
+	// Name of the identity provider, shown to users on the login page.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	// +required
+	Name string `json:"name"`
+
+	// Type of the identity provider.
+	//
+	// +kubebuilder:validation:Enum=GitHub;GitLab;Google;LDAP;OpenID
+	// +required
+	Type IdentityProviderType `json:"type"`
+
+	// MappingMethod specifies how identities of the identity provider are mapped to users.
+	// Defaults to claim.
+	//
+	// +kubebuilder:validation:Enum=claim;lookup;generate;add
+	// +kubebuilder:default=claim
+	// +optional
+	MappingMethod IdentityProviderMappingMethod `json:"mappingMethod,omitempty"`
+
+	// GitHub configures a GitHub identity provider.
+	// +optional
+	GitHub *GitHubIdentityProvider `json:"github,omitempty"`
+
+	// GitLab configures a GitLab identity provider.
+	// +optional
+	GitLab *GitLabIdentityProvider `json:"gitlab,omitempty"`
+
+	// Google configures a Google identity provider.
+	// +optional
+	Google *GoogleIdentityProvider `json:"google,omitempty"`
+
+	// LDAP configures an LDAP identity provider.
+	// +optional
+	LDAP *LDAPIdentityProvider `json:"ldap,omitempty"`
+
+	// OpenID configures an OpenID Connect identity provider.
+	// +optional
+	OpenID *OpenIDIdentityProvider `json:"openID,omitempty"`
+}
+
+// GitHubIdentityProvider configures a GitHub identity provider.
+type GitHubIdentityProvider struct {
+	// ClientID is the client ID of the registered GitHub OAuth application.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ClientID string `json:"clientID"`
+
+	// ClientSecret refers to a secret that
+	// contains the client secret in the `clientSecret` key of the `.data` field
+	ClientSecret LocalObjectReference `json:"clientSecret"`
+
+	// Hostname is the host name of a GitHub Enterprise instance.
+	// Defaults to github.com when not set.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// Organizations restricts logins to the members of at least one of the listed organizations.
+	// Organizations and Teams are mutually exclusive.
+	//
+	// +listType=set
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// Teams restricts logins to the members of at least one of the listed teams, in the org/team format.
+	// Organizations and Teams are mutually exclusive.
+	//
+	// +listType=set
+	// +optional
+	Teams []string `json:"teams,omitempty"`
+
+	// CertificateAuthority is a reference to a config map holding the CA bundle of a GitHub Enterprise instance
+	// in the "ca-bundle.crt" key.
+	// If unset, system trust is used instead.
+	// +optional
+	CertificateAuthority *LocalObjectReference `json:"certificateAuthority,omitempty"`
+}
+
+// GitLabIdentityProvider configures a GitLab identity provider.
+type GitLabIdentityProvider struct {
+	// URL is the URL of the GitLab instance, for example "https://gitlab.com".
+	//
+	// +kubebuilder:validation:Pattern=`^https:\/\/[^\s]`
+	// +required
+	URL string `json:"url"`
+
+	// ClientID is the client ID of the registered GitLab OAuth application.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ClientID string `json:"clientID"`
+
+	// ClientSecret refers to a secret that
+	// contains the client secret in the `clientSecret` key of the `.data` field
+	ClientSecret LocalObjectReference `json:"clientSecret"`
+
+	// CertificateAuthority is a reference to a config map holding the CA bundle of the GitLab instance
+	// in the "ca-bundle.crt" key.
+	// If unset, system trust is used instead.
+	// +optional
+	CertificateAuthority *LocalObjectReference `json:"certificateAuthority,omitempty"`
+}
+
+// GoogleIdentityProvider configures a Google identity provider.
+type GoogleIdentityProvider struct {
+	// ClientID is the client ID of the registered Google project.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ClientID string `json:"clientID"`
+
+	// ClientSecret refers to a secret that
+	// contains the client secret in the `clientSecret` key of the `.data` field
+	ClientSecret LocalObjectReference `json:"clientSecret"`
+
+	// HostedDomain restricts logins to the users of a Google Apps domain.
+	// Required unless the mapping method is lookup.
+	// +optional
+	HostedDomain string `json:"hostedDomain,omitempty"`
+}
+
+// LDAPIdentityProvider configures an LDAP identity provider.
+type LDAPIdentityProvider struct {
+	// URL is an RFC 2255 URL specifying the LDAP host and search parameters,
+	// for example "ldaps://ldap.example.com/ou=users,dc=example,dc=com?uid".
+	//
+	// +kubebuilder:validation:Pattern=`^ldaps?:\/\/[^\s]`
+	// +required
+	URL string `json:"url"`
+
+	// BindDN is the DN to bind with during the search phase.
+	// +optional
+	BindDN string `json:"bindDN,omitempty"`
+
+	// BindPassword refers to a secret that
+	// contains the password to bind with during the search phase in the `bindPassword` key of the `.data` field.
+	// Required when BindDN is set.
+	// +optional
+	BindPassword *LocalObjectReference `json:"bindPassword,omitempty"`
+
+	// Insecure allows connecting to the LDAP server without TLS, with a ldap:// URL.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CertificateAuthority is a reference to a config map holding the CA bundle of the LDAP server
+	// in the "ca-bundle.crt" key.
+	// If unset, system trust is used instead.
+	// +optional
+	CertificateAuthority *LocalObjectReference `json:"certificateAuthority,omitempty"`
+
+	// Attributes maps LDAP attributes to identities.
+	// +optional
+	Attributes LDAPAttributes `json:"attributes,omitempty"`
+}
+
+// LDAPAttributes maps LDAP attributes to identities. The first non-empty attribute of each list is used.
+type LDAPAttributes struct {
+	// ID is the list of attributes whose value should be used as the user ID. Defaults to "dn".
+	// +optional
+	ID []string `json:"id,omitempty"`
+
+	// Email is the list of attributes whose value should be used as the email address.
+	// +optional
+	Email []string `json:"email,omitempty"`
+
+	// Name is the list of attributes whose value should be used as the display name.
+	// +optional
+	Name []string `json:"name,omitempty"`
+
+	// PreferredUsername is the list of attributes whose value should be used as the preferred username.
+	// Defaults to "uid".
+	// +optional
+	PreferredUsername []string `json:"preferredUsername,omitempty"`
+}
+
+// OpenIDIdentityProvider configures an OpenID Connect identity provider.
+type OpenIDIdentityProvider struct {
+	// Issuer is the URL the OpenID provider asserts as its issuer identifier.
+	// Must use the https:// scheme.
+	//
+	// +kubebuilder:validation:Pattern=`^https:\/\/[^\s]`
+	// +required
+	Issuer string `json:"issuer"`
+
+	// ClientID is the client ID of the application registered with the OpenID provider.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ClientID string `json:"clientID"`
+
+	// ClientSecret refers to a secret that
+	// contains the client secret in the `clientSecret` key of the `.data` field
+	ClientSecret LocalObjectReference `json:"clientSecret"`
+
+	// CertificateAuthority is a reference to a config map holding the CA bundle of the OpenID provider
+	// in the "ca-bundle.crt" key.
+	// If unset, system trust is used instead.
+	// +optional
+	CertificateAuthority *LocalObjectReference `json:"certificateAuthority,omitempty"`
+
+	// Claims maps the claims of the ID token to identities and groups.
+	Claims OpenIDClaims `json:"claims"`
+
+	// ExtraScopes are the scopes to request in addition to the openid scope.
+	//
+	// +listType=set
+	// +optional
+	ExtraScopes []string `json:"extraScopes,omitempty"`
+
+	// ExtraAuthorizeParameters are parameters added to the authorize request.
+	// +optional
+	ExtraAuthorizeParameters map[string]string `json:"extraAuthorizeParameters,omitempty"`
+}
+
+// OpenIDClaims maps the claims of an ID token to identities and groups. The first non-empty claim of each list is used.
+type OpenIDClaims struct {
+	// Email is the list of claims whose value should be used as the email address.
+	// +optional
+	Email []string `json:"email,omitempty"`
+
+	// Groups is the list of claims whose value should be used to synchronize the groups of the user
+	// into OpenShift groups.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Name is the list of claims whose value should be used as the display name.
+	// +optional
+	Name []string `json:"name,omitempty"`
+
+	// PreferredUsername is the list of claims whose value should be used as the preferred username.
+	// +optional
+	PreferredUsername []string `json:"preferredUsername,omitempty"`
+}
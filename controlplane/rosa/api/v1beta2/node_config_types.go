@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// KubeletConfig is a kubelet configuration which ROSAMachinePools apply to their nodes by referencing its name
+// in spec.kubeletConfigs.
+type KubeletConfig struct {
+	// Name of the kubelet config.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// PodPidsLimit is the maximum number of processes allowed in each pod.
+	//
+	// +kubebuilder:validation:Minimum=4096
+	// +kubebuilder:validation:Maximum=16384
+	// +required
+	PodPidsLimit int32 `json:"podPidsLimit"`
+}
+
+// TuningConfig is a node tuning configuration which ROSAMachinePools apply to their nodes by referencing its name
+// in spec.tuningConfigs.
+type TuningConfig struct {
+	// Name of the tuning config.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// Spec is the spec of the Tuned object applied by the Node Tuning Operator, holding its profiles
+	// and recommendations.
+	// See https://docs.openshift.com/rosa/rosa_hcp/rosa-tuning-config.html.
+	//
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +required
+	Spec runtime.RawExtension `json:"spec"`
+}
@@ -105,6 +105,7 @@ const (
 )
 
 // RosaControlPlaneSpec defines the desired state of ROSAControlPlane.
+// +kubebuilder:validation:XValidation:rule="!(has(self.ocmAccountRef) && has(self.credentialsSecretRef))",message="ocmAccountRef and credentialsSecretRef are mutually exclusive"
 type RosaControlPlaneSpec struct { //nolint: maligned
 	// Cluster name must be valid DNS-1035 label, so it must consist of lower case alphanumeric
 	// characters or '-', start with an alphabetic character, end with an alphanumeric character
@@ -188,6 +189,14 @@ type RosaControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:default=WaitForAcknowledge
 	VersionGate VersionGateAckType `json:"versionGate"`
 
+	// MaintenanceWindow restricts when version upgrades of the control plane and of the machine pools
+	// that don't set their own window start.
+	// When set, a version change is held as pending until the next maintenance window opens, and only then scheduled in OCM.
+	// When not set, version upgrades are scheduled right away.
+	//
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
 	// RosaRoleConfigRef is a reference to a RosaRoleConfig resource that contains account roles, operator roles and OIDC configuration.
 	// RosaRoleConfigRef and role fields such as installerRoleARN, supportRoleARN, workerRoleARN, rolesRef and oidcID are mutually exclusive.
 	//
@@ -232,6 +241,28 @@ type RosaControlPlaneSpec struct { //nolint: maligned
 	// +kubebuilder:validation:MaxItems=1
 	ExternalAuthProviders []ExternalAuthProvider `json:"externalAuthProviders,omitempty"`
 
+	// BreakGlassCredentials are named break glass credentials issued for the cluster, each stored in its own
+	// kubeconfig secret and issued again before it expires. Credentials removed from the list have their secret
+	// deleted, but remain valid in OCM until they expire or are revoked.
+	// Can only be set if "enableExternalAuthProviders" is set to "True".
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	BreakGlassCredentials []BreakGlassCredential `json:"breakGlassCredentials,omitempty"`
+
+	// IdentityProviders are the identity providers users log in to the cluster with through the OpenShift OAuth server.
+	// Identity providers removed from the list are deleted from the cluster.
+	// Identity providers created outside of this list, such as the cluster-admin one, are left untouched.
+	// Can only be set if "enableExternalAuthProviders" is set to "False".
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=50
+	// +optional
+	IdentityProviders []IdentityProvider `json:"identityProviders,omitempty"`
+
 	// InstallerRoleARN is an AWS IAM role that OpenShift Cluster Manager will assume to create the cluster.
 	// Required if RosaRoleConfigRef is not specified.
 	// +optional
@@ -285,6 +316,30 @@ type RosaControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	DefaultMachinePoolSpec DefaultMachinePoolSpec `json:"defaultMachinePoolSpec,omitempty"`
 
+	// KubeletConfigs are the kubelet configurations available to the ROSAMachinePools of the cluster.
+	// A kubelet config referenced by a machine pool is only deleted once no machine pool uses it.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	KubeletConfigs []KubeletConfig `json:"kubeletConfigs,omitempty"`
+
+	// TuningConfigs are the node tuning configurations available to the ROSAMachinePools of the cluster.
+	// A tuning config referenced by a machine pool is only deleted once no machine pool uses it.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	TuningConfigs []TuningConfig `json:"tuningConfigs,omitempty"`
+
+	// Autoscaler configures the cluster autoscaler, which scales the machine pools with autoscaling enabled.
+	// Changes made to the autoscaler outside of this field are reverted.
+	// The autoscaler is deleted, restoring the default autoscaling behavior, when this field is removed.
+	// +optional
+	Autoscaler *ClusterAutoscaler `json:"autoscaler,omitempty"`
+
 	// Network config for the ROSA HCP cluster.
 	// +optional
 	Network *NetworkSpec `json:"network,omitempty"`
@@ -297,6 +352,12 @@ type RosaControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	EndpointAccess RosaEndpointAccessType `json:"endpointAccess,omitempty"`
 
+	// Ingress configures the default ingress controller of the cluster, which serves the routes of the applications.
+	// The settings are applied when the cluster is created, and changes made to them outside of this field are reverted.
+	// The default ingress controller keeps its current settings when this field is removed.
+	// +optional
+	Ingress *DefaultIngress `json:"ingress,omitempty"`
+
 	// AdditionalTags are user-defined tags to be added on the AWS resources associated with the control plane.
 	// +optional
 	AdditionalTags infrav1.Tags `json:"additionalTags,omitempty"`
@@ -324,6 +385,11 @@ type RosaControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 
+	// OCMAccountRef references the cluster-scoped OCMAccount whose service account is used to connect to the OCM API,
+	// instead of the credentials of credentialsSecretRef. The namespace of the ROSAControlPlane must be allowed by the OCMAccount.
+	// +optional
+	OCMAccountRef *corev1.LocalObjectReference `json:"ocmAccountRef,omitempty"`
+
 	// IdentityRef is a reference to an identity to be used when reconciling the managed control plane.
 	// If no identity is specified, the default identity for this controller will be used.
 	//
@@ -497,6 +563,90 @@ type NetworkSpec struct {
 	NetworkType string `json:"networkType,omitempty"`
 }
 
+// IngressListeningMethod specifies whether the default ingress controller is reachable from the internet.
+type IngressListeningMethod string
+
+const (
+	// IngressListeningExternal publishes the routes of the applications on a public load balancer.
+	IngressListeningExternal IngressListeningMethod = "External"
+
+	// IngressListeningInternal publishes the routes of the applications on a private load balancer only.
+	IngressListeningInternal IngressListeningMethod = "Internal"
+)
+
+// NamespaceOwnershipPolicy specifies whether routes can claim the same host name across namespaces.
+type NamespaceOwnershipPolicy string
+
+const (
+	// NamespaceOwnershipPolicyStrict prevents routes in different namespaces from claiming the same host name.
+	NamespaceOwnershipPolicyStrict NamespaceOwnershipPolicy = "Strict"
+
+	// NamespaceOwnershipPolicyInterNamespaceAllowed allows routes to claim different paths of the same host name
+	// across namespaces.
+	NamespaceOwnershipPolicyInterNamespaceAllowed NamespaceOwnershipPolicy = "InterNamespaceAllowed"
+)
+
+// WildcardPolicy specifies whether routes with a wildcard host name are admitted.
+type WildcardPolicy string
+
+const (
+	// WildcardPolicyWildcardsDisallowed only admits routes with a wildcard policy of None.
+	WildcardPolicyWildcardsDisallowed WildcardPolicy = "WildcardsDisallowed"
+
+	// WildcardPolicyWildcardsAllowed admits routes with any wildcard policy.
+	WildcardPolicyWildcardsAllowed WildcardPolicy = "WildcardsAllowed"
+)
+
+// IngressLoadBalancerType specifies the type of AWS load balancer of the default ingress controller.
+type IngressLoadBalancerType string
+
+const (
+	// IngressLoadBalancerClassic uses a Classic Load Balancer.
+	IngressLoadBalancerClassic IngressLoadBalancerType = "Classic"
+
+	// IngressLoadBalancerNLB uses a Network Load Balancer.
+	IngressLoadBalancerNLB IngressLoadBalancerType = "NLB"
+)
+
+// DefaultIngress configures the default ingress controller of the cluster. Unset fields keep the values set by OCM.
+type DefaultIngress struct {
+	// Listening specifies whether the routes of the applications are published on a public or a private
+	// load balancer. When not set, the routes are published like the API server, according to endpointAccess,
+	// when the cluster is created.
+	//
+	// +kubebuilder:validation:Enum=External;Internal
+	// +optional
+	Listening IngressListeningMethod `json:"listening,omitempty"`
+
+	// RouteSelectors restricts the routes served by the default ingress controller to the routes with these labels.
+	// +optional
+	RouteSelectors map[string]string `json:"routeSelectors,omitempty"`
+
+	// ExcludedNamespaces are the namespaces whose routes are not served by the default ingress controller.
+	//
+	// +listType=set
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// RouteNamespaceOwnershipPolicy specifies whether routes in different namespaces can claim the same host name.
+	//
+	// +kubebuilder:validation:Enum=Strict;InterNamespaceAllowed
+	// +optional
+	RouteNamespaceOwnershipPolicy NamespaceOwnershipPolicy `json:"routeNamespaceOwnershipPolicy,omitempty"`
+
+	// RouteWildcardPolicy specifies whether routes with a wildcard host name are admitted.
+	//
+	// +kubebuilder:validation:Enum=WildcardsDisallowed;WildcardsAllowed
+	// +optional
+	RouteWildcardPolicy WildcardPolicy `json:"routeWildcardPolicy,omitempty"`
+
+	// LoadBalancerType is the type of AWS load balancer of the default ingress controller.
+	//
+	// +kubebuilder:validation:Enum=Classic;NLB
+	// +optional
+	LoadBalancerType IngressLoadBalancerType `json:"loadBalancerType,omitempty"`
+}
+
 // DefaultMachinePoolSpec defines the configuration for the required worker nodes provisioned as part of the cluster creation.
 type DefaultMachinePoolSpec struct {
 	// The instance type to use, for example `r5.xlarge`. Instance type ref; https://aws.amazon.com/ec2/instance-types/
@@ -524,6 +674,84 @@ type AutoScaling struct {
 	MaxReplicas int `json:"maxReplicas,omitempty"`
 }
 
+// ClusterAutoscaler configures the cluster autoscaler. Unset fields keep the defaults set by OCM.
+type ClusterAutoscaler struct {
+	// BalanceSimilarNodeGroups balances the number of nodes of the machine pools with the same instance type
+	// and labels.
+	// +optional
+	BalanceSimilarNodeGroups *bool `json:"balanceSimilarNodeGroups,omitempty"`
+
+	// BalancingIgnoredLabels are the node labels ignored when comparing machine pools to balance.
+	//
+	// +listType=set
+	// +optional
+	BalancingIgnoredLabels []string `json:"balancingIgnoredLabels,omitempty"`
+
+	// SkipNodesWithLocalStorage prevents removing the nodes running pods with local storage,
+	// such as EmptyDir or HostPath volumes.
+	// +optional
+	SkipNodesWithLocalStorage *bool `json:"skipNodesWithLocalStorage,omitempty"`
+
+	// IgnoreDaemonSetsUtilization ignores the pods of daemon sets when computing the utilization of a node
+	// for scale down.
+	// +optional
+	IgnoreDaemonSetsUtilization *bool `json:"ignoreDaemonSetsUtilization,omitempty"`
+
+	// MaxPodGracePeriod is the graceful termination period given to pods, in seconds, when scaling down a node.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxPodGracePeriod *int32 `json:"maxPodGracePeriod,omitempty"`
+
+	// PodPriorityThreshold is the priority below which pods neither trigger scale up nor prevent scale down.
+	// +optional
+	PodPriorityThreshold *int32 `json:"podPriorityThreshold,omitempty"`
+
+	// MaxNodeProvisionTime is how long to wait for a node to be provisioned before giving up on it.
+	// +optional
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty"`
+
+	// MaxNodesTotal is the maximum number of nodes of all the machine pools with autoscaling enabled.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxNodesTotal *int32 `json:"maxNodesTotal,omitempty"`
+
+	// ScaleDown configures when nodes are removed.
+	// +optional
+	ScaleDown *AutoscalerScaleDown `json:"scaleDown,omitempty"`
+}
+
+// AutoscalerScaleDown configures when the cluster autoscaler removes nodes.
+type AutoscalerScaleDown struct {
+	// Enabled allows removing nodes.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// UnneededTime is how long a node must be unneeded before it is removed.
+	// +optional
+	UnneededTime *metav1.Duration `json:"unneededTime,omitempty"`
+
+	// UtilizationThreshold is the ratio of requested resources to capacity below which a node is unneeded,
+	// for example "0.5".
+	//
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	// +optional
+	UtilizationThreshold string `json:"utilizationThreshold,omitempty"`
+
+	// DelayAfterAdd is how long after scaling up scale down evaluation resumes.
+	// +optional
+	DelayAfterAdd *metav1.Duration `json:"delayAfterAdd,omitempty"`
+
+	// DelayAfterDelete is how long after removing a node scale down evaluation resumes.
+	// +optional
+	DelayAfterDelete *metav1.Duration `json:"delayAfterDelete,omitempty"`
+
+	// DelayAfterFailure is how long after a failed scale down scale down evaluation resumes.
+	// +optional
+	DelayAfterFailure *metav1.Duration `json:"delayAfterFailure,omitempty"`
+}
+
 // AWSRolesRef contains references to various AWS IAM roles required for operators to make calls against the AWS API.
 type AWSRolesRef struct {
 	// The referenced role must have a trust relationship that allows it to be assumed via web identity.
@@ -902,6 +1130,60 @@ type AWSRolesRef struct {
 	KMSProviderARN          string `json:"kmsProviderARN"`
 }
 
+// MaintenanceWindow defines recurring windows during which version upgrades are allowed to start.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression in the standard five-field format (minute, hour, day of month, month, day of week)
+	// defining when the maintenance windows open, for example "0 2 * * 6" for every Saturday at 02:00.
+	//
+	// +kubebuilder:validation:MinLength:=1
+	Schedule string `json:"schedule"`
+
+	// Duration is how long each maintenance window stays open, for example "4h".
+	// Upgrades are only scheduled to start while a window is open.
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone the schedule and blackout dates are evaluated in, for example "Europe/Berlin".
+	// Defaults to UTC.
+	//
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// BlackoutDates are the days during which no upgrade starts, even if a maintenance window is open.
+	//
+	// +optional
+	BlackoutDates []BlackoutPeriod `json:"blackoutDates,omitempty"`
+}
+
+// BlackoutPeriod is a range of days during which no upgrade starts.
+type BlackoutPeriod struct {
+	// Start is the first day of the period, in the YYYY-MM-DD format.
+	//
+	// +kubebuilder:validation:Pattern:=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	Start string `json:"start"`
+
+	// End is the last day of the period, in the YYYY-MM-DD format.
+	// Defaults to Start, making the period a single day.
+	//
+	// +kubebuilder:validation:Pattern:=`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	// +optional
+	End string `json:"end,omitempty"`
+}
+
+// PendingUpgrade reports a version upgrade which hasn't completed yet.
+type PendingUpgrade struct {
+	// Version is the OpenShift version to upgrade to.
+	Version string `json:"version"`
+
+	// ScheduledAt is when the upgrade starts, or started.
+	ScheduledAt metav1.Time `json:"scheduledAt"`
+
+	// WaitingForMaintenanceWindow is true while the upgrade is held by the controller until the maintenance window
+	// opening at ScheduledAt, and false once the upgrade is scheduled in OCM.
+	//
+	// +optional
+	WaitingForMaintenanceWindow bool `json:"waitingForMaintenanceWindow,omitempty"`
+}
+
 // RosaControlPlaneStatus defines the observed state of ROSAControlPlane.
 type RosaControlPlaneStatus struct {
 	// ExternalManagedControlPlane indicates to cluster-api that the control plane
@@ -945,6 +1227,23 @@ type RosaControlPlaneStatus struct {
 
 	// Available channels for the ROSA hosted control plane.
 	AvailableChannels []string `json:"availableChannels,omitempty"`
+
+	// PendingUpgrade is the version upgrade of the control plane which hasn't completed yet, if any.
+	// +optional
+	PendingUpgrade *PendingUpgrade `json:"pendingUpgrade,omitempty"`
+
+	// BreakGlassCredentials are the active break glass credentials of the cluster, which are issued and not expired.
+	// +optional
+	BreakGlassCredentials []BreakGlassCredentialStatus `json:"breakGlassCredentials,omitempty"`
+
+	// RotatedBreakGlassCredentials are the names of the credentials of spec.breakGlassCredentials which have
+	// already been issued again for the rotation requested with the BreakGlassCredentialsRotateAnnotation annotation.
+	// +optional
+	RotatedBreakGlassCredentials []string `json:"rotatedBreakGlassCredentials,omitempty"`
+
+	// BreakGlassCredentialsRevokedAt is when all the break glass credentials of the cluster were last revoked.
+	// +optional
+	BreakGlassCredentialsRevokedAt *metav1.Time `json:"breakGlassCredentialsRevokedAt,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,8 +21,9 @@ limitations under the License.
 package v1beta2
 
 import (
-	"k8s.io/api/core/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta2 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
@@ -72,6 +73,100 @@ func (in *AutoScaling) DeepCopy() *AutoScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerScaleDown) DeepCopyInto(out *AutoscalerScaleDown) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UnneededTime != nil {
+		in, out := &in.UnneededTime, &out.UnneededTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DelayAfterAdd != nil {
+		in, out := &in.DelayAfterAdd, &out.DelayAfterAdd
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DelayAfterDelete != nil {
+		in, out := &in.DelayAfterDelete, &out.DelayAfterDelete
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DelayAfterFailure != nil {
+		in, out := &in.DelayAfterFailure, &out.DelayAfterFailure
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerScaleDown.
+func (in *AutoscalerScaleDown) DeepCopy() *AutoscalerScaleDown {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerScaleDown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutPeriod) DeepCopyInto(out *BlackoutPeriod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutPeriod.
+func (in *BlackoutPeriod) DeepCopy() *BlackoutPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BreakGlassCredential) DeepCopyInto(out *BreakGlassCredential) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BreakGlassCredential.
+func (in *BreakGlassCredential) DeepCopy() *BreakGlassCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(BreakGlassCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BreakGlassCredentialStatus) DeepCopyInto(out *BreakGlassCredentialStatus) {
+	*out = *in
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BreakGlassCredentialStatus.
+func (in *BreakGlassCredentialStatus) DeepCopy() *BreakGlassCredentialStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BreakGlassCredentialStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudWatchLogForwarderConfig) DeepCopyInto(out *CloudWatchLogForwarderConfig) {
 	*out = *in
@@ -97,6 +192,93 @@ func (in *CloudWatchLogForwarderConfig) DeepCopy() *CloudWatchLogForwarderConfig
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
+	*out = *in
+	if in.BalanceSimilarNodeGroups != nil {
+		in, out := &in.BalanceSimilarNodeGroups, &out.BalanceSimilarNodeGroups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BalancingIgnoredLabels != nil {
+		in, out := &in.BalancingIgnoredLabels, &out.BalancingIgnoredLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkipNodesWithLocalStorage != nil {
+		in, out := &in.SkipNodesWithLocalStorage, &out.SkipNodesWithLocalStorage
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreDaemonSetsUtilization != nil {
+		in, out := &in.IgnoreDaemonSetsUtilization, &out.IgnoreDaemonSetsUtilization
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxPodGracePeriod != nil {
+		in, out := &in.MaxPodGracePeriod, &out.MaxPodGracePeriod
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodPriorityThreshold != nil {
+		in, out := &in.PodPriorityThreshold, &out.PodPriorityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNodeProvisionTime != nil {
+		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxNodesTotal != nil {
+		in, out := &in.MaxNodesTotal, &out.MaxNodesTotal
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(AutoscalerScaleDown)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaler.
+func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultIngress) DeepCopyInto(out *DefaultIngress) {
+	*out = *in
+	if in.RouteSelectors != nil {
+		in, out := &in.RouteSelectors, &out.RouteSelectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultIngress.
+func (in *DefaultIngress) DeepCopy() *DefaultIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultMachinePoolSpec) DeepCopyInto(out *DefaultMachinePoolSpec) {
 	*out = *in
@@ -150,6 +332,190 @@ func (in *ExternalAuthProvider) DeepCopy() *ExternalAuthProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitHubIdentityProvider) DeepCopyInto(out *GitHubIdentityProvider) {
+	*out = *in
+	out.ClientSecret = in.ClientSecret
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubIdentityProvider.
+func (in *GitHubIdentityProvider) DeepCopy() *GitHubIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GitHubIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabIdentityProvider) DeepCopyInto(out *GitLabIdentityProvider) {
+	*out = *in
+	out.ClientSecret = in.ClientSecret
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProvider.
+func (in *GitLabIdentityProvider) DeepCopy() *GitLabIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleIdentityProvider) DeepCopyInto(out *GoogleIdentityProvider) {
+	*out = *in
+	out.ClientSecret = in.ClientSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleIdentityProvider.
+func (in *GoogleIdentityProvider) DeepCopy() *GoogleIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
+	*out = *in
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(GitHubIdentityProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitLab != nil {
+		in, out := &in.GitLab, &out.GitLab
+		*out = new(GitLabIdentityProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Google != nil {
+		in, out := &in.Google, &out.Google
+		*out = new(GoogleIdentityProvider)
+		**out = **in
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPIdentityProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenID != nil {
+		in, out := &in.OpenID, &out.OpenID
+		*out = new(OpenIDIdentityProvider)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProvider.
+func (in *IdentityProvider) DeepCopy() *IdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPAttributes) DeepCopyInto(out *LDAPAttributes) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferredUsername != nil {
+		in, out := &in.PreferredUsername, &out.PreferredUsername
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPAttributes.
+func (in *LDAPAttributes) DeepCopy() *LDAPAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPIdentityProvider) DeepCopyInto(out *LDAPIdentityProvider) {
+	*out = *in
+	if in.BindPassword != nil {
+		in, out := &in.BindPassword, &out.BindPassword
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	in.Attributes.DeepCopyInto(&out.Attributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProvider.
+func (in *LDAPIdentityProvider) DeepCopy() *LDAPIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalObjectReference) DeepCopyInto(out *LocalObjectReference) {
 	*out = *in
@@ -165,6 +531,27 @@ func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.BlackoutDates != nil {
+		in, out := &in.BlackoutDates, &out.BlackoutDates
+		*out = make([]BlackoutPeriod, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -201,6 +588,91 @@ func (in *OIDCClientConfig) DeepCopy() *OIDCClientConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDClaims) DeepCopyInto(out *OpenIDClaims) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreferredUsername != nil {
+		in, out := &in.PreferredUsername, &out.PreferredUsername
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDClaims.
+func (in *OpenIDClaims) DeepCopy() *OpenIDClaims {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDClaims)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenIDIdentityProvider) DeepCopyInto(out *OpenIDIdentityProvider) {
+	*out = *in
+	out.ClientSecret = in.ClientSecret
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	in.Claims.DeepCopyInto(&out.Claims)
+	if in.ExtraScopes != nil {
+		in, out := &in.ExtraScopes, &out.ExtraScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraAuthorizeParameters != nil {
+		in, out := &in.ExtraAuthorizeParameters, &out.ExtraAuthorizeParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDIdentityProvider.
+func (in *OpenIDIdentityProvider) DeepCopy() *OpenIDIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenIDIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingUpgrade) DeepCopyInto(out *PendingUpgrade) {
+	*out = *in
+	in.ScheduledAt.DeepCopyInto(&out.ScheduledAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingUpgrade.
+func (in *PendingUpgrade) DeepCopy() *PendingUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixedClaimMapping) DeepCopyInto(out *PrefixedClaimMapping) {
 	*out = *in
@@ -365,9 +837,14 @@ func (in *RosaControlPlaneSpec) DeepCopyInto(out *RosaControlPlaneSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RosaRoleConfigRef != nil {
 		in, out := &in.RosaRoleConfigRef, &out.RosaRoleConfigRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	out.RolesRef = in.RolesRef
@@ -378,12 +855,48 @@ func (in *RosaControlPlaneSpec) DeepCopyInto(out *RosaControlPlaneSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BreakGlassCredentials != nil {
+		in, out := &in.BreakGlassCredentials, &out.BreakGlassCredentials
+		*out = make([]BreakGlassCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IdentityProviders != nil {
+		in, out := &in.IdentityProviders, &out.IdentityProviders
+		*out = make([]IdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.DefaultMachinePoolSpec.DeepCopyInto(&out.DefaultMachinePoolSpec)
+	if in.KubeletConfigs != nil {
+		in, out := &in.KubeletConfigs, &out.KubeletConfigs
+		*out = make([]KubeletConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.TuningConfigs != nil {
+		in, out := &in.TuningConfigs, &out.TuningConfigs
+		*out = make([]TuningConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Autoscaler != nil {
+		in, out := &in.Autoscaler, &out.Autoscaler
+		*out = new(ClusterAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
 		*out = new(NetworkSpec)
 		**out = **in
 	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(DefaultIngress)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
 		*out = make(apiv1beta2.Tags, len(*in))
@@ -393,7 +906,12 @@ func (in *RosaControlPlaneSpec) DeepCopyInto(out *RosaControlPlaneSpec) {
 	}
 	if in.CredentialsSecretRef != nil {
 		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.OCMAccountRef != nil {
+		in, out := &in.OCMAccountRef, &out.OCMAccountRef
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.IdentityRef != nil {
@@ -414,7 +932,7 @@ func (in *RosaControlPlaneSpec) DeepCopyInto(out *RosaControlPlaneSpec) {
 	}
 	if in.ROSANetworkRef != nil {
 		in, out := &in.ROSANetworkRef, &out.ROSANetworkRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.CloudWatchLogForwarder != nil {
@@ -469,6 +987,27 @@ func (in *RosaControlPlaneStatus) DeepCopyInto(out *RosaControlPlaneStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PendingUpgrade != nil {
+		in, out := &in.PendingUpgrade, &out.PendingUpgrade
+		*out = new(PendingUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BreakGlassCredentials != nil {
+		in, out := &in.BreakGlassCredentials, &out.BreakGlassCredentials
+		*out = make([]BreakGlassCredentialStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RotatedBreakGlassCredentials != nil {
+		in, out := &in.RotatedBreakGlassCredentials, &out.RotatedBreakGlassCredentials
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BreakGlassCredentialsRevokedAt != nil {
+		in, out := &in.BreakGlassCredentialsRevokedAt, &out.BreakGlassCredentialsRevokedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RosaControlPlaneStatus.
@@ -587,6 +1126,22 @@ func (in *TokenRequiredClaim) DeepCopy() *TokenRequiredClaim {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningConfig) DeepCopyInto(out *TuningConfig) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningConfig.
+func (in *TuningConfig) DeepCopy() *TuningConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UsernameClaimMapping) DeepCopyInto(out *UsernameClaimMapping) {
 	*out = *in
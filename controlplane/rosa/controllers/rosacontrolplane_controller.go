@@ -26,6 +26,7 @@ import (
 	"net"
 	"net/url"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -93,8 +94,14 @@ const (
 	// ROSAControlPlaneCredentialExpiryAnnotation tracks when the break-glass credential in a kubeconfig secret expires.
 	ROSAControlPlaneCredentialExpiryAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-credential-expiry"
 
+	// ROSAControlPlaneCredentialIssuedAnnotation tracks when the break-glass credential in a kubeconfig secret was issued.
+	ROSAControlPlaneCredentialIssuedAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-credential-issued"
+
 	// credentialRefreshThreshold is how long before expiry a break-glass credential should be refreshed.
 	credentialRefreshThreshold = 1 * time.Hour
+
+	// bootstrapCredentialTTL is how long the break-glass credential of the bootstrap kubeconfig is valid for.
+	bootstrapCredentialTTL = 24 * time.Hour
 )
 
 // ROSAControlPlaneReconciler reconciles a ROSAControlPlane object.
@@ -163,6 +170,20 @@ func (r *ROSAControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr c
 		return fmt.Errorf("failed adding a watch for ROSACluster")
 	}
 
+	if err = c.Watch(
+		source.Kind[client.Object](mgr.GetCache(), &corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.identityProviderReferenceToROSAControlPlanes(log))),
+	); err != nil {
+		return fmt.Errorf("failed adding a watch for Secrets: %w", err)
+	}
+
+	if err = c.Watch(
+		source.Kind[client.Object](mgr.GetCache(), &corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.identityProviderReferenceToROSAControlPlanes(log))),
+	); err != nil {
+		return fmt.Errorf("failed adding a watch for ConfigMaps: %w", err)
+	}
+
 	return nil
 }
 
@@ -178,6 +199,8 @@ func (r *ROSAControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr c
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=rosacontrolplanes/finalizers,verbs=update
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosaroleconfigs,verbs=get;list;watch;
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosaroleconfigs/status,verbs=get;
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ocmaccounts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosamachinepools,verbs=get;list;watch
 
 // Reconcile will reconcile RosaControlPlane Resources.
 func (r *ROSAControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, reterr error) {
@@ -340,11 +363,27 @@ func (r *ROSAControlPlaneReconciler) reconcileNormal(ctx context.Context, rosaSc
 				return ctrl.Result{}, err
 			}
 
+			if err := r.reconcileDefaultIngress(ctx, rosaScope, cluster); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to reconcile default ingress: %w", err)
+			}
+
+			if err := r.reconcileClusterAutoscaler(ctx, rosaScope, cluster); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to reconcile cluster autoscaler: %w", err)
+			}
+
+			if err := r.reconcileNodeConfigs(ctx, rosaScope, ocmClient, cluster); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to reconcile kubelet and tuning configs: %w", err)
+			}
+
 			if rosaScope.ControlPlane.Spec.EnableExternalAuthProviders {
 				if err := r.reconcileExternalAuth(ctx, rosaScope, cluster); err != nil {
 					return ctrl.Result{}, fmt.Errorf("failed to reconcile external auth: %w", err)
 				}
 			} else {
+				if err := r.reconcileIdentityProviders(ctx, rosaScope, cluster); err != nil {
+					return ctrl.Result{}, fmt.Errorf("failed to reconcile identity providers: %w", err)
+				}
+
 				// only reconcile a kubeconfig when external auth is not enabled.
 				// The user is expected to provide the kubeconfig for CAPI.
 				if err := r.reconcileKubeconfig(ctx, rosaScope, ocmClient, cluster); err != nil {
@@ -352,7 +391,8 @@ func (r *ROSAControlPlaneReconciler) reconcileNormal(ctx context.Context, rosaSc
 				}
 			}
 
-			return ctrl.Result{}, nil
+			// Requeue when an upgrade is held until a maintenance window, so that it is scheduled once the window opens.
+			return ctrl.Result{RequeueAfter: rosa.UntilMaintenanceWindow(rosaScope.ControlPlane.Status.PendingUpgrade)}, nil
 		case cmv1.ClusterStateError:
 			errorMessage := cluster.Status().ProvisionErrorMessage()
 			rosaScope.ControlPlane.Status.FailureMessage = &errorMessage
@@ -701,6 +741,8 @@ func (r *ROSAControlPlaneReconciler) reconcileClusterVersion(rosaScope *scope.RO
 			}
 		}
 
+		rosaScope.ControlPlane.Status.PendingUpgrade = nil
+
 		// Set the version gate to WaitForAcknowledge as the previous upgrade is applied.
 		if rosaScope.ControlPlane.Spec.VersionGate == rosacontrolplanev1.Acknowledge {
 			rosaScope.ControlPlane.Spec.VersionGate = rosacontrolplanev1.WaitForAcknowledge
@@ -716,8 +758,30 @@ func (r *ROSAControlPlaneReconciler) reconcileClusterVersion(rosaScope *scope.RO
 	}
 
 	if scheduledUpgrade == nil {
+		nextRun := time.Now()
+		if window := rosaScope.ControlPlane.Spec.MaintenanceWindow; window != nil {
+			windowStart, err := rosa.NextMaintenanceWindow(window, nextRun)
+			if err != nil {
+				v1beta1conditions.MarkFalse(rosaScope.ControlPlane, rosacontrolplanev1.ROSAControlPlaneUpgradingCondition, "failed", clusterv1beta1.ConditionSeverityError,
+					"failed to find the next maintenance window: %v", err)
+				return err
+			}
+
+			// Hold the upgrade until the next maintenance window opens.
+			if windowStart.After(nextRun) {
+				rosaScope.ControlPlane.Status.PendingUpgrade = &rosacontrolplanev1.PendingUpgrade{
+					Version:                     version,
+					ScheduledAt:                 metav1.NewTime(windowStart),
+					WaitingForMaintenanceWindow: true,
+				}
+				v1beta1conditions.MarkFalse(rosaScope.ControlPlane, rosacontrolplanev1.ROSAControlPlaneUpgradingCondition, rosacontrolplanev1.WaitingForMaintenanceWindowReason, clusterv1beta1.ConditionSeverityInfo,
+					"Upgrade to version %s is pending until the maintenance window opening at %s", version, windowStart.UTC().Format(time.RFC3339))
+				return nil
+			}
+		}
+
 		ack := (rosaScope.ControlPlane.Spec.VersionGate == rosacontrolplanev1.Acknowledge || rosaScope.ControlPlane.Spec.VersionGate == rosacontrolplanev1.AlwaysAcknowledge)
-		scheduledUpgrade, err = rosa.ScheduleControlPlaneUpgrade(ocmClient, cluster, version, time.Now(), ack)
+		scheduledUpgrade, err = rosa.ScheduleControlPlaneUpgrade(ocmClient, cluster, version, nextRun, ack)
 		if err != nil {
 			condition := &clusterv1beta1.Condition{
 				Type:    rosacontrolplanev1.ROSAControlPlaneUpgradingCondition,
@@ -738,6 +802,10 @@ func (r *ROSAControlPlaneReconciler) reconcileClusterVersion(rosaScope *scope.RO
 		Message: fmt.Sprintf("Upgrading to version %s", scheduledUpgrade.Version()),
 	}
 	v1beta1conditions.Set(rosaScope.ControlPlane, condition)
+	rosaScope.ControlPlane.Status.PendingUpgrade = &rosacontrolplanev1.PendingUpgrade{
+		Version:     scheduledUpgrade.Version(),
+		ScheduledAt: metav1.NewTime(scheduledUpgrade.NextRun()),
+	}
 
 	// if cluster is already upgrading to another version we need to wait until the current upgrade is finished, return an error to requeue and try later.
 	if scheduledUpgrade.Version() != version {
@@ -877,6 +945,10 @@ func (r *ROSAControlPlaneReconciler) reconcileExternalAuth(ctx context.Context,
 		v1beta1conditions.MarkTrue(rosaScope.ControlPlane, rosacontrolplanev1.ExternalAuthConfiguredCondition)
 	}
 
+	if err := r.reconcileBreakGlassCredentials(ctx, externalAuthClient, rosaScope, cluster); err != nil {
+		errs = append(errs, err)
+	}
+
 	if err := r.reconcileExternalAuthBootstrapKubeconfig(ctx, externalAuthClient, rosaScope, cluster); err != nil {
 		errs = append(errs, err)
 	}
@@ -884,6 +956,174 @@ func (r *ROSAControlPlaneReconciler) reconcileExternalAuth(ctx context.Context,
 	return kerrors.NewAggregate(errs)
 }
 
+// reconcileBreakGlassCredentials issues the break glass credentials of the spec, and revokes all the break glass
+// credentials of the cluster when requested.
+func (r *ROSAControlPlaneReconciler) reconcileBreakGlassCredentials(ctx context.Context, externalAuthClient *rosa.ExternalAuthClient, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) error {
+	err := rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())
+	switch {
+	case len(rosaScope.ControlPlane.Spec.BreakGlassCredentials) == 0:
+		v1beta1conditions.Delete(rosaScope.ControlPlane, rosacontrolplanev1.BreakGlassCredentialsConfiguredCondition)
+	case err != nil:
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.BreakGlassCredentialsConfiguredCondition,
+			rosacontrolplanev1.ReconciliationFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+	default:
+		v1beta1conditions.MarkTrue(rosaScope.ControlPlane, rosacontrolplanev1.BreakGlassCredentialsConfiguredCondition)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to reconcile break glass credentials: %w", err)
+	}
+	return nil
+}
+
+func (r *ROSAControlPlaneReconciler) reconcileIdentityProviders(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) error {
+	// Nothing to reconcile when no identity provider is, or was, managed through the spec.
+	if len(rosaScope.ControlPlane.Spec.IdentityProviders) == 0 && rosaScope.ControlPlane.Annotations[rosa.IdentityProvidersLastAppliedAnnotation] == "" {
+		return nil
+	}
+
+	idpClient, err := rosa.NewIdentityProviderClient(ctx, rosaScope)
+	if err != nil {
+		return fmt.Errorf("failed to create identity provider client: %w", err)
+	}
+	defer idpClient.Close()
+
+	unmanaged, err := rosa.ReconcileIdentityProviders(ctx, rosaScope, idpClient, cluster.ID())
+	if err != nil {
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.IdentityProvidersConfiguredCondition,
+			rosacontrolplanev1.ReconciliationFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return err
+	}
+	if len(unmanaged) > 0 {
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.IdentityProvidersConfiguredCondition,
+			rosacontrolplanev1.IdentityProvidersNotManagedReason,
+			clusterv1beta1.ConditionSeverityWarning,
+			"identity providers %s already exist and weren't created from the spec, delete them to let them be managed",
+			strings.Join(unmanaged, ", "))
+		return nil
+	}
+
+	v1beta1conditions.MarkTrue(rosaScope.ControlPlane, rosacontrolplanev1.IdentityProvidersConfiguredCondition)
+	return nil
+}
+
+func (r *ROSAControlPlaneReconciler) reconcileDefaultIngress(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) error {
+	// The default ingress keeps its current settings when spec.ingress is removed.
+	if rosaScope.ControlPlane.Spec.Ingress == nil {
+		v1beta1conditions.Delete(rosaScope.ControlPlane, rosacontrolplanev1.DefaultIngressConfiguredCondition)
+		return nil
+	}
+
+	ingressClient, err := rosa.NewIngressClient(ctx, rosaScope)
+	if err != nil {
+		return fmt.Errorf("failed to create ingress client: %w", err)
+	}
+	defer ingressClient.Close()
+
+	if err := rosa.ReconcileDefaultIngress(rosaScope, ingressClient, cluster.ID()); err != nil {
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.DefaultIngressConfiguredCondition,
+			rosacontrolplanev1.ReconciliationFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return err
+	}
+
+	v1beta1conditions.MarkTrue(rosaScope.ControlPlane, rosacontrolplanev1.DefaultIngressConfiguredCondition)
+	return nil
+}
+
+func (r *ROSAControlPlaneReconciler) reconcileClusterAutoscaler(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) error {
+	// Nothing to reconcile when the cluster autoscaler is not, and was not, managed through the spec.
+	if rosaScope.ControlPlane.Spec.Autoscaler == nil && !v1beta1conditions.Has(rosaScope.ControlPlane, rosacontrolplanev1.ClusterAutoscalerConfiguredCondition) {
+		return nil
+	}
+
+	autoscalerClient, err := rosa.NewClusterAutoscalerClient(ctx, rosaScope)
+	if err != nil {
+		return fmt.Errorf("failed to create cluster autoscaler client: %w", err)
+	}
+	defer autoscalerClient.Close()
+
+	if err := rosa.ReconcileClusterAutoscaler(rosaScope, autoscalerClient, cluster.ID()); err != nil {
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.ClusterAutoscalerConfiguredCondition,
+			rosacontrolplanev1.ReconciliationFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return err
+	}
+
+	if rosaScope.ControlPlane.Spec.Autoscaler == nil {
+		v1beta1conditions.Delete(rosaScope.ControlPlane, rosacontrolplanev1.ClusterAutoscalerConfiguredCondition)
+		return nil
+	}
+	v1beta1conditions.MarkTrue(rosaScope.ControlPlane, rosacontrolplanev1.ClusterAutoscalerConfiguredCondition)
+	return nil
+}
+
+func (r *ROSAControlPlaneReconciler) reconcileNodeConfigs(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ocmClient rosa.OCMClient, cluster *cmv1.Cluster) error {
+	// Nothing to reconcile when no kubelet or tuning config is, or was, managed through the spec.
+	if len(rosaScope.ControlPlane.Spec.KubeletConfigs) == 0 && len(rosaScope.ControlPlane.Spec.TuningConfigs) == 0 &&
+		rosaScope.ControlPlane.Annotations[rosa.KubeletConfigsLastAppliedAnnotation] == "" &&
+		rosaScope.ControlPlane.Annotations[rosa.TuningConfigsLastAppliedAnnotation] == "" {
+		return nil
+	}
+
+	// The node pools, and the machine pools whose node pool may not exist yet, are listed to keep the configs they use.
+	nodePools, err := ocmClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("failed to list node pools: %w", err)
+	}
+	machinePools := &expinfrav1.ROSAMachinePoolList{}
+	if err := r.Client.List(ctx, machinePools,
+		client.InNamespace(rosaScope.Namespace()),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: rosaScope.Cluster.Name},
+	); err != nil {
+		return fmt.Errorf("failed to list ROSAMachinePools: %w", err)
+	}
+
+	nodeConfigClient, err := rosa.NewNodeConfigClient(ctx, rosaScope)
+	if err != nil {
+		return fmt.Errorf("failed to create node config client: %w", err)
+	}
+	defer nodeConfigClient.Close()
+
+	inUse, err := rosa.ReconcileNodeConfigs(rosaScope, nodeConfigClient, cluster.ID(), nodePools, machinePools.Items)
+	if err != nil {
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.NodeConfigsConfiguredCondition,
+			rosacontrolplanev1.ReconciliationFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return err
+	}
+	// The configs removed from the spec which are still in use don't block the rest of the reconciliation.
+	if len(inUse) > 0 {
+		v1beta1conditions.MarkFalse(rosaScope.ControlPlane,
+			rosacontrolplanev1.NodeConfigsConfiguredCondition,
+			rosacontrolplanev1.NodeConfigsInUseReason,
+			clusterv1beta1.ConditionSeverityWarning,
+			"%s",
+			strings.Join(inUse, "; "))
+		return nil
+	}
+
+	v1beta1conditions.MarkTrue(rosaScope.ControlPlane, rosacontrolplanev1.NodeConfigsConfiguredCondition)
+	return nil
+}
+
 func (r *ROSAControlPlaneReconciler) reconcileExternalAuthProviders(ctx context.Context, externalAuthClient *rosa.ExternalAuthClient, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) error {
 	externalAuths, err := externalAuthClient.ListExternalAuths(cluster.ID())
 	if err != nil {
@@ -1029,7 +1269,8 @@ func (r *ROSAControlPlaneReconciler) reconcileExternalAuthProviders(ctx context.
 }
 
 // reconcileExternalAuthBootstrapKubeconfig ensures both the bootstrap kubeconfig (for user RBAC setup)
-// and the CAPI-contract kubeconfig (for RemoteConnectionProbe) exist and are refreshed before expiry.
+// and the CAPI-contract kubeconfig (for RemoteConnectionProbe) exist and are refreshed before expiry,
+// or once their break-glass credential has been revoked.
 func (r *ROSAControlPlaneReconciler) reconcileExternalAuthBootstrapKubeconfig(ctx context.Context, externalAuthClient *rosa.ExternalAuthClient, rosaScope *scope.ROSAControlPlaneScope, cluster *cmv1.Cluster) error {
 	bootstrapSecret := rosaScope.ExternalAuthBootstrapKubeconfigSecret()
 	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(bootstrapSecret), bootstrapSecret); err != nil {
@@ -1045,11 +1286,17 @@ func (r *ROSAControlPlaneReconciler) reconcileExternalAuthBootstrapKubeconfig(ct
 		return fmt.Errorf("failed to get kubeconfig secret: %w", err)
 	}
 
-	if !needsCredentialRefresh(bootstrapSecret) && !needsCredentialRefresh(capiSecret) {
+	revokedAt := rosaScope.ControlPlane.Status.BreakGlassCredentialsRevokedAt
+	if !needsCredentialRefresh(bootstrapSecret, revokedAt) && !needsCredentialRefresh(capiSecret, revokedAt) {
 		return nil
 	}
 
-	expiration := time.Now().Add(time.Hour * 24)
+	if err := externalAuthClient.AwaitBreakGlassCredentialsRevocation(cluster.ID()); err != nil {
+		return err
+	}
+
+	issued := time.Now()
+	expiration := issued.Add(bootstrapCredentialTTL)
 	breakGlassConfig, err := cmv1.NewBreakGlassCredential().
 		Username(names.SimpleNameGenerator.GenerateName("capi-admin-")). // OCM requires unique usernames
 		ExpirationTimestamp(expiration).
@@ -1068,18 +1315,19 @@ func (r *ROSAControlPlaneReconciler) reconcileExternalAuthBootstrapKubeconfig(ct
 		return fmt.Errorf("failed to poll break glass kubeconfig: %v", err)
 	}
 
-	expiryAnnotation := map[string]string{
+	credentialAnnotations := map[string]string{
+		ROSAControlPlaneCredentialIssuedAnnotation: issued.Format(time.RFC3339),
 		ROSAControlPlaneCredentialExpiryAnnotation: expiration.Format(time.RFC3339),
 	}
 
 	bootstrapNewSecret := rosaScope.ExternalAuthBootstrapKubeconfigSecret()
-	if err := r.reconcileKubeconfigSecret(ctx, kubeconfigData, expiryAnnotation, bootstrapSecret, bootstrapNewSecret); err != nil {
+	if err := r.reconcileKubeconfigSecret(ctx, kubeconfigData, credentialAnnotations, bootstrapSecret, bootstrapNewSecret); err != nil {
 		return err
 	}
 
 	controllerOwnerRef := *metav1.NewControllerRef(rosaScope.ControlPlane, rosacontrolplanev1.GroupVersion.WithKind("ROSAControlPlane"))
 	capiNewSecret := kubeconfig.GenerateSecretWithOwner(clusterRef, []byte(kubeconfigData), controllerOwnerRef)
-	return r.reconcileKubeconfigSecret(ctx, kubeconfigData, expiryAnnotation, capiSecret, capiNewSecret)
+	return r.reconcileKubeconfigSecret(ctx, kubeconfigData, credentialAnnotations, capiSecret, capiNewSecret)
 }
 
 func (r *ROSAControlPlaneReconciler) reconcileKubeconfigSecret(ctx context.Context, kubeconfigData string, annotations map[string]string, existing *corev1.Secret, newSecret *corev1.Secret) error {
@@ -1101,8 +1349,9 @@ func (r *ROSAControlPlaneReconciler) reconcileKubeconfigSecret(ctx context.Conte
 }
 
 // needsCredentialRefresh returns true if the break-glass credential in the secret
-// is missing, has no expiry annotation, or is within the refresh threshold of expiring.
-func needsCredentialRefresh(s *corev1.Secret) bool {
+// is missing, has no expiry annotation, is within the refresh threshold of expiring,
+// or wasn't issued after all the break-glass credentials were revoked at revokedAt.
+func needsCredentialRefresh(s *corev1.Secret, revokedAt *metav1.Time) bool {
 	if s == nil {
 		return true
 	}
@@ -1117,6 +1366,13 @@ func needsCredentialRefresh(s *corev1.Secret) bool {
 		return true
 	}
 
+	if revokedAt != nil {
+		issued, err := time.Parse(time.RFC3339, s.Annotations[ROSAControlPlaneCredentialIssuedAnnotation])
+		if err != nil || issued.Before(revokedAt.Time) {
+			return true
+		}
+	}
+
 	return time.Until(expiry) < credentialRefreshThreshold
 }
 
@@ -1291,7 +1547,6 @@ func buildOCMClusterSpec(controlPlaneSpec rosacontrolplanev1.RosaControlPlaneSpe
 		MultiAZ:                   true,
 		Version:                   rosa.CreateVersionID(controlPlaneSpec.Version, string(controlPlaneSpec.ChannelGroup), controlPlaneSpec.Channel),
 		DisableWorkloadMonitoring: ptr.To(true),
-		DefaultIngress:            ocm.NewDefaultIngressSpec(), // n.b. this is a no-op when it's set to the default value
 		ComputeMachineType:        controlPlaneSpec.DefaultMachinePoolSpec.InstanceType,
 		AvailabilityZones:         availabilityZones,
 		Tags:                      controlPlaneSpec.AdditionalTags,
@@ -1322,6 +1577,9 @@ func buildOCMClusterSpec(controlPlaneSpec rosacontrolplanev1.RosaControlPlaneSpe
 		ocmClusterSpec.PrivateLink = ptr.To(true)
 	}
 
+	// n.b. the default ingress spec is a no-op when it's set to the default value.
+	ocmClusterSpec.DefaultIngress, ocmClusterSpec.PrivateIngress = rosa.BuildDefaultIngressSpec(controlPlaneSpec.Ingress)
+
 	if networkSpec := controlPlaneSpec.Network; networkSpec != nil {
 		if networkSpec.MachineCIDR != "" {
 			_, machineCIDR, err := net.ParseCIDR(networkSpec.MachineCIDR)
@@ -1572,6 +1830,33 @@ func (r *ROSAControlPlaneReconciler) newAWSClient(rosaScope *scope.ROSAControlPl
 		Build()
 }
 
+// identityProviderReferenceToROSAControlPlanes maps a secret or a config map to the ROSAControlPlanes of its namespace
+// with an identity provider referencing it, so that the identity providers are updated when it changes.
+func (r *ROSAControlPlaneReconciler) identityProviderReferenceToROSAControlPlanes(log *logger.Logger) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []ctrl.Request {
+		_, isConfigMap := o.(*corev1.ConfigMap)
+
+		controlPlanes := &rosacontrolplanev1.ROSAControlPlaneList{}
+		if err := r.Client.List(ctx, controlPlanes, client.InNamespace(o.GetNamespace())); err != nil {
+			log.Error(err, "failed to list ROSAControlPlanes")
+			return nil
+		}
+
+		var requests []ctrl.Request
+		for _, controlPlane := range controlPlanes.Items {
+			secrets, configMaps := rosa.IdentityProviderReferences(controlPlane.Spec.IdentityProviders)
+			references := secrets
+			if isConfigMap {
+				references = configMaps
+			}
+			if slices.Contains(references, o.GetName()) {
+				requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&controlPlane)})
+			}
+		}
+		return requests
+	}
+}
+
 func (r *ROSAControlPlaneReconciler) rosaClusterToROSAControlPlane(log *logger.Logger) handler.MapFunc {
 	return func(ctx context.Context, o client.Object) []ctrl.Request {
 		rosaCluster, ok := o.(*expinfrav1.ROSACluster)
@@ -41,10 +41,12 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	restclient "k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
@@ -1903,9 +1905,10 @@ func TestReconcileExternalAuthKubeconfigSecrets(t *testing.T) {
 
 func TestNeedsCredentialRefresh(t *testing.T) {
 	tests := []struct {
-		name   string
-		secret *corev1.Secret
-		want   bool
+		name      string
+		secret    *corev1.Secret
+		revokedAt *metav1.Time
+		want      bool
 	}{
 		{
 			name:   "nil secret needs refresh",
@@ -1957,12 +1960,67 @@ func TestNeedsCredentialRefresh(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "credential issued before revocation needs refresh",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-kubeconfig",
+					Annotations: map[string]string{
+						ROSAControlPlaneCredentialIssuedAnnotation: time.Now().Add(-2 * time.Hour).Format(time.RFC3339),
+						ROSAControlPlaneCredentialExpiryAnnotation: time.Now().Add(12 * time.Hour).Format(time.RFC3339),
+					},
+				},
+			},
+			revokedAt: &metav1.Time{Time: time.Now().Add(-1 * time.Hour)},
+			want:      true,
+		},
+		{
+			name: "credential issued after revocation does not need refresh",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-kubeconfig",
+					Annotations: map[string]string{
+						ROSAControlPlaneCredentialIssuedAnnotation: time.Now().Add(-12 * time.Hour).Format(time.RFC3339),
+						ROSAControlPlaneCredentialExpiryAnnotation: time.Now().Add(12 * time.Hour).Format(time.RFC3339),
+					},
+				},
+			},
+			revokedAt: &metav1.Time{Time: time.Now().Add(-13 * time.Hour)},
+			want:      false,
+		},
+		{
+			name: "credential with a TTL shorter than the bootstrap TTL issued after revocation does not need refresh",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-kubeconfig",
+					Annotations: map[string]string{
+						ROSAControlPlaneCredentialIssuedAnnotation: time.Now().Add(-30 * time.Minute).Format(time.RFC3339),
+						ROSAControlPlaneCredentialExpiryAnnotation: time.Now().Add(2 * time.Hour).Format(time.RFC3339),
+					},
+				},
+			},
+			revokedAt: &metav1.Time{Time: time.Now().Add(-1 * time.Hour)},
+			want:      false,
+		},
+		{
+			name: "credential without issue time needs refresh after revocation",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-kubeconfig",
+					Annotations: map[string]string{
+						ROSAControlPlaneCredentialExpiryAnnotation: time.Now().Add(12 * time.Hour).Format(time.RFC3339),
+					},
+				},
+			},
+			revokedAt: &metav1.Time{Time: time.Now().Add(-13 * time.Hour)},
+			want:      true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			g.Expect(needsCredentialRefresh(tt.secret)).To(Equal(tt.want))
+			g.Expect(needsCredentialRefresh(tt.secret, tt.revokedAt)).To(Equal(tt.want))
 		})
 	}
 }
@@ -2071,3 +2129,229 @@ func TestROSAControlPlaneUpdatePredicate(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentityProviderReferenceToROSAControlPlanes(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(rosacontrolplanev1.AddToScheme(scheme)).To(Succeed())
+
+	controlPlane := func(name, namespace string, idps ...rosacontrolplanev1.IdentityProvider) *rosacontrolplanev1.ROSAControlPlane {
+		return &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       rosacontrolplanev1.RosaControlPlaneSpec{IdentityProviders: idps},
+		}
+	}
+	github := rosacontrolplanev1.IdentityProvider{
+		Name: "github",
+		Type: rosacontrolplanev1.GitHubIdentityProviderType,
+		GitHub: &rosacontrolplanev1.GitHubIdentityProvider{
+			ClientSecret:         rosacontrolplanev1.LocalObjectReference{Name: "github-client-secret"},
+			CertificateAuthority: &rosacontrolplanev1.LocalObjectReference{Name: "github-ca"},
+		},
+	}
+	ldap := rosacontrolplanev1.IdentityProvider{
+		Name: "ldap",
+		Type: rosacontrolplanev1.LDAPIdentityProviderType,
+		LDAP: &rosacontrolplanev1.LDAPIdentityProvider{
+			BindPassword: &rosacontrolplanev1.LocalObjectReference{Name: "ldap-bind-password"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		controlPlane("with-github", "default", github),
+		controlPlane("with-ldap", "default", ldap),
+		controlPlane("without-idps", "default"),
+		controlPlane("other-namespace", "other", github),
+	).Build()
+	r := &ROSAControlPlaneReconciler{Client: fakeClient}
+	mapFunc := r.identityProviderReferenceToROSAControlPlanes(logger.FromContext(context.TODO()))
+
+	testCases := []struct {
+		name     string
+		object   client.Object
+		expected []ctrl.Request
+	}{
+		{
+			name:   "secret referenced by an identity provider",
+			object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "github-client-secret", Namespace: "default"}},
+			expected: []ctrl.Request{
+				{NamespacedName: types.NamespacedName{Name: "with-github", Namespace: "default"}},
+			},
+		},
+		{
+			name:   "config map referenced by an identity provider",
+			object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "github-ca", Namespace: "default"}},
+			expected: []ctrl.Request{
+				{NamespacedName: types.NamespacedName{Name: "with-github", Namespace: "default"}},
+			},
+		},
+		{
+			name:   "optional secret referenced by an identity provider",
+			object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ldap-bind-password", Namespace: "default"}},
+			expected: []ctrl.Request{
+				{NamespacedName: types.NamespacedName{Name: "with-ldap", Namespace: "default"}},
+			},
+		},
+		{
+			name:   "config map with the name of a referenced secret",
+			object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "github-client-secret", Namespace: "default"}},
+		},
+		{
+			name:   "secret which isn't referenced",
+			object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(mapFunc(context.TODO(), tc.object)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestRosaControlPlaneReconcileCreatesCluster(t *testing.T) {
+	g := NewWithT(t)
+	ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("test-namespace-create-%s", generateTestID()))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	secret := ocmServer.CredentialsSecret("rosa-creds-secret", ns.Name)
+
+	identity := &infrav1.AWSClusterControllerIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+		Spec: infrav1.AWSClusterControllerIdentitySpec{
+			AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+				AllowedNamespaces: &infrav1.AllowedNamespaces{},
+			},
+		},
+	}
+	identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterControllerIdentity"))
+
+	rosaControlPlane := &rosacontrolplanev1.ROSAControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rosa-control-plane-create",
+			Namespace: ns.Name,
+		},
+		Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+			RosaClusterName:   "rosa-control-plane-create",
+			Subnets:           []string{"subnet-0ac99a6230b408813", "subnet-1ac99a6230b408811"},
+			AvailabilityZones: []string{"az-1", "az-2"},
+			Network: &rosacontrolplanev1.NetworkSpec{
+				MachineCIDR: "10.0.0.0/16",
+				PodCIDR:     "10.128.0.0/14",
+				ServiceCIDR: "172.30.0.0/16",
+			},
+			Region:       "us-east-1",
+			Version:      "4.17.0",
+			ChannelGroup: "stable",
+			RolesRef: rosacontrolplanev1.AWSRolesRef{
+				IngressARN:              "op-arn1",
+				ImageRegistryARN:        "op-arn2",
+				StorageARN:              "op-arn3",
+				NetworkARN:              "op-arn4",
+				KubeCloudControllerARN:  "op-arn5",
+				NodePoolManagementARN:   "op-arn6",
+				ControlPlaneOperatorARN: "op-arn7",
+				KMSProviderARN:          "op-arn8",
+			},
+			OIDCID:           "iodcid1",
+			InstallerRoleARN: "arn:aws:iam::123456789012:role/installer",
+			WorkerRoleARN:    "arn:aws:iam::123456789012:role/worker",
+			SupportRoleARN:   "arn:aws:iam::123456789012:role/support",
+			CredentialsSecretRef: &corev1.LocalObjectReference{
+				Name: secret.Name,
+			},
+			IdentityRef: &infrav1.AWSIdentityReference{
+				Name: identity.Name,
+				Kind: infrav1.ControllerIdentityKind,
+			},
+		},
+	}
+
+	ownerCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner-cluster-create",
+			Namespace: ns.Name,
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+				Name:     rosaControlPlane.Name,
+				Kind:     "ROSAControlPlane",
+				APIGroup: rosacontrolplanev1.GroupVersion.Group,
+			},
+		},
+	}
+
+	objects := []client.Object{ownerCluster, secret, identity}
+	for _, obj := range objects {
+		createObject(g, obj, ns.Name)
+	}
+	rosaControlPlane.OwnerReferences = []metav1.OwnerReference{
+		{
+			Name:       ownerCluster.Name,
+			UID:        ownerCluster.UID,
+			Kind:       "Cluster",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+	}
+	createObject(g, rosaControlPlane, ns.Name)
+	objects = append(objects, rosaControlPlane)
+	defer func() {
+		for _, obj := range objects {
+			cleanupObject(g, obj)
+		}
+	}()
+
+	// Add the paused condition, so that the reconciler doesn't return early to set it.
+	cpPh, err := patch.NewHelper(rosaControlPlane, testEnv)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	v1beta1conditions.MarkFalse(rosaControlPlane, clusterv1beta1.PausedV1Beta2Condition, clusterv1beta1.NotPausedV1Beta2Reason, "", "")
+	g.Expect(cpPh.Patch(ctx, rosaControlPlane)).To(Succeed())
+
+	r := ROSAControlPlaneReconciler{
+		Client: testEnv,
+		awsClientFactory: newFakeAWSClientFactory(&fakeStsAPIClient{
+			account: "123456789012",
+			arn:     "arn:aws:iam::123456789012:user/test",
+			userID:  "user-id",
+		}),
+		NewOCMClient: ocmServer.NewOCMClient,
+	}
+
+	req := ctrl.Request{}
+	req.NamespacedName = types.NamespacedName{Name: rosaControlPlane.Name, Namespace: rosaControlPlane.Namespace}
+	key := client.ObjectKey{Name: rosaControlPlane.Name, Namespace: rosaControlPlane.Namespace}
+	cp := &rosacontrolplanev1.ROSAControlPlane{}
+
+	// The first reconciliation creates the cluster in OCM.
+	g.Eventually(func(g Gomega) {
+		g.Expect(testEnv.Get(ctx, key, cp)).To(Succeed())
+		g.Expect(v1beta1conditions.Has(cp, clusterv1beta1.PausedV1Beta2Condition)).To(BeTrue())
+	}).WithTimeout(10 * time.Second).Should(Succeed())
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Eventually(func(g Gomega) {
+		g.Expect(testEnv.Get(ctx, key, cp)).To(Succeed())
+		g.Expect(cp.Status.ID).ToNot(BeEmpty())
+	}).WithTimeout(10 * time.Second).Should(Succeed())
+	cluster := ocmServer.Cluster(cp.Status.ID)
+	g.Expect(cluster).ToNot(BeNil())
+	g.Expect(cluster.Name()).To(Equal(rosaControlPlane.Spec.RosaClusterName))
+	g.Expect(cluster.Version().RawID()).To(Equal(rosaControlPlane.Spec.Version))
+
+	// The cluster is pending until the fake OCM API is stepped, so the control plane isn't ready yet.
+	result, err := r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+	g.Eventually(func(g Gomega) {
+		g.Expect(testEnv.Get(ctx, key, cp)).To(Succeed())
+		g.Expect(cp.Status.Version).To(Equal(rosaControlPlane.Spec.Version))
+		g.Expect(cp.Status.Ready).To(BeFalse())
+		g.Expect(v1beta1conditions.IsFalse(cp, rosacontrolplanev1.ROSAControlPlaneReadyCondition)).To(BeTrue())
+	}).WithTimeout(10 * time.Second).Should(Succeed())
+}
@@ -18,19 +18,25 @@ package webhooks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/blang/semver"
 	kmsArnRegexpValidator "github.com/openshift-online/ocm-common/pkg/resource/validations"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
 )
 
 // ROSAControlPlane implements a custom validation webhook for ROSAControlPlane.
@@ -85,6 +91,12 @@ func (w *ROSAControlPlane) ValidateCreate(_ context.Context, obj runtime.Object)
 
 	allErrs = append(allErrs, w.validateROSANetwork(r)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, rosa.ValidateMaintenanceWindow(r.Spec.MaintenanceWindow, field.NewPath("spec", "maintenanceWindow"))...)
+	allErrs = append(allErrs, w.validateIdentityProviders(r)...)
+	allErrs = append(allErrs, w.validateAutoscaler(r)...)
+	allErrs = append(allErrs, w.validateBreakGlassCredentials(r)...)
+	allErrs = append(allErrs, w.validateTuningConfigs(r)...)
+	allErrs = append(allErrs, w.validateIngress(r)...)
 
 	if err := w.validateROSANetworkRef(r); err != nil {
 		allErrs = append(allErrs, err)
@@ -140,6 +152,12 @@ func (w *ROSAControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj runt
 
 	allErrs = append(allErrs, w.validateROSANetwork(r)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
+	allErrs = append(allErrs, rosa.ValidateMaintenanceWindow(r.Spec.MaintenanceWindow, field.NewPath("spec", "maintenanceWindow"))...)
+	allErrs = append(allErrs, w.validateIdentityProviders(r)...)
+	allErrs = append(allErrs, w.validateAutoscaler(r)...)
+	allErrs = append(allErrs, w.validateBreakGlassCredentials(r)...)
+	allErrs = append(allErrs, w.validateTuningConfigs(r)...)
+	allErrs = append(allErrs, w.validateIngress(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -225,6 +243,162 @@ func (w *ROSAControlPlane) validateExternalAuthProviders(r *rosacontrolplanev1.R
 	return nil
 }
 
+func (w *ROSAControlPlane) validateAutoscaler(r *rosacontrolplanev1.ROSAControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+	autoscaler := r.Spec.Autoscaler
+	if autoscaler == nil {
+		return allErrs
+	}
+	rootPath := field.NewPath("spec", "autoscaler")
+
+	validateDuration := func(fldPath *field.Path, duration *metav1.Duration, allowZero bool) {
+		switch {
+		case duration == nil:
+		case duration.Duration < 0:
+			allErrs = append(allErrs, field.Invalid(fldPath, duration.String(), "must not be negative"))
+		case duration.Duration == 0 && !allowZero:
+			allErrs = append(allErrs, field.Invalid(fldPath, duration.String(), "must be greater than zero"))
+		}
+	}
+
+	validateDuration(rootPath.Child("maxNodeProvisionTime"), autoscaler.MaxNodeProvisionTime, false)
+	if scaleDown := autoscaler.ScaleDown; scaleDown != nil {
+		scaleDownPath := rootPath.Child("scaleDown")
+		validateDuration(scaleDownPath.Child("unneededTime"), scaleDown.UnneededTime, false)
+		validateDuration(scaleDownPath.Child("delayAfterAdd"), scaleDown.DelayAfterAdd, true)
+		validateDuration(scaleDownPath.Child("delayAfterDelete"), scaleDown.DelayAfterDelete, true)
+		validateDuration(scaleDownPath.Child("delayAfterFailure"), scaleDown.DelayAfterFailure, true)
+	}
+
+	return allErrs
+}
+
+func (w *ROSAControlPlane) validateBreakGlassCredentials(r *rosacontrolplanev1.ROSAControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+	rootPath := field.NewPath("spec", "breakGlassCredentials")
+
+	if !r.Spec.EnableExternalAuthProviders && len(r.Spec.BreakGlassCredentials) > 0 {
+		allErrs = append(allErrs, field.Forbidden(rootPath, "can only be set if spec.enableExternalAuthProviders is set to 'True'"))
+	}
+
+	for i, credential := range r.Spec.BreakGlassCredentials {
+		if credential.TTL == nil {
+			continue
+		}
+		if credential.TTL.Duration < rosa.BreakGlassCredentialMinTTL || credential.TTL.Duration > rosa.BreakGlassCredentialMaxTTL {
+			allErrs = append(allErrs, field.Invalid(rootPath.Index(i).Child("ttl"), credential.TTL.String(),
+				fmt.Sprintf("must be between %s and %s", rosa.BreakGlassCredentialMinTTL, rosa.BreakGlassCredentialMaxTTL)))
+		}
+	}
+
+	return allErrs
+}
+
+func (w *ROSAControlPlane) validateIngress(r *rosacontrolplanev1.ROSAControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+	ingress := r.Spec.Ingress
+	if ingress == nil {
+		return allErrs
+	}
+	rootPath := field.NewPath("spec", "ingress")
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(ingress.RouteSelectors, rootPath.Child("routeSelectors"))...)
+	for i, namespace := range ingress.ExcludedNamespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(rootPath.Child("excludedNamespaces").Index(i), namespace, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func (w *ROSAControlPlane) validateTuningConfigs(r *rosacontrolplanev1.ROSAControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+	rootPath := field.NewPath("spec", "tuningConfigs")
+
+	for i, tuningConfig := range r.Spec.TuningConfigs {
+		spec := map[string]interface{}{}
+		if err := json.Unmarshal(tuningConfig.Spec.Raw, &spec); err != nil {
+			allErrs = append(allErrs, field.Invalid(rootPath.Index(i).Child("spec"), string(tuningConfig.Spec.Raw), "must be an object"))
+			continue
+		}
+		if len(spec) == 0 {
+			allErrs = append(allErrs, field.Required(rootPath.Index(i).Child("spec"), "must define the profiles and recommendations of the tuning config"))
+		}
+	}
+
+	return allErrs
+}
+
+func (w *ROSAControlPlane) validateIdentityProviders(r *rosacontrolplanev1.ROSAControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+	rootPath := field.NewPath("spec", "identityProviders")
+
+	if r.Spec.EnableExternalAuthProviders && len(r.Spec.IdentityProviders) > 0 {
+		allErrs = append(allErrs, field.Forbidden(rootPath, "can only be set if spec.enableExternalAuthProviders is set to 'False'"))
+	}
+
+	for i, idp := range r.Spec.IdentityProviders {
+		idpPath := rootPath.Index(i)
+		if idp.Name == rosa.ClusterAdminIdentityProviderName {
+			allErrs = append(allErrs, field.Invalid(idpPath.Child("name"), idp.Name, "is reserved for the cluster-admin identity provider"))
+		}
+
+		configs := []struct {
+			idpType rosacontrolplanev1.IdentityProviderType
+			field   string
+			set     bool
+		}{
+			{rosacontrolplanev1.GitHubIdentityProviderType, "github", idp.GitHub != nil},
+			{rosacontrolplanev1.GitLabIdentityProviderType, "gitlab", idp.GitLab != nil},
+			{rosacontrolplanev1.GoogleIdentityProviderType, "google", idp.Google != nil},
+			{rosacontrolplanev1.LDAPIdentityProviderType, "ldap", idp.LDAP != nil},
+			{rosacontrolplanev1.OpenIDIdentityProviderType, "openID", idp.OpenID != nil},
+		}
+		for _, config := range configs {
+			switch {
+			case config.idpType == idp.Type && !config.set:
+				allErrs = append(allErrs, field.Required(idpPath.Child(config.field), fmt.Sprintf("is required for type %s", idp.Type)))
+			case config.idpType != idp.Type && config.set:
+				allErrs = append(allErrs, field.Forbidden(idpPath.Child(config.field), fmt.Sprintf("can't be set for type %s", idp.Type)))
+			}
+		}
+
+		if idp.GitHub != nil {
+			if len(idp.GitHub.Organizations) > 0 && len(idp.GitHub.Teams) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idpPath.Child("github", "teams"), "organizations and teams are mutually exclusive"))
+			}
+			for j, team := range idp.GitHub.Teams {
+				if org, name, ok := strings.Cut(team, "/"); !ok || org == "" || name == "" {
+					allErrs = append(allErrs, field.Invalid(idpPath.Child("github", "teams").Index(j), team, "must be in the org/team format"))
+				}
+			}
+		}
+
+		if idp.Google != nil && idp.Google.HostedDomain == "" && idp.MappingMethod != rosacontrolplanev1.IdentityProviderMappingMethodLookup {
+			allErrs = append(allErrs, field.Required(idpPath.Child("google", "hostedDomain"), "is required unless mappingMethod is lookup"))
+		}
+
+		if idp.LDAP != nil {
+			if (idp.LDAP.BindDN == "") != (idp.LDAP.BindPassword == nil) {
+				allErrs = append(allErrs, field.Invalid(idpPath.Child("ldap", "bindPassword"), idp.LDAP.BindPassword, "bindDN and bindPassword must be set together"))
+			}
+			if idp.LDAP.Insecure && strings.HasPrefix(idp.LDAP.URL, "ldaps://") {
+				allErrs = append(allErrs, field.Invalid(idpPath.Child("ldap", "insecure"), idp.LDAP.Insecure, "can't be set with an ldaps:// URL"))
+			}
+		}
+
+		if idp.OpenID != nil {
+			claims := idp.OpenID.Claims
+			if len(claims.Email) == 0 && len(claims.Name) == 0 && len(claims.PreferredUsername) == 0 {
+				allErrs = append(allErrs, field.Required(idpPath.Child("openID", "claims"), "at least one of email, name or preferredUsername claims is required"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func (w *ROSAControlPlane) validateRosaRoleConfig(r *rosacontrolplanev1.ROSAControlPlane) *field.Error {
 	hasRoleFields := r.Spec.OIDCID != "" || r.Spec.InstallerRoleARN != "" || r.Spec.SupportRoleARN != "" || r.Spec.WorkerRoleARN != "" ||
 		r.Spec.RolesRef.IngressARN != "" || r.Spec.RolesRef.ImageRegistryARN != "" || r.Spec.RolesRef.StorageARN != "" ||
@@ -18,9 +18,12 @@ package webhooks
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
 )
@@ -83,3 +86,368 @@ func TestValidateROSANetwork(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 	})
 }
+
+func TestValidateIdentityProviders(t *testing.T) {
+	clientSecret := rosacontrolplanev1.LocalObjectReference{Name: "client-secret"}
+
+	tests := []struct {
+		name                string
+		enableExternalAuth  bool
+		identityProviders   []rosacontrolplanev1.IdentityProvider
+		expectedErrorFields []string
+	}{
+		{
+			name: "valid identity providers",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name: "github",
+					Type: rosacontrolplanev1.GitHubIdentityProviderType,
+					GitHub: &rosacontrolplanev1.GitHubIdentityProvider{
+						ClientID:     "client-id",
+						ClientSecret: clientSecret,
+						Teams:        []string{"example/admins"},
+					},
+				},
+				{
+					Name: "ldap",
+					Type: rosacontrolplanev1.LDAPIdentityProviderType,
+					LDAP: &rosacontrolplanev1.LDAPIdentityProvider{
+						URL:          "ldaps://ldap.example.com/ou=users,dc=example,dc=com?uid",
+						BindDN:       "cn=admin,dc=example,dc=com",
+						BindPassword: &rosacontrolplanev1.LocalObjectReference{Name: "bind-password"},
+					},
+				},
+				{
+					Name: "openid",
+					Type: rosacontrolplanev1.OpenIDIdentityProviderType,
+					OpenID: &rosacontrolplanev1.OpenIDIdentityProvider{
+						Issuer:       "https://issuer.example.com",
+						ClientID:     "client-id",
+						ClientSecret: clientSecret,
+						Claims: rosacontrolplanev1.OpenIDClaims{
+							PreferredUsername: []string{"preferred_username"},
+							Groups:            []string{"groups"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:               "identity providers with external auth",
+			enableExternalAuth: true,
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name:   "google",
+					Type:   rosacontrolplanev1.GoogleIdentityProviderType,
+					Google: &rosacontrolplanev1.GoogleIdentityProvider{ClientID: "client-id", ClientSecret: clientSecret, HostedDomain: "example.com"},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders"},
+		},
+		{
+			name: "reserved name",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name:   "cluster-admin",
+					Type:   rosacontrolplanev1.GitLabIdentityProviderType,
+					GitLab: &rosacontrolplanev1.GitLabIdentityProvider{URL: "https://gitlab.com", ClientID: "client-id", ClientSecret: clientSecret},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders[0].name"},
+		},
+		{
+			name: "configuration not matching the type",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name:   "gitlab",
+					Type:   rosacontrolplanev1.GitLabIdentityProviderType,
+					Google: &rosacontrolplanev1.GoogleIdentityProvider{ClientID: "client-id", ClientSecret: clientSecret, HostedDomain: "example.com"},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders[0].gitlab", "spec.identityProviders[0].google"},
+		},
+		{
+			name: "invalid github restrictions",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name: "github",
+					Type: rosacontrolplanev1.GitHubIdentityProviderType,
+					GitHub: &rosacontrolplanev1.GitHubIdentityProvider{
+						ClientID:      "client-id",
+						ClientSecret:  clientSecret,
+						Organizations: []string{"example"},
+						Teams:         []string{"admins"},
+					},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders[0].github.teams", "spec.identityProviders[0].github.teams[0]"},
+		},
+		{
+			name: "google without hosted domain",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name:   "google",
+					Type:   rosacontrolplanev1.GoogleIdentityProviderType,
+					Google: &rosacontrolplanev1.GoogleIdentityProvider{ClientID: "client-id", ClientSecret: clientSecret},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders[0].google.hostedDomain"},
+		},
+		{
+			name: "invalid ldap bind and insecure settings",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name: "ldap",
+					Type: rosacontrolplanev1.LDAPIdentityProviderType,
+					LDAP: &rosacontrolplanev1.LDAPIdentityProvider{
+						URL:      "ldaps://ldap.example.com/ou=users,dc=example,dc=com?uid",
+						BindDN:   "cn=admin,dc=example,dc=com",
+						Insecure: true,
+					},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders[0].ldap.bindPassword", "spec.identityProviders[0].ldap.insecure"},
+		},
+		{
+			name: "openid without identity claims",
+			identityProviders: []rosacontrolplanev1.IdentityProvider{
+				{
+					Name: "openid",
+					Type: rosacontrolplanev1.OpenIDIdentityProviderType,
+					OpenID: &rosacontrolplanev1.OpenIDIdentityProvider{
+						Issuer:       "https://issuer.example.com",
+						ClientID:     "client-id",
+						ClientSecret: clientSecret,
+						Claims:       rosacontrolplanev1.OpenIDClaims{Groups: []string{"groups"}},
+					},
+				},
+			},
+			expectedErrorFields: []string{"spec.identityProviders[0].openID.claims"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rosaCP := &rosacontrolplanev1.ROSAControlPlane{
+				Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+					EnableExternalAuthProviders: tt.enableExternalAuth,
+					IdentityProviders:           tt.identityProviders,
+				},
+			}
+
+			errs := (&ROSAControlPlane{}).validateIdentityProviders(rosaCP)
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tt.expectedErrorFields))
+		})
+	}
+}
+
+func TestValidateAutoscaler(t *testing.T) {
+	tests := []struct {
+		name                string
+		autoscaler          *rosacontrolplanev1.ClusterAutoscaler
+		expectedErrorFields []string
+	}{
+		{
+			name: "no autoscaler",
+		},
+		{
+			name: "valid autoscaler",
+			autoscaler: &rosacontrolplanev1.ClusterAutoscaler{
+				MaxNodeProvisionTime: &metav1.Duration{Duration: 15 * time.Minute},
+				ScaleDown: &rosacontrolplanev1.AutoscalerScaleDown{
+					UnneededTime:  &metav1.Duration{Duration: 10 * time.Minute},
+					DelayAfterAdd: &metav1.Duration{},
+				},
+			},
+		},
+		{
+			name: "invalid durations",
+			autoscaler: &rosacontrolplanev1.ClusterAutoscaler{
+				MaxNodeProvisionTime: &metav1.Duration{},
+				ScaleDown: &rosacontrolplanev1.AutoscalerScaleDown{
+					UnneededTime:      &metav1.Duration{Duration: 10 * time.Minute},
+					DelayAfterFailure: &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			expectedErrorFields: []string{"spec.autoscaler.maxNodeProvisionTime", "spec.autoscaler.scaleDown.delayAfterFailure"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rosaCP := &rosacontrolplanev1.ROSAControlPlane{
+				Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+					Autoscaler: tt.autoscaler,
+				},
+			}
+
+			errs := (&ROSAControlPlane{}).validateAutoscaler(rosaCP)
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tt.expectedErrorFields))
+		})
+	}
+}
+
+func TestValidateTuningConfigs(t *testing.T) {
+	tests := []struct {
+		name                string
+		tuningConfigs       []rosacontrolplanev1.TuningConfig
+		expectedErrorFields []string
+	}{
+		{
+			name: "no tuning configs",
+		},
+		{
+			name: "valid tuning config",
+			tuningConfigs: []rosacontrolplanev1.TuningConfig{
+				{
+					Name: "sysctl",
+					Spec: runtime.RawExtension{Raw: []byte(`{"profile":[{"name":"sysctl","data":"[main]\n[sysctl]\nvm.dirty_ratio=\"55\"\n"}],"recommend":[{"priority":10,"profile":"sysctl"}]}`)},
+				},
+			},
+		},
+		{
+			name: "invalid tuning config specs",
+			tuningConfigs: []rosacontrolplanev1.TuningConfig{
+				{Name: "empty", Spec: runtime.RawExtension{Raw: []byte(`{}`)}},
+				{Name: "list", Spec: runtime.RawExtension{Raw: []byte(`["profile"]`)}},
+			},
+			expectedErrorFields: []string{"spec.tuningConfigs[0].spec", "spec.tuningConfigs[1].spec"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rosaCP := &rosacontrolplanev1.ROSAControlPlane{
+				Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+					TuningConfigs: tt.tuningConfigs,
+				},
+			}
+
+			errs := (&ROSAControlPlane{}).validateTuningConfigs(rosaCP)
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tt.expectedErrorFields))
+		})
+	}
+}
+
+func TestValidateBreakGlassCredentials(t *testing.T) {
+	tests := []struct {
+		name                        string
+		enableExternalAuthProviders bool
+		breakGlassCredentials       []rosacontrolplanev1.BreakGlassCredential
+		expectedErrorFields         []string
+	}{
+		{
+			name: "no break glass credentials",
+		},
+		{
+			name:                        "valid break glass credentials",
+			enableExternalAuthProviders: true,
+			breakGlassCredentials: []rosacontrolplanev1.BreakGlassCredential{
+				{Name: "oncall"},
+				{Name: "incident", TTL: &metav1.Duration{Duration: 2 * time.Hour}},
+			},
+		},
+		{
+			name: "break glass credentials without external auth providers",
+			breakGlassCredentials: []rosacontrolplanev1.BreakGlassCredential{
+				{Name: "oncall"},
+			},
+			expectedErrorFields: []string{"spec.breakGlassCredentials"},
+		},
+		{
+			name:                        "invalid TTLs",
+			enableExternalAuthProviders: true,
+			breakGlassCredentials: []rosacontrolplanev1.BreakGlassCredential{
+				{Name: "short", TTL: &metav1.Duration{Duration: 5 * time.Minute}},
+				{Name: "long", TTL: &metav1.Duration{Duration: 48 * time.Hour}},
+			},
+			expectedErrorFields: []string{"spec.breakGlassCredentials[0].ttl", "spec.breakGlassCredentials[1].ttl"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rosaCP := &rosacontrolplanev1.ROSAControlPlane{
+				Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+					EnableExternalAuthProviders: tt.enableExternalAuthProviders,
+					BreakGlassCredentials:       tt.breakGlassCredentials,
+				},
+			}
+
+			errs := (&ROSAControlPlane{}).validateBreakGlassCredentials(rosaCP)
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tt.expectedErrorFields))
+		})
+	}
+}
+
+func TestValidateIngress(t *testing.T) {
+	tests := []struct {
+		name                string
+		ingress             *rosacontrolplanev1.DefaultIngress
+		expectedErrorFields []string
+	}{
+		{
+			name: "no ingress",
+		},
+		{
+			name: "valid ingress",
+			ingress: &rosacontrolplanev1.DefaultIngress{
+				Listening:          rosacontrolplanev1.IngressListeningInternal,
+				RouteSelectors:     map[string]string{"example.com/router": "default"},
+				ExcludedNamespaces: []string{"kube-system", "openshift-monitoring"},
+				LoadBalancerType:   rosacontrolplanev1.IngressLoadBalancerNLB,
+			},
+		},
+		{
+			name: "invalid route selectors and excluded namespaces",
+			ingress: &rosacontrolplanev1.DefaultIngress{
+				RouteSelectors:     map[string]string{"invalid key": "default"},
+				ExcludedNamespaces: []string{"kube-system", "Invalid_Namespace"},
+			},
+			expectedErrorFields: []string{"spec.ingress.routeSelectors", "spec.ingress.excludedNamespaces[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rosaCP := &rosacontrolplanev1.ROSAControlPlane{
+				Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+					Ingress: tt.ingress,
+				},
+			}
+
+			errs := (&ROSAControlPlane{}).validateIngress(rosaCP)
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tt.expectedErrorFields))
+		})
+	}
+}
@@ -82,6 +82,7 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
 	dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+	dst.Spec.AWSLaunchTemplate.AdditionalNetworkInterfaces = restored.Spec.AWSLaunchTemplate.AdditionalNetworkInterfaces
 	return nil
 }
 
@@ -126,6 +127,7 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		}
 		dst.Spec.AWSLaunchTemplate.InstanceMetadataOptions = restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions
 		dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+		dst.Spec.AWSLaunchTemplate.AdditionalNetworkInterfaces = restored.Spec.AWSLaunchTemplate.AdditionalNetworkInterfaces
 
 		if restored.Spec.AWSLaunchTemplate.EnclaveOptions != nil {
 			dst.Spec.AWSLaunchTemplate.EnclaveOptions = restored.Spec.AWSLaunchTemplate.EnclaveOptions
@@ -156,6 +158,7 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	dst.Spec.InstanceTypes = restored.Spec.InstanceTypes
 
 	if restored.Spec.NodeRepairConfig != nil {
 		dst.Spec.NodeRepairConfig = restored.Spec.NodeRepairConfig
@@ -213,6 +216,9 @@ func (src *AWSFargateProfile) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	dst.Status.ActiveProfileName = restored.Status.ActiveProfileName
+	dst.Status.ReplacedProfileName = restored.Status.ReplacedProfileName
+	dst.Status.PendingProfileName = restored.Status.PendingProfileName
 
 	return nil
 }
@@ -265,3 +271,7 @@ func Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in *expinf
 func Convert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in *expinfrav1.FargateProfileSpec, out *FargateProfileSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in, out, s)
 }
+
+func Convert_v1beta2_FargateProfileStatus_To_v1beta1_FargateProfileStatus(in *expinfrav1.FargateProfileStatus, out *FargateProfileStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_FargateProfileStatus_To_v1beta1_FargateProfileStatus(in, out, s)
+}
@@ -44,6 +44,7 @@ type FargateProfileSpec struct {
 
 	// SubnetIDs specifies which subnets are used for the
 	// auto scaling group of this nodegroup.
+	// Changing the subnets replaces the EKS Fargate profile.
 	// +optional
 	SubnetIDs []string `json:"subnetIDs,omitempty"`
 
@@ -84,6 +85,8 @@ type FargateProfileSpec struct {
 	RolePermissionsBoundary string `json:"rolePermissionsBoundary,omitempty"`
 
 	// Selectors specify fargate pod selectors.
+	// Changing the selectors replaces the EKS Fargate profile: a profile with the new selectors is created
+	// and the previous one is deleted once the new one is active.
 	Selectors []FargateSelector `json:"selectors,omitempty"`
 }
 
@@ -140,6 +143,20 @@ type FargateProfileStatus struct {
 	// +optional
 	FailureMessage *string `json:"failureMessage,omitempty"`
 
+	// ActiveProfileName is the name of the EKS Fargate profile serving the pods of this profile. It differs
+	// from the profile name of the spec once the EKS profile has been replaced.
+	// +optional
+	ActiveProfileName string `json:"activeProfileName,omitempty"`
+
+	// ReplacedProfileName is the name of the EKS Fargate profile which has been replaced by the active one
+	// and is being deleted.
+	// +optional
+	ReplacedProfileName string `json:"replacedProfileName,omitempty"`
+
+	// PendingProfileName is the name of the EKS Fargate profile being created to replace the active one.
+	// +optional
+	PendingProfileName string `json:"pendingProfileName,omitempty"`
+
 	// Conditions defines current state of the Fargate profile.
 	// +optional
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
@@ -151,6 +168,7 @@ type FargateProfileStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="AWSFargateProfile ready status"
 // +kubebuilder:printcolumn:name="ProfileName",type="string",JSONPath=".spec.profileName",description="EKS Fargate profile name"
+// +kubebuilder:printcolumn:name="ActiveProfileName",type="string",JSONPath=".status.activeProfileName",description="Active EKS Fargate profile name",priority=1
 // +kubebuilder:printcolumn:name="FailureReason",type="string",JSONPath=".status.failureReason",description="Failure reason"
 
 // AWSFargateProfile is the Schema for the awsfargateprofiles API.
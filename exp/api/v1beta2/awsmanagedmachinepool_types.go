@@ -80,6 +80,8 @@ const (
 	ManagedMachinePoolCapacityTypeOnDemand ManagedMachinePoolCapacityType = "onDemand"
 	// ManagedMachinePoolCapacityTypeSpot is the spot instance capacity type to launch spot instances.
 	ManagedMachinePoolCapacityTypeSpot ManagedMachinePoolCapacityType = "spot"
+	// ManagedMachinePoolCapacityTypeCapacityBlock is the capacity type to launch instances in a Capacity Block for ML.
+	ManagedMachinePoolCapacityTypeCapacityBlock ManagedMachinePoolCapacityType = "capacityBlock"
 )
 
 var (
@@ -180,6 +182,13 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	InstanceType *string `json:"instanceType,omitempty"`
 
+	// InstanceTypes specifies several AWS instance types for the node group, for instance to diversify spot capacity.
+	// It is mutually exclusive with InstanceType. When a launch template is used, the instance types are set on the
+	// node group and the launch template must not have an instance type.
+	// +optional
+	// +listType=set
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
 	// Scaling specifies scaling for the ASG behind this pool
 	// +optional
 	Scaling *ManagedMachinePoolScaling `json:"scaling,omitempty"`
@@ -194,8 +203,10 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	ProviderIDList []string `json:"providerIDList,omitempty"`
 
-	// CapacityType specifies the capacity type for the ASG behind this pool
-	// +kubebuilder:validation:Enum:=onDemand;spot
+	// CapacityType specifies the capacity type for the ASG behind this pool.
+	// The capacityBlock type requires a launch template targeting the Capacity Block with
+	// its capacityReservationID and the CapacityBlock market type.
+	// +kubebuilder:validation:Enum:=onDemand;spot;capacityBlock
 	// +kubebuilder:default:=onDemand
 	// +optional
 	CapacityType *ManagedMachinePoolCapacityType `json:"capacityType,omitempty"`
@@ -104,6 +104,11 @@ const (
 	EKSFargateDeletedReason = "Deleted"
 	// EKSFargateFailedReason used when the profile failed.
 	EKSFargateFailedReason = "Failed"
+	// EKSFargateReplacingCondition used to report that the profile is being replaced by a profile with
+	// the updated selectors and subnets.
+	EKSFargateReplacingCondition clusterv1beta1.ConditionType = "EKSFargateReplacing"
+	// EKSFargateReplacedReason used when the profile has been replaced.
+	EKSFargateReplacedReason = "Replaced"
 )
 
 const (
@@ -153,4 +158,17 @@ const (
 
 	// ROSANetworkDeletionFailedReason used to report failures while deleting ROSANetwork.
 	ROSANetworkDeletionFailedReason = "DeletionFailed"
+
+	// ROSANetworkStackAdoptedCondition reports on the adoption of the resources of the CloudFormation stack
+	// of a ROSANetwork switched to the EC2 provisioner.
+	ROSANetworkStackAdoptedCondition clusterv1beta1.ConditionType = "ROSANetworkStackAdopted"
+
+	// ROSANetworkRetainingStackResourcesReason used while the CloudFormation stack is updated to retain its resources.
+	ROSANetworkRetainingStackResourcesReason = "RetainingStackResources"
+
+	// ROSANetworkDeletingStackReason used while the CloudFormation stack is deleted, keeping its resources.
+	ROSANetworkDeletingStackReason = "DeletingStack"
+
+	// ROSANetworkStackAdoptionFailedReason used to report failures while adopting the resources of the CloudFormation stack.
+	ROSANetworkStackAdoptionFailedReason = "StackAdoptionFailed"
 )
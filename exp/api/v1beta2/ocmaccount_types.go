@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// OCMAccountSpec defines the OCM service account used to manage ROSA clusters, and the Red Hat organization it belongs to.
+type OCMAccountSpec struct {
+	// AllowedNamespaces is used to identify which namespaces are allowed to use the OCM account from.
+	// Namespaces can be selected either using an array of namespaces or with label selector.
+	// An empty allowedNamespaces object indicates that ROSA objects can use this OCM account from any namespace.
+	// If this object is nil, no namespaces will be allowed (default behaviour, if this field is not provided)
+	// A namespace should be either in the NamespaceList or match with Selector to use the OCM account.
+	// Cluster-scoped objects, such as ROSAOCMRoleConfigs, can always use the OCM account.
+	//
+	// +optional
+	// +nullable
+	AllowedNamespaces *infrav1.AllowedNamespaces `json:"allowedNamespaces"`
+
+	// SecretRef is the name of a secret containing the credentials of an OCM service account.
+	// The secret must be in the same namespace as the controller, and contain the following data keys:
+	// - ocmClientID: the client ID of the service account
+	// - ocmClientSecret: the client secret of the service account
+	// - ocmApiUrl: Optional, defaults to 'https://api.openshift.com'
+	// - ocmTokenUrl: Optional, the URL the service account gets its access tokens from, defaults to Red Hat SSO
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	SecretRef string `json:"secretRef"`
+
+	// OrganizationID is the ID, or the external ID shown in the Red Hat Hybrid Cloud Console, of the
+	// Red Hat organization the service account belongs to. When set, the OCM account can't be used if the
+	// service account belongs to another organization, so that clusters are never created in, or billed to,
+	// the wrong organization.
+	// +optional
+	OrganizationID string `json:"organizationID,omitempty"`
+}
+
+// OCMAccount is an OCM service account, which ROSA objects in the allowed namespaces can reference to connect to OCM.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=ocmaccounts,scope=Cluster,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Organization",type="string",JSONPath=".spec.organizationID",description="Red Hat organization of the service account"
+type OCMAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OCMAccountSpec `json:"spec,omitempty"`
+}
+
+// OCMAccountList contains a list of OCMAccount.
+// +kubebuilder:object:root=true
+type OCMAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OCMAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OCMAccount{}, &OCMAccountList{})
+}
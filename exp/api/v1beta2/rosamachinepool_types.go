@@ -43,6 +43,13 @@ type RosaMachinePoolSpec struct {
 	// +optional
 	Version string `json:"version,omitempty"`
 
+	// MaintenanceWindow restricts when version upgrades of this machine pool start.
+	// When set, a version change is held as pending until the next maintenance window opens, and only then scheduled in OCM.
+	// When not set, the maintenance window of the ROSAControlPlane is used, if any.
+	//
+	// +optional
+	MaintenanceWindow *rosacontrolplanev1.MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
 	// AvailabilityZone is an optinal field specifying the availability zone where instances of this machine pool should run
 	// For Multi-AZ clusters, you can create a machine pool in a Single-AZ of your choice.
 	// +optional
@@ -89,10 +96,17 @@ type RosaMachinePoolSpec struct {
 	Autoscaling *rosacontrolplanev1.AutoScaling `json:"autoscaling,omitempty"`
 
 	// TuningConfigs specifies the names of the tuning configs to be applied to this MachinePool.
-	// Tuning configs must already exist.
+	// Tuning configs must be defined in the tuningConfigs of the ROSAControlPlane, or already exist.
 	// +optional
 	TuningConfigs []string `json:"tuningConfigs,omitempty"`
 
+	// KubeletConfigs specifies the name of the kubelet config to be applied to this MachinePool.
+	// The kubelet config must be defined in the kubeletConfigs of the ROSAControlPlane, or already exist.
+	//
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	KubeletConfigs []string `json:"kubeletConfigs,omitempty"`
+
 	// AdditionalSecurityGroups is an optional set of security groups to associate
 	// with all node instances of the machine pool.
 	//
@@ -231,6 +245,10 @@ type RosaMachinePoolStatus struct {
 
 	// Available upgrades for the ROSA MachinePool.
 	AvailableUpgrades []string `json:"availableUpgrades,omitempty"`
+
+	// PendingUpgrade is the version upgrade of the machine pool which hasn't completed yet, if any.
+	// +optional
+	PendingUpgrade *rosacontrolplanev1.PendingUpgrade `json:"pendingUpgrade,omitempty"`
 }
 
 // +kubebuilder:object:root=true
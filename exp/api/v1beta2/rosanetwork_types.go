@@ -26,9 +26,35 @@ import (
 // ROSANetworkFinalizer allows the controller to clean up resources on delete.
 const ROSANetworkFinalizer = "rosanetwork.infrastructure.cluster.x-k8s.io"
 
+// ROSANetworkProvisioner is the way the network infrastructure of a ROSANetwork is provisioned.
+type ROSANetworkProvisioner string
+
+const (
+	// ROSANetworkProvisionerCloudFormation provisions the network infrastructure through a CloudFormation stack.
+	ROSANetworkProvisionerCloudFormation ROSANetworkProvisioner = "CloudFormation"
+
+	// ROSANetworkProvisionerEC2 reconciles the network infrastructure directly through the EC2 API.
+	ROSANetworkProvisionerEC2 ROSANetworkProvisioner = "EC2"
+)
+
 // ROSANetworkSpec defines the desired state of ROSANetwork
 type ROSANetworkSpec struct {
-	// The name of the cloudformation stack under which the network infrastructure would be created
+	// Provisioner selects how the network infrastructure is provisioned.
+	// CloudFormation creates the network infrastructure from a fixed CloudFormation template, and can't change it afterwards.
+	// EC2 reconciles the network infrastructure through the EC2 API, which allows to add availability zones,
+	// change the tags and associate additional CIDR blocks after creation.
+	// Changing the provisioner of an existing network from CloudFormation to EC2 adopts the resources
+	// of the CloudFormation stack and deletes the stack, keeping its resources. The provisioner can't be changed
+	// from EC2 to CloudFormation.
+	// +kubebuilder:validation:Enum=CloudFormation;EC2
+	// +kubebuilder:default=CloudFormation
+	// +kubebuilder:validation:XValidation:rule="oldSelf != 'EC2' || self == 'EC2'", message="provisioner can't be changed from EC2 to CloudFormation"
+	// +optional
+	Provisioner ROSANetworkProvisioner `json:"provisioner,omitempty"`
+
+	// The name of the cloudformation stack under which the network infrastructure would be created.
+	// With the EC2 provisioner, the network resources are named after it, and the resources of an existing stack
+	// with this name are adopted.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="stackName is immutable"
 	// +kubebuilder:validation:Required
 	StackName string `json:"stackName"`
@@ -41,23 +67,34 @@ type ROSANetworkSpec struct {
 	// The number of availability zones to be used for creation of the network infrastructure.
 	// You can specify anything between one and four, depending on the chosen AWS region.
 	// Either AvailabilityZoneCount OR AvailabilityZones must be set.
+	// It is immutable with the CloudFormation provisioner, and can only be increased with the EC2 provisioner.
 	// +kubebuilder:validation:Minimum=1
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="availabilityZoneCount is immutable"
+	// +kubebuilder:validation:Maximum=4
 	// +optional
 	AvailabilityZoneCount int `json:"availabilityZoneCount,omitempty"`
 
 	// The list of availability zones to be used for creation of the network infrastructure.
 	// You can specify anything between one and four valid availability zones from a given region.
 	// Either AvailabilityZones OR AvailabilityZoneCount must be set.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="availabilityZones is immutable"
+	// It is immutable with the CloudFormation provisioner, and availability zones can only be added with the EC2 provisioner.
+	// +kubebuilder:validation:MaxItems=4
 	// +optional
 	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 
 	// CIDR block to be used for the VPC
 	// +kubebuilder:validation:Format=cidr
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="cidrBlock is immutable"
 	// +kubebuilder:validation:Required
 	CIDRBlock string `json:"cidrBlock"`
 
+	// AdditionalCIDRBlocks are IPv4 CIDR blocks associated with the VPC in addition to CIDRBlock,
+	// extending the range of addresses of the VPC. Only supported with the EC2 provisioner.
+	// CIDR blocks can be added, but removing them doesn't disassociate them from the VPC.
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=set
+	// +optional
+	AdditionalCIDRBlocks []string `json:"additionalCIDRBlocks,omitempty"`
+
 	// IdentityRef is a reference to an identity to be used when reconciling rosa network.
 	// If no identity is specified, the default identity for this controller will be used.
 	// +optional
@@ -65,6 +102,7 @@ type ROSANetworkSpec struct {
 
 	// StackTags is an optional set of tags to add to the created cloudformation stack.
 	// The stack tags will then be automatically applied to the supported AWS resources (VPC, subnets, ...).
+	// With the EC2 provisioner, the tags are applied to the network resources directly and can be changed.
 	// +optional
 	StackTags Tags `json:"stackTags,omitempty"`
 }
@@ -99,6 +137,21 @@ type CFResource struct {
 	Reason string `json:"reason"`
 }
 
+// ROSANetworkEC2Status is the state of the network infrastructure reconciled through the EC2 API.
+type ROSANetworkEC2Status struct {
+	// VPC is the state of the VPC.
+	// +optional
+	VPC infrav1.VPCSpec `json:"vpc,omitempty"`
+
+	// Subnets is the state of the public and private subnets.
+	// +optional
+	Subnets infrav1.Subnets `json:"subnets,omitempty"`
+
+	// NatGatewaysIPs are the public IPs of the NAT gateways.
+	// +optional
+	NatGatewaysIPs []string `json:"natGatewaysIPs,omitempty"`
+}
+
 // ROSANetworkStatus defines the observed state of ROSANetwork
 type ROSANetworkStatus struct {
 	// Array of created private, public subnets and availability zones, grouped by availability zones
@@ -107,6 +160,10 @@ type ROSANetworkStatus struct {
 	// Resources created in the cloudformation stack
 	Resources []CFResource `json:"resources,omitempty"`
 
+	// EC2 is the state of the network infrastructure reconciled with the EC2 provisioner.
+	// +optional
+	EC2 *ROSANetworkEC2Status `json:"ec2,omitempty"`
+
 	// Conditions specifies the conditions for ROSANetwork
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
 }
@@ -70,6 +70,7 @@ const (
 )
 
 // ROSAOCMRoleConfigSpec defines the desired state of ROSAOCMRoleConfig
+// +kubebuilder:validation:XValidation:rule="!(has(self.ocmAccountRef) && has(self.credentialsSecretRef))",message="ocmAccountRef and credentialsSecretRef are mutually exclusive"
 type ROSAOCMRoleConfigSpec struct {
 	// RolePrefix is the user-defined prefix for the OCM role name.
 	// The final role name will be: {RolePrefix}-OCM-Role-{ExternalID}
@@ -104,6 +105,11 @@ type ROSAOCMRoleConfigSpec struct {
 	// +optional
 	CredentialsSecretRef *corev1.SecretReference `json:"credentialsSecretRef,omitempty"`
 
+	// OCMAccountRef references the cluster-scoped OCMAccount whose service account is used to connect to the OCM API,
+	// instead of the credentials of credentialsSecretRef.
+	// +optional
+	OCMAccountRef *corev1.LocalObjectReference `json:"ocmAccountRef,omitempty"`
+
 	// DeletionPolicy determines what happens to the OCM role when this CR is deleted.
 	// Delete will unlink and delete the OCM role.
 	// Retain will keep the OCM role intact.
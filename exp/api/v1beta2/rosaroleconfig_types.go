@@ -93,6 +93,7 @@ const (
 )
 
 // ROSARoleConfigSpec defines the desired state of ROSARoleConfig
+// +kubebuilder:validation:XValidation:rule="!(has(self.ocmAccountRef) && has(self.credentialsSecretRef))",message="ocmAccountRef and credentialsSecretRef are mutually exclusive"
 type ROSARoleConfigSpec struct {
 	// AccountRoleConfig defines account-wide IAM roles before creating your ROSA cluster.
 	AccountRoleConfig AccountRoleConfig `json:"accountRoleConfig"`
@@ -109,6 +110,11 @@ type ROSARoleConfigSpec struct {
 	// +optional
 	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 
+	// OCMAccountRef references the cluster-scoped OCMAccount whose service account is used to connect to the OCM API,
+	// instead of the credentials of credentialsSecretRef. The namespace of the ROSARoleConfig must be allowed by the OCMAccount.
+	// +optional
+	OCMAccountRef *corev1.LocalObjectReference `json:"ocmAccountRef,omitempty"`
+
 	// OIDC provider type values are Managed or UnManaged. When set to Unmanged OperatorRoleConfig OIDCID field must be provided.
 	// +kubebuilder:validation:Enum=Managed;Unmanaged
 	// +kubebuilder:default=Managed
@@ -123,6 +123,16 @@ type AWSLaunchTemplate struct {
 	// +optional
 	AdditionalSecurityGroups []infrav1.AWSResourceReference `json:"additionalSecurityGroups,omitempty"`
 
+	// AdditionalNetworkInterfaces is a list of network interfaces that are created together with each
+	// instance launched from this template, in addition to the primary network interface.
+	// Since a launch template is shared by all the availability zones of a pool, a subnet
+	// referenced here pins the instances to the availability zone of that subnet.
+	// +optional
+	// +kubebuilder:validation:MaxItems=15
+	// +listType=map
+	// +listMapKey=deviceIndex
+	AdditionalNetworkInterfaces []infrav1.NetworkInterfaceSpec `json:"additionalNetworkInterfaces,omitempty"`
+
 	// SpotMarketOptions are options for configuring AWSMachinePool instances to be run using AWS Spot instances.
 	SpotMarketOptions *infrav1.SpotMarketOptions `json:"spotMarketOptions,omitempty"`
 
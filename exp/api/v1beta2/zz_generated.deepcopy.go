@@ -122,6 +122,13 @@ func (in *AWSLaunchTemplate) DeepCopyInto(out *AWSLaunchTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]apiv1beta2.NetworkInterfaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SpotMarketOptions != nil {
 		in, out := &in.SpotMarketOptions, &out.SpotMarketOptions
 		*out = new(apiv1beta2.SpotMarketOptions)
@@ -522,6 +529,11 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(string)
 		**out = **in
 	}
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Scaling != nil {
 		in, out := &in.Scaling, &out.Scaling
 		*out = new(ManagedMachinePoolScaling)
@@ -954,6 +966,84 @@ func (in *NodeRepairConfig) DeepCopy() *NodeRepairConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCMAccount) DeepCopyInto(out *OCMAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCMAccount.
+func (in *OCMAccount) DeepCopy() *OCMAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(OCMAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OCMAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCMAccountList) DeepCopyInto(out *OCMAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OCMAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCMAccountList.
+func (in *OCMAccountList) DeepCopy() *OCMAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(OCMAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OCMAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCMAccountSpec) DeepCopyInto(out *OCMAccountSpec) {
+	*out = *in
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(apiv1beta2.AllowedNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCMAccountSpec.
+func (in *OCMAccountSpec) DeepCopy() *OCMAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OCMAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorRoleConfig) DeepCopyInto(out *OperatorRoleConfig) {
 	*out = *in
@@ -1235,6 +1325,34 @@ func (in *ROSANetwork) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSANetworkEC2Status) DeepCopyInto(out *ROSANetworkEC2Status) {
+	*out = *in
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(apiv1beta2.Subnets, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NatGatewaysIPs != nil {
+		in, out := &in.NatGatewaysIPs, &out.NatGatewaysIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSANetworkEC2Status.
+func (in *ROSANetworkEC2Status) DeepCopy() *ROSANetworkEC2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSANetworkEC2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ROSANetworkList) DeepCopyInto(out *ROSANetworkList) {
 	*out = *in
@@ -1275,6 +1393,11 @@ func (in *ROSANetworkSpec) DeepCopyInto(out *ROSANetworkSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalCIDRBlocks != nil {
+		in, out := &in.AdditionalCIDRBlocks, &out.AdditionalCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.IdentityRef != nil {
 		in, out := &in.IdentityRef, &out.IdentityRef
 		*out = new(apiv1beta2.AWSIdentityReference)
@@ -1312,6 +1435,11 @@ func (in *ROSANetworkStatus) DeepCopyInto(out *ROSANetworkStatus) {
 		*out = make([]CFResource, len(*in))
 		copy(*out, *in)
 	}
+	if in.EC2 != nil {
+		in, out := &in.EC2, &out.EC2
+		*out = new(ROSANetworkEC2Status)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -1418,6 +1546,11 @@ func (in *ROSAOCMRoleConfigSpec) DeepCopyInto(out *ROSAOCMRoleConfigSpec) {
 		*out = new(corev1.SecretReference)
 		**out = **in
 	}
+	if in.OCMAccountRef != nil {
+		in, out := &in.OCMAccountRef, &out.OCMAccountRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAOCMRoleConfigSpec.
@@ -1526,6 +1659,11 @@ func (in *ROSARoleConfigSpec) DeepCopyInto(out *ROSARoleConfigSpec) {
 		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
+	if in.OCMAccountRef != nil {
+		in, out := &in.OCMAccountRef, &out.OCMAccountRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSARoleConfigSpec.
@@ -1625,6 +1763,11 @@ func (in *RollingUpdate) DeepCopy() *RollingUpdate {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RosaMachinePoolSpec) DeepCopyInto(out *RosaMachinePoolSpec) {
 	*out = *in
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(rosaapiv1beta2.MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -1654,6 +1797,11 @@ func (in *RosaMachinePoolSpec) DeepCopyInto(out *RosaMachinePoolSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KubeletConfigs != nil {
+		in, out := &in.KubeletConfigs, &out.KubeletConfigs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.AdditionalSecurityGroups != nil {
 		in, out := &in.AdditionalSecurityGroups, &out.AdditionalSecurityGroups
 		*out = make([]string, len(*in))
@@ -1706,6 +1854,11 @@ func (in *RosaMachinePoolStatus) DeepCopyInto(out *RosaMachinePoolStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PendingUpgrade != nil {
+		in, out := &in.PendingUpgrade, &out.PendingUpgrade
+		*out = new(rosaapiv1beta2.PendingUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RosaMachinePoolStatus.
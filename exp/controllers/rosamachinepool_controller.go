@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -303,7 +304,8 @@ func (r *ROSAMachinePoolReconciler) reconcileNormal(ctx context.Context,
 				return ctrl.Result{}, err
 			}
 
-			return ctrl.Result{}, nil
+			// Requeue when an upgrade is held until a maintenance window, so that it is scheduled once the window opens.
+			return ctrl.Result{RequeueAfter: rosa.UntilMaintenanceWindow(rosaMachinePool.Status.PendingUpgrade)}, nil
 		}
 
 		v1beta1conditions.MarkFalse(rosaMachinePool,
@@ -376,6 +378,7 @@ func (r *ROSAMachinePoolReconciler) reconcileMachinePoolVersion(machinePoolScope
 	if version == "" || version == rosa.RawVersionID(nodePool.Version()) {
 		machinePoolScope.RosaMachinePool.Status.AvailableUpgrades = nodePool.Version().AvailableUpgrades()
 		v1beta1conditions.MarkFalse(machinePoolScope.RosaMachinePool, expinfrav1.RosaMachinePoolUpgradingCondition, "upgraded", clusterv1beta1.ConditionSeverityInfo, "")
+		machinePoolScope.RosaMachinePool.Status.PendingUpgrade = nil
 		return nil
 	}
 
@@ -386,7 +389,31 @@ func (r *ROSAMachinePoolReconciler) reconcileMachinePoolVersion(machinePoolScope
 	}
 
 	if scheduledUpgrade == nil {
-		scheduledUpgrade, err = rosa.ScheduleNodePoolUpgrade(ocmClient, clusterID, nodePool, version, time.Now())
+		nextRun := time.Now()
+		window := machinePoolScope.RosaMachinePool.Spec.MaintenanceWindow
+		if window == nil {
+			window = machinePoolScope.ControlPlane.Spec.MaintenanceWindow
+		}
+		if window != nil {
+			windowStart, err := rosa.NextMaintenanceWindow(window, nextRun)
+			if err != nil {
+				return fmt.Errorf("failed to find the next maintenance window: %w", err)
+			}
+
+			// Hold the upgrade until the next maintenance window opens.
+			if windowStart.After(nextRun) {
+				machinePoolScope.RosaMachinePool.Status.PendingUpgrade = &rosacontrolplanev1.PendingUpgrade{
+					Version:                     version,
+					ScheduledAt:                 metav1.NewTime(windowStart),
+					WaitingForMaintenanceWindow: true,
+				}
+				v1beta1conditions.MarkFalse(machinePoolScope.RosaMachinePool, expinfrav1.RosaMachinePoolUpgradingCondition, rosacontrolplanev1.WaitingForMaintenanceWindowReason, clusterv1beta1.ConditionSeverityInfo,
+					"Upgrade to version %s is pending until the maintenance window opening at %s", version, windowStart.UTC().Format(time.RFC3339))
+				return nil
+			}
+		}
+
+		scheduledUpgrade, err = rosa.ScheduleNodePoolUpgrade(ocmClient, clusterID, nodePool, version, nextRun)
 		if err != nil {
 			return fmt.Errorf("failed to schedule nodePool upgrade to version %s: %w", version, err)
 		}
@@ -399,6 +426,10 @@ func (r *ROSAMachinePoolReconciler) reconcileMachinePoolVersion(machinePoolScope
 		Message: fmt.Sprintf("Upgrading to version %s", scheduledUpgrade.Version()),
 	}
 	v1beta1conditions.Set(machinePoolScope.RosaMachinePool, condition)
+	machinePoolScope.RosaMachinePool.Status.PendingUpgrade = &rosacontrolplanev1.PendingUpgrade{
+		Version:     scheduledUpgrade.Version(),
+		ScheduledAt: metav1.NewTime(scheduledUpgrade.NextRun()),
+	}
 
 	// if nodePool is already upgrading to another version we need to wait until the current upgrade is finished, return an error to requeue and try later.
 	if scheduledUpgrade.Version() != version {
@@ -503,6 +534,10 @@ func nodePoolBuilder(rosaMachinePoolSpec expinfrav1.RosaMachinePoolSpec, machine
 		npBuilder = npBuilder.TuningConfigs(rosaMachinePoolSpec.TuningConfigs...)
 	}
 
+	if rosaMachinePoolSpec.KubeletConfigs != nil {
+		npBuilder = npBuilder.KubeletConfigs(rosaMachinePoolSpec.KubeletConfigs...)
+	}
+
 	if len(rosaMachinePoolSpec.Taints) > 0 {
 		taintBuilders := make([]*cmv1.TaintBuilder, 0, len(rosaMachinePoolSpec.Taints))
 		for _, taint := range rosaMachinePoolSpec.Taints {
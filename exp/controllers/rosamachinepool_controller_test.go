@@ -10,6 +10,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -31,6 +33,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 )
 
@@ -898,3 +901,196 @@ func (m replicasMatcher) String() string {
 func matchesReplicas(replicas int) gomock.Matcher {
 	return replicasMatcher{replicas: replicas}
 }
+
+func TestRosaMachinePoolReconcileCreatesNodePool(t *testing.T) {
+	g := NewWithT(t)
+	ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("test-namespace-nodepool-%d", time.Now().UnixNano()))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The node pool is created in a cluster which already exists in OCM.
+	ocmClient, err := ocmServer.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	ocmCluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "rosa-cluster-nodepool",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator: &rosaaws.Creator{
+			ARN:       "arn:aws:iam::123456789012:user/test",
+			AccountID: "123456789012",
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	ocmServer.Settle()
+
+	secret := ocmServer.CredentialsSecret("rosa-creds-secret", ns.Name)
+
+	rosaControlPlane := &rosacontrolplanev1.ROSAControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rosa-control-plane-nodepool",
+			Namespace: ns.Name,
+		},
+		Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+			RosaClusterName:   ocmCluster.Name(),
+			Subnets:           []string{"subnet-0ac99a6230b408813", "subnet-1ac99a6230b408811"},
+			AvailabilityZones: []string{"az-1", "az-2"},
+			Network: &rosacontrolplanev1.NetworkSpec{
+				MachineCIDR: "10.0.0.0/16",
+				PodCIDR:     "10.128.0.0/14",
+				ServiceCIDR: "172.30.0.0/16",
+			},
+			Region:       "us-east-1",
+			Version:      "4.17.0",
+			ChannelGroup: "stable",
+			RolesRef: rosacontrolplanev1.AWSRolesRef{
+				IngressARN:              "op-arn1",
+				ImageRegistryARN:        "op-arn2",
+				StorageARN:              "op-arn3",
+				NetworkARN:              "op-arn4",
+				KubeCloudControllerARN:  "op-arn5",
+				NodePoolManagementARN:   "op-arn6",
+				ControlPlaneOperatorARN: "op-arn7",
+				KMSProviderARN:          "op-arn8",
+			},
+			OIDCID:           "iodcid1",
+			InstallerRoleARN: "arn:aws:iam::123456789012:role/installer",
+			WorkerRoleARN:    "arn:aws:iam::123456789012:role/worker",
+			SupportRoleARN:   "arn:aws:iam::123456789012:role/support",
+			CredentialsSecretRef: &corev1.LocalObjectReference{
+				Name: secret.Name,
+			},
+		},
+	}
+
+	ownerCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner-cluster-nodepool",
+			Namespace: ns.Name,
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: clusterv1.ContractVersionedObjectReference{
+				Name:     rosaControlPlane.Name,
+				Kind:     "ROSAControlPlane",
+				APIGroup: rosacontrolplanev1.GroupVersion.Group,
+			},
+		},
+	}
+
+	rosaMachinePool := &expinfrav1.ROSAMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rosa-machinepool-nodepool",
+			Namespace: ns.Name,
+		},
+		Spec: expinfrav1.RosaMachinePoolSpec{
+			NodePoolName: "workers",
+			Version:      "4.17.0",
+			Subnet:       "subnet-0ac99a6230b408813",
+			InstanceType: "m5.large",
+		},
+	}
+
+	machinePool := &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machinepool-nodepool",
+			Namespace: ns.Name,
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: ownerCluster.Name},
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			ClusterName: ownerCluster.Name,
+			Replicas:    ptr.To[int32](2),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					ClusterName: ownerCluster.Name,
+					InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+						Name:     rosaMachinePool.Name,
+						Kind:     "ROSAMachinePool",
+						APIGroup: expinfrav1.GroupVersion.Group,
+					},
+				},
+			},
+		},
+	}
+
+	objects := []client.Object{secret, ownerCluster, rosaControlPlane, machinePool}
+	for _, obj := range objects {
+		createObject(g, obj, ns.Name)
+	}
+	rosaMachinePool.OwnerReferences = []metav1.OwnerReference{
+		{
+			Name:       machinePool.Name,
+			UID:        machinePool.UID,
+			Kind:       "MachinePool",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+	}
+	createObject(g, rosaMachinePool, ns.Name)
+	objects = append(objects, rosaMachinePool)
+	defer func() {
+		for _, obj := range objects {
+			cleanupObject(g, obj)
+		}
+	}()
+
+	// Make the control plane ready, can't do this during creation.
+	cpPh, err := patch.NewHelper(rosaControlPlane, testEnv)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	rosaControlPlane.Status.Ready = true
+	rosaControlPlane.Status.ID = ocmCluster.ID()
+	rosaControlPlane.Status.Version = rosaControlPlane.Spec.Version
+	g.Expect(cpPh.Patch(ctx, rosaControlPlane)).To(Succeed())
+
+	// Add the paused condition, so that the reconciler doesn't return early to set it.
+	rmpPh, err := patch.NewHelper(rosaMachinePool, testEnv)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	v1beta1conditions.MarkFalse(rosaMachinePool, clusterv1beta1.PausedV1Beta2Condition, clusterv1beta1.NotPausedV1Beta2Reason, "", "")
+	g.Expect(rmpPh.Patch(ctx, rosaMachinePool)).To(Succeed())
+
+	r := ROSAMachinePoolReconciler{
+		Recorder:     record.NewFakeRecorder(10),
+		Client:       testEnv,
+		NewOCMClient: ocmServer.NewOCMClient,
+	}
+
+	req := ctrl.Request{}
+	req.NamespacedName = types.NamespacedName{Name: rosaMachinePool.Name, Namespace: ns.Name}
+	key := client.ObjectKey{Name: rosaMachinePool.Name, Namespace: ns.Name}
+	m := &expinfrav1.ROSAMachinePool{}
+
+	// The first reconciliation creates the node pool in OCM.
+	g.Eventually(func(g Gomega) {
+		cp := &rosacontrolplanev1.ROSAControlPlane{}
+		g.Expect(testEnv.Get(ctx, client.ObjectKeyFromObject(rosaControlPlane), cp)).To(Succeed())
+		g.Expect(cp.Status.Ready).To(BeTrue())
+		g.Expect(testEnv.Get(ctx, key, m)).To(Succeed())
+		g.Expect(v1beta1conditions.Has(m, clusterv1beta1.PausedV1Beta2Condition)).To(BeTrue())
+	}).WithTimeout(10 * time.Second).Should(Succeed())
+	result, err := r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{}))
+
+	g.Eventually(func(g Gomega) {
+		g.Expect(testEnv.Get(ctx, key, m)).To(Succeed())
+		g.Expect(m.Status.ID).To(Equal(rosaMachinePool.Spec.NodePoolName))
+	}).WithTimeout(10 * time.Second).Should(Succeed())
+	nodePool := ocmServer.NodePool(ocmCluster.ID(), rosaMachinePool.Spec.NodePoolName)
+	g.Expect(nodePool).ToNot(BeNil())
+	g.Expect(nodePool.Replicas()).To(Equal(2))
+	g.Expect(nodePool.AWSNodePool().InstanceType()).To(Equal(rosaMachinePool.Spec.InstanceType))
+
+	// Once OCM has scaled the node pool, the machine pool is ready.
+	ocmServer.Settle()
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Eventually(func(g Gomega) {
+		g.Expect(testEnv.Get(ctx, key, m)).To(Succeed())
+		g.Expect(m.Status.Ready).To(BeTrue())
+		g.Expect(m.Status.Replicas).To(Equal(int32(2)))
+		g.Expect(v1beta1conditions.IsTrue(m, expinfrav1.RosaMachinePoolReadyCondition)).To(BeTrue())
+	}).WithTimeout(10 * time.Second).Should(Succeed())
+}
@@ -45,6 +45,7 @@ import (
 
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/rosanetwork"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
@@ -64,6 +65,8 @@ type ROSANetworkReconciler struct {
 	WatchFilterValue string
 	// awsClientFactory overrides AWS client creation per reconciliation. Used in tests to inject mock clients.
 	awsClientFactory func(scope *scope.ROSANetworkScope) (rosaAWSClient.Client, error)
+	// networkServiceFactory overrides the creation of the EC2 provisioner network service. Used in tests to inject mock clients.
+	networkServiceFactory func(scope *scope.ROSANetworkScope) *rosanetwork.Service
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosanetworks,verbs=get;list;watch;create;update;patch;delete
@@ -94,6 +97,21 @@ func (r *ROSANetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, fmt.Errorf("failed to create rosanetwork scope: %w", err)
 	}
 
+	// Always close the scope
+	defer func() {
+		if err := rosaNetworkScope.PatchObject(); err != nil {
+			reterr = errors.Join(reterr, err)
+		}
+	}()
+
+	if rosaNetwork.Spec.Provisioner == expinfrav1.ROSANetworkProvisionerEC2 {
+		networkService := r.newNetworkService(rosaNetworkScope)
+		if !rosaNetwork.ObjectMeta.DeletionTimestamp.IsZero() {
+			return r.reconcileDeleteEC2(ctx, rosaNetworkScope, networkService)
+		}
+		return r.reconcileNormalEC2(ctx, rosaNetworkScope, networkService)
+	}
+
 	// Create a new AWS/CloudFormation Client per reconciliation so the correct identity is always used.
 	awsClient, err := r.newAWSClient(rosaNetworkScope)
 	if err != nil {
@@ -111,13 +129,6 @@ func (r *ROSANetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
-	// Always close the scope
-	defer func() {
-		if err := rosaNetworkScope.PatchObject(); err != nil {
-			reterr = errors.Join(reterr, err)
-		}
-	}()
-
 	if !rosaNetwork.ObjectMeta.DeletionTimestamp.IsZero() {
 		// Handle deletion reconciliation loop.
 		return r.reconcileDelete(ctx, rosaNetworkScope, awsClient, cfStack)
@@ -259,6 +270,98 @@ func (r *ROSANetworkReconciler) reconcileDelete(ctx context.Context, rosaNetScop
 	return ctrl.Result{}, nil
 }
 
+// reconcileNormalEC2 reconciles the network through the EC2 API, after adopting the resources of the
+// CloudFormation stack of a network migrated from the CloudFormation provisioner.
+func (r *ROSANetworkReconciler) reconcileNormalEC2(ctx context.Context, rosaNetScope *scope.ROSANetworkScope, networkService *rosanetwork.Service) (res ctrl.Result, reterr error) {
+	rosaNetScope.Info("Reconciling ROSANetwork with the EC2 provisioner")
+
+	if controllerutil.AddFinalizer(rosaNetScope.ROSANetwork, expinfrav1.ROSANetworkFinalizer) {
+		if err := rosaNetScope.PatchObject(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to patch ROSANetwork: %w", err)
+		}
+	}
+
+	if !v1beta1conditions.Has(rosaNetScope.ROSANetwork, expinfrav1.ROSANetworkReadyCondition) {
+		v1beta1conditions.MarkFalse(rosaNetScope.ROSANetwork,
+			expinfrav1.ROSANetworkReadyCondition,
+			expinfrav1.ROSANetworkCreatingReason,
+			clusterv1beta1.ConditionSeverityInfo,
+			"")
+	}
+
+	adopted, err := networkService.AdoptStack(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(rosaNetScope.ROSANetwork,
+			expinfrav1.ROSANetworkStackAdoptedCondition,
+			expinfrav1.ROSANetworkStackAdoptionFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to adopt CF stack resources: %w", err)
+	}
+	if !adopted {
+		return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+	}
+
+	if err := networkService.ReconcileNetwork(ctx); err != nil {
+		v1beta1conditions.MarkFalse(rosaNetScope.ROSANetwork,
+			expinfrav1.ROSANetworkReadyCondition,
+			expinfrav1.ROSANetworkFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile network: %w", err)
+	}
+
+	v1beta1conditions.Set(rosaNetScope.ROSANetwork,
+		&clusterv1beta1.Condition{
+			Type:     expinfrav1.ROSANetworkReadyCondition,
+			Status:   corev1.ConditionTrue,
+			Reason:   expinfrav1.ROSANetworkCreatedReason,
+			Severity: clusterv1beta1.ConditionSeverityInfo,
+		})
+	return ctrl.Result{}, nil
+}
+
+// reconcileDeleteEC2 deletes the network through the EC2 API, after deleting the CloudFormation stack of a network
+// whose resources haven't been adopted yet.
+func (r *ROSANetworkReconciler) reconcileDeleteEC2(ctx context.Context, rosaNetScope *scope.ROSANetworkScope, networkService *rosanetwork.Service) (res ctrl.Result, reterr error) {
+	rosaNetScope.Info("Reconciling ROSANetwork delete with the EC2 provisioner")
+
+	v1beta1conditions.MarkFalse(rosaNetScope.ROSANetwork,
+		expinfrav1.ROSANetworkReadyCondition,
+		expinfrav1.ROSANetworkDeletingReason,
+		clusterv1beta1.ConditionSeverityInfo,
+		"")
+
+	deleted, err := networkService.DeleteStack(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(rosaNetScope.ROSANetwork,
+			expinfrav1.ROSANetworkReadyCondition,
+			expinfrav1.ROSANetworkDeletionFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to delete CF stack: %w", err)
+	}
+	if !deleted {
+		return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+	}
+
+	if err := networkService.DeleteNetwork(ctx); err != nil {
+		v1beta1conditions.MarkFalse(rosaNetScope.ROSANetwork,
+			expinfrav1.ROSANetworkReadyCondition,
+			expinfrav1.ROSANetworkDeletionFailedReason,
+			clusterv1beta1.ConditionSeverityError,
+			"%s",
+			err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to delete network: %w", err)
+	}
+
+	controllerutil.RemoveFinalizer(rosaNetScope.ROSANetwork, expinfrav1.ROSANetworkFinalizer)
+	return ctrl.Result{}, nil
+}
+
 func (r *ROSANetworkReconciler) updateROSANetworkResources(ctx context.Context, rosaNet *expinfrav1.ROSANetwork, awsClient rosaAWSClient.Client) error {
 	resources, err := awsClient.DescribeCFStackResources(ctx, rosaNet.Spec.StackName)
 	if err != nil {
@@ -323,6 +426,14 @@ func (r *ROSANetworkReconciler) newAWSClient(rosaNetworkScope *scope.ROSANetwork
 		Build()
 }
 
+// newNetworkService creates the network service of the EC2 provisioner. Tests inject via networkServiceFactory.
+func (r *ROSANetworkReconciler) newNetworkService(rosaNetworkScope *scope.ROSANetworkScope) *rosanetwork.Service {
+	if r.networkServiceFactory != nil {
+		return r.networkServiceFactory(rosaNetworkScope)
+	}
+	return rosanetwork.NewService(rosaNetworkScope)
+}
+
 // SetupWithManager is used to setup the controller.
 func (r *ROSANetworkReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
 	log := logger.FromContext(ctx)
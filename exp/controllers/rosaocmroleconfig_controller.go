@@ -99,6 +99,7 @@ func (r *ROSAOCMRoleConfigReconciler) SetupWithManager(ctx context.Context, mgr
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosaocmroleconfigs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosaocmroleconfigs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=rosaocmroleconfigs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ocmaccounts,verbs=get;list;watch
 
 // Reconcile reconciles ROSAOCMRoleConfig.
 func (r *ROSAOCMRoleConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, reterr error) {
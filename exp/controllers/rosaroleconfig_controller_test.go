@@ -52,6 +52,7 @@ import (
 	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
@@ -1403,3 +1404,107 @@ func TestROSARoleConfigUpdatePredicate(t *testing.T) {
 		})
 	}
 }
+
+func TestROSARoleConfigReconcileCreatesOIDCConfig(t *testing.T) {
+	g := NewWithT(t)
+	testID := generateTestID()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// The account and operator roles already exist, so only the OIDC config is created in OCM.
+	mockAWSClient := aws.NewMockClient(mockCtrl)
+	for _, roleName := range []string{
+		"test-HCP-ROSA-Installer-Role",
+		"test-HCP-ROSA-Support-Role",
+		"test-HCP-ROSA-Worker-Role",
+		"test-openshift-ingress-operator-cloud-credentials",
+		"test-openshift-image-registry-installer-cloud-credentials",
+		"test-openshift-cluster-csi-drivers-ebs-cloud-credentials",
+		"test-openshift-cloud-network-config-controller-cloud-credentials",
+		"test-kube-system-kube-controller-manager",
+		"test-kube-system-capa-controller-manager",
+		"test-kube-system-control-plane-operator",
+		"test-kube-system-kms-provider",
+	} {
+		mockAWSClient.EXPECT().GetRoleByName(roleName).Return(
+			iamTypes.Role{Arn: awsSdk.String("arn:aws:iam::123456789012:role/" + roleName)}, nil).AnyTimes()
+	}
+
+	// The OIDC provider is looked up by the issuer URL of the OIDC config created in OCM.
+	var issuerURL string
+	mockAWSClient.EXPECT().GetOpenIDConnectProviderByOidcEndpointUrl(gomock.Any()).DoAndReturn(func(url string) (string, error) {
+		issuerURL = url
+		return "arn:aws:iam::123456789012:oidc-provider/test-oidc", nil
+	}).AnyTimes()
+
+	ns, err := testEnv.CreateNamespace(ctx, fmt.Sprintf("test-namespace-oidc-%s", testID))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	rosaRoleConfig := &expinfrav1.ROSARoleConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       fmt.Sprintf("test-rosarole-oidc-%s", testID),
+			Namespace:  ns.Name,
+			Finalizers: []string{expinfrav1.RosaRoleConfigFinalizer},
+		},
+		Spec: expinfrav1.ROSARoleConfigSpec{
+			AccountRoleConfig: expinfrav1.AccountRoleConfig{
+				Prefix:  "test",
+				Version: "4.17.0",
+			},
+			OperatorRoleConfig: expinfrav1.OperatorRoleConfig{
+				Prefix: "test",
+			},
+			OidcProviderType: expinfrav1.Managed,
+		},
+	}
+	createObject(g, rosaRoleConfig, ns.Name)
+	defer cleanupObject(g, rosaRoleConfig)
+
+	// The runtime reaches the fake OCM API through the NewOCMClient function of the reconciler.
+	reconciler := &ROSARoleConfigReconciler{
+		Client:       testEnv.Client,
+		NewOCMClient: ocmServer.NewOCMClientWithoutControlPlane,
+	}
+	reconciler.runtimeFactory = func(ctx context.Context, scope *scope.RosaRoleConfigScope) (*rosacli.Runtime, error) {
+		wrapped, err := reconciler.NewOCMClient(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		ocmClient, err := rosa.ConvertToRosaOcmClient(wrapped)
+		if err != nil {
+			return nil, err
+		}
+
+		rt := rosacli.NewRuntime()
+		rt.OCMClient = ocmClient
+		rt.AWSClient = mockAWSClient
+		rt.Creator = &aws.Creator{
+			ARN:       "arn:aws:iam::123456789012:user/test-user",
+			AccountID: "123456789012",
+		}
+		return rt, nil
+	}
+
+	req := ctrl.Request{}
+	req.NamespacedName = types.NamespacedName{Name: rosaRoleConfig.Name, Namespace: rosaRoleConfig.Namespace}
+
+	g.Eventually(func(g Gomega) {
+		_, err := reconciler.Reconcile(ctx, req)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedRoleConfig := &expinfrav1.ROSARoleConfig{}
+		g.Expect(reconciler.Client.Get(ctx, req.NamespacedName, updatedRoleConfig)).To(Succeed())
+		g.Expect(updatedRoleConfig.Status.OIDCID).ToNot(BeEmpty())
+		g.Expect(updatedRoleConfig.Status.OIDCProviderARN).To(Equal("arn:aws:iam::123456789012:oidc-provider/test-oidc"))
+
+		oidcConfig := ocmServer.OidcConfig(updatedRoleConfig.Status.OIDCID)
+		g.Expect(oidcConfig).ToNot(BeNil())
+		g.Expect(oidcConfig.Managed()).To(BeTrue())
+		g.Expect(issuerURL).To(Equal(oidcConfig.IssuerUrl()))
+
+		readyCondition := v1beta1conditions.Get(updatedRoleConfig, expinfrav1.RosaRoleConfigReadyCondition)
+		g.Expect(readyCondition).ToNot(BeNil())
+		g.Expect(readyCondition.Status).To(Equal(corev1.ConditionTrue))
+	}).WithTimeout(30 * time.Second).WithPolling(500 * time.Millisecond).Should(Succeed())
+}
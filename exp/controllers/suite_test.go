@@ -33,6 +33,7 @@ import (
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	expwebhooks "sigs.k8s.io/cluster-api-provider-aws/v2/exp/webhooks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
 	capawebhooks "sigs.k8s.io/cluster-api-provider-aws/v2/webhooks"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
@@ -42,7 +43,9 @@ import (
 
 var (
 	testEnv *helpers.TestEnvironment
-	ctx     = ctrl.SetupSignalHandler()
+	// ocmServer is the fake OCM API the ROSA reconcilers reach through their NewOCMClient functions.
+	ocmServer *ocmfake.Server
+	ctx       = ctrl.SetupSignalHandler()
 )
 
 func TestMain(m *testing.M) {
@@ -106,9 +109,15 @@ func setup() {
 		}
 	}()
 	testEnv.WaitForWebhooks()
+
+	ocmServer, err = ocmfake.NewServer()
+	if err != nil {
+		panic(fmt.Sprintf("Failed to start the fake OCM API: %v", err))
+	}
 }
 
 func teardown() {
+	ocmServer.Close()
 	if err := testEnv.Stop(); err != nil {
 		panic(fmt.Sprintf("Failed to stop envtest: %v", err))
 	}
@@ -42,6 +42,7 @@ func NodePoolToRosaMachinePoolSpec(nodePool *cmv1.NodePool) expinfrav1.RosaMachi
 		AutoRepair:               nodePool.AutoRepair(),
 		InstanceType:             nodePool.AWSNodePool().InstanceType(),
 		TuningConfigs:            nodePool.TuningConfigs(),
+		KubeletConfigs:           nodePool.KubeletConfigs(),
 		AdditionalSecurityGroups: nodePool.AWSNodePool().AdditionalSecurityGroupIds(),
 		VolumeSize:               nodePool.AWSNodePool().RootVolume().Size(),
 		CapacityReservationID:    nodePool.AWSNodePool().CapacityReservation().Id(),
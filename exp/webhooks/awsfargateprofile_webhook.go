@@ -132,6 +132,9 @@ func (w *AWSFargateProfile) ValidateUpdate(_ context.Context, oldObj, newObj run
 	// remove additionalTags from equal check since they are mutable
 	old.Spec.AdditionalTags = nil
 	r.Spec.AdditionalTags = nil
+	// remove selectors and subnets from equal check since changing them replaces the EKS profile
+	old.Spec.Selectors, old.Spec.SubnetIDs = nil, nil
+	r.Spec.Selectors, r.Spec.SubnetIDs = nil, nil
 
 	if !cmp.Equal(old.Spec, r.Spec) {
 		allErrs = append(
@@ -81,6 +81,13 @@ func TestAWSFargateProfileValidateRoleNameUpdate(t *testing.T) {
 	beforeWithDifferentRoleName := before.DeepCopy()
 	beforeWithDifferentRoleName.Spec.RoleName = "different-role-name"
 
+	selectorsUpdate := before.DeepCopy()
+	selectorsUpdate.Spec.Selectors = []expinfrav1.FargateSelector{{Namespace: "default", Labels: map[string]string{"app": "foo"}}}
+	selectorsUpdate.Spec.SubnetIDs = []string{"subnet-1"}
+
+	profileNameUpdate := before.DeepCopy()
+	profileNameUpdate.Spec.ProfileName = "other-profilename"
+
 	tests := []struct {
 		name           string
 		expectErr      bool
@@ -111,6 +118,18 @@ func TestAWSFargateProfileValidateRoleNameUpdate(t *testing.T) {
 			before:         beforeWithDifferentRoleName,
 			fargateProfile: validRoleNameUpdate,
 		},
+		{
+			name:           "update selectors and subnets should succeed",
+			expectErr:      false,
+			before:         before,
+			fargateProfile: selectorsUpdate,
+		},
+		{
+			name:           "update profileName should fail",
+			expectErr:      true,
+			before:         before,
+			fargateProfile: profileNameUpdate,
+		},
 		{
 			name:           "update tags should fail when invalid tags are present",
 			expectErr:      true,
@@ -214,6 +214,7 @@ func (w *AWSMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (
 	allErrs = append(allErrs, w.validateCapacityReservation(r)...)
 	allErrs = append(allErrs, w.validateLifecycleHooks(r)...)
 	allErrs = append(allErrs, w.validateIgnition(r)...)
+	allErrs = append(allErrs, w.validateAdditionalNetworkInterfaces(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -226,6 +227,10 @@ func (w *AWSMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (
 	)
 }
 
+func (w *AWSMachinePool) validateAdditionalNetworkInterfaces(r *expinfrav1.AWSMachinePool) field.ErrorList {
+	return infrav1.ValidateNetworkInterfaceSpecs(field.NewPath("spec", "awsLaunchTemplate", "additionalNetworkInterfaces"), r.Spec.AWSLaunchTemplate.AdditionalNetworkInterfaces, 1)
+}
+
 func (w *AWSMachinePool) validateCapacityReservation(r *expinfrav1.AWSMachinePool) field.ErrorList {
 	var allErrs field.ErrorList
 	if r.Spec.AWSLaunchTemplate.CapacityReservationID != nil &&
@@ -280,6 +285,7 @@ func (w *AWSMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj runtim
 	allErrs = append(allErrs, w.validateSpotInstances(r)...)
 	allErrs = append(allErrs, w.validateRefreshPreferences(r)...)
 	allErrs = append(allErrs, w.validateLifecycleHooks(r)...)
+	allErrs = append(allErrs, w.validateAdditionalNetworkInterfaces(r)...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 )
@@ -124,7 +125,10 @@ func (w *AWSManagedMachinePool) validateRemoteAccess(r *expinfrav1.AWSManagedMac
 	return allErrs
 }
 
-func (w *AWSManagedMachinePool) validateLaunchTemplate(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+// validateLaunchTemplate validates the launch template of a pool. On update, old is the pool before the update and
+// the constraints of the fields of the node group are only checked when they change, so that the pools created
+// before these constraints existed can still be updated and deleted.
+func (w *AWSManagedMachinePool) validateLaunchTemplate(r, old *expinfrav1.AWSManagedMachinePool) field.ErrorList {
 	var allErrs field.ErrorList
 	if r.Spec.AWSLaunchTemplate == nil {
 		return allErrs
@@ -141,6 +145,94 @@ func (w *AWSManagedMachinePool) validateLaunchTemplate(r *expinfrav1.AWSManagedM
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "AWSLaunchTemplate", "IamInstanceProfile"), r.Spec.AWSLaunchTemplate.IamInstanceProfile, "IAM instance profile in launch template is prohibited in EKS managed node group"))
 	}
 
+	launchTemplatePath := field.NewPath("spec", "awsLaunchTemplate")
+	lt := r.Spec.AWSLaunchTemplate
+
+	changed := func(value func(*expinfrav1.AWSManagedMachinePool) any) bool {
+		return old == nil || old.Spec.AWSLaunchTemplate == nil || !cmp.Equal(value(old), value(r))
+	}
+	remoteAccessChanged := changed(func(p *expinfrav1.AWSManagedMachinePool) any { return p.Spec.RemoteAccess })
+	instanceTypesChanged := changed(func(p *expinfrav1.AWSManagedMachinePool) any {
+		return []any{p.Spec.InstanceTypes, p.Spec.AWSLaunchTemplate.InstanceType}
+	})
+	amiChanged := changed(func(p *expinfrav1.AWSManagedMachinePool) any {
+		return []any{p.Spec.AMIType, p.Spec.AMIVersion, p.Spec.AWSLaunchTemplate.AMI}
+	})
+	marketChanged := changed(func(p *expinfrav1.AWSManagedMachinePool) any {
+		return []any{p.Spec.CapacityType, p.Spec.AWSLaunchTemplate.MarketType, p.Spec.AWSLaunchTemplate.SpotMarketOptions, p.Spec.AWSLaunchTemplate.CapacityReservationID}
+	})
+
+	// The remote access of a node group is configured through a launch template, its SSH key and security groups,
+	// when one is used.
+	if remoteAccessChanged && r.Spec.RemoteAccess != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "remoteAccess"), "remoteAccess cannot be specified when awsLaunchTemplate is specified, use awsLaunchTemplate.sshKeyName and awsLaunchTemplate.additionalSecurityGroups instead"))
+	}
+	if instanceTypesChanged && len(r.Spec.InstanceTypes) > 0 && lt.InstanceType != "" {
+		allErrs = append(allErrs, field.Forbidden(launchTemplatePath.Child("instanceType"), "instanceType cannot be specified in the launch template when instanceTypes is specified"))
+	}
+
+	// The AMI set in the launch template is a custom AMI for EKS, which doesn't accept another AMI type for
+	// the node group. The release version of the node group is set by the AMI of the launch template.
+	if amiChanged && lt.AMI.ID != nil && r.Spec.AMIType != nil && *r.Spec.AMIType != expinfrav1.Custom {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "amiType"), *r.Spec.AMIType, fmt.Sprintf("must be %s or unset when awsLaunchTemplate.ami.id is specified", expinfrav1.Custom)))
+	}
+	// A launch template without AMI uses the EKS optimized AMI of the node group, whose release version can be pinned.
+	if amiChanged && r.Spec.AMIVersion != nil && (lt.AMI.ID != nil || lt.AMI.EKSOptimizedLookupType != nil) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "amiVersion"), "amiVersion cannot be specified when awsLaunchTemplate.ami is specified"))
+	}
+
+	// Managed node groups choose between on-demand, spot and Capacity Block instances with their capacity type,
+	// they don't support the spot options of the launch template such as the max price.
+	if marketChanged {
+		allErrs = append(allErrs, w.validateLaunchTemplateMarket(r)...)
+	}
+
+	allErrs = append(allErrs, infrav1.ValidateNetworkInterfaceSpecs(launchTemplatePath.Child("additionalNetworkInterfaces"), lt.AdditionalNetworkInterfaces, 1)...)
+
+	return allErrs
+}
+
+func (w *AWSManagedMachinePool) validateLaunchTemplateMarket(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+	launchTemplatePath := field.NewPath("spec", "awsLaunchTemplate")
+	lt := r.Spec.AWSLaunchTemplate
+
+	if lt.SpotMarketOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(launchTemplatePath.Child("spotMarketOptions"), "spot market options are not supported by EKS managed node groups, use capacityType spot instead"))
+	}
+	if lt.MarketType == infrav1.MarketTypeSpot {
+		allErrs = append(allErrs, field.Forbidden(launchTemplatePath.Child("marketType"), "the Spot market type is not supported by EKS managed node groups, use capacityType spot instead"))
+	}
+	capacityBlock := ptr.Deref(r.Spec.CapacityType, "") == expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock
+	if capacityBlock && lt.MarketType != infrav1.MarketTypeCapacityBlock {
+		allErrs = append(allErrs, field.Invalid(launchTemplatePath.Child("marketType"), lt.MarketType, fmt.Sprintf("must be %s when capacityType is %s", infrav1.MarketTypeCapacityBlock, expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock)))
+	}
+	if !capacityBlock && lt.MarketType == infrav1.MarketTypeCapacityBlock {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "capacityType"), ptr.Deref(r.Spec.CapacityType, ""), fmt.Sprintf("must be %s when awsLaunchTemplate.marketType is %s", expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock, infrav1.MarketTypeCapacityBlock)))
+	}
+	if lt.MarketType == infrav1.MarketTypeCapacityBlock && lt.CapacityReservationID == nil {
+		allErrs = append(allErrs, field.Required(launchTemplatePath.Child("capacityReservationID"), "capacityReservationID is required to target a Capacity Block"))
+	}
+
+	return allErrs
+}
+
+func (w *AWSManagedMachinePool) validateInstanceTypes(r *expinfrav1.AWSManagedMachinePool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.InstanceType != nil && len(r.Spec.InstanceTypes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "instanceTypes"), "instanceTypes and instanceType are mutually exclusive"))
+	}
+	for i, instanceType := range r.Spec.InstanceTypes {
+		if instanceType == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "instanceTypes").Index(i), "instance type must not be empty"))
+		}
+	}
+
+	if ptr.Deref(r.Spec.CapacityType, "") == expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock && r.Spec.AWSLaunchTemplate == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "awsLaunchTemplate"), "a launch template targeting the Capacity Block is required when capacityType is capacityBlock"))
+	}
+
 	return allErrs
 }
 
@@ -171,7 +263,10 @@ func (w *AWSManagedMachinePool) ValidateCreate(_ context.Context, obj runtime.Ob
 	if errs := w.validateNodegroupUpdateConfig(r); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
-	if errs := w.validateLaunchTemplate(r); len(errs) > 0 {
+	if errs := w.validateInstanceTypes(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateLaunchTemplate(r, nil); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
 	if errs := w.validateLifecycleHooks(r); len(errs) > 0 {
@@ -216,7 +311,10 @@ func (w *AWSManagedMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj
 	if errs := w.validateNodegroupUpdateConfig(r); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
-	if errs := w.validateLaunchTemplate(r); len(errs) > 0 {
+	if errs := w.validateInstanceTypes(r); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := w.validateLaunchTemplate(r, oldPool); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
 	if errs := w.validateLifecycleHooks(r); len(errs) > 0 {
@@ -265,9 +363,15 @@ func (w *AWSManagedMachinePool) validateImmutable(r *expinfrav1.AWSManagedMachin
 	appendErrorIfMutated(old.Spec.SubnetIDs, r.Spec.SubnetIDs, "subnetIDs")
 	appendErrorIfSetAndMutated(old.Spec.RoleName, r.Spec.RoleName, "roleName")
 	appendErrorIfMutated(old.Spec.DiskSize, r.Spec.DiskSize, "diskSize")
-	appendErrorIfMutated(old.Spec.AMIType, r.Spec.AMIType, "amiType")
+	// The AMI type defaulted by the API server is replaced by CUSTOM for node groups using the AMI of a launch
+	// template, which is what EKS records for them.
+	if !(r.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate.AMI.ID != nil &&
+		isDefaultAMIType(old.Spec.AMIType) && ptr.Deref(r.Spec.AMIType, "") == expinfrav1.Custom) {
+		appendErrorIfMutated(old.Spec.AMIType, r.Spec.AMIType, "amiType")
+	}
 	appendErrorIfMutated(old.Spec.RemoteAccess, r.Spec.RemoteAccess, "remoteAccess")
 	appendErrorIfSetAndMutated(old.Spec.CapacityType, r.Spec.CapacityType, "capacityType")
+	appendErrorIfMutated(old.Spec.InstanceTypes, r.Spec.InstanceTypes, "instanceTypes")
 	appendErrorIfMutated(old.Spec.AvailabilityZones, r.Spec.AvailabilityZones, "availabilityZones")
 	appendErrorIfMutated(old.Spec.AvailabilityZoneSubnetType, r.Spec.AvailabilityZoneSubnetType, "availabilityZoneSubnetType")
 	if (old.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate == nil) ||
@@ -310,5 +414,16 @@ func (w *AWSManagedMachinePool) Default(_ context.Context, obj runtime.Object) e
 			MaxUnavailable: ptr.To[int](1),
 		}
 	}
+
+	// The AMI set in a launch template is a custom AMI for EKS. Node groups whose launch template doesn't set
+	// an AMI keep their AMI type, which EKS uses to pick their AMI.
+	if r.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate.AMI.ID != nil && isDefaultAMIType(r.Spec.AMIType) {
+		r.Spec.AMIType = ptr.To(expinfrav1.Custom)
+	}
 	return nil
 }
+
+// isDefaultAMIType returns true if the AMI type is the one defaulted by the API server.
+func isDefaultAMIType(amiType *expinfrav1.ManagedMachineAMIType) bool {
+	return amiType == nil || *amiType == expinfrav1.Al2x86_64
+}
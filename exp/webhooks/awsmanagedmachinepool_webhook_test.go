@@ -50,6 +50,31 @@ func TestAWSManagedMachinePoolDefault(t *testing.T) {
 
 	err := (&AWSManagedMachinePool{}).Default(context.Background(), fargate)
 	g.Expect(err).NotTo(HaveOccurred())
+
+	withLaunchTemplate := &expinfrav1.AWSManagedMachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: expinfrav1.AWSManagedMachinePoolSpec{
+			AMIType: &oldAmiType,
+			AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+				Name: "test",
+				AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+			},
+		},
+	}
+	err = (&AWSManagedMachinePool{}).Default(context.Background(), withLaunchTemplate)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(withLaunchTemplate.Spec.AMIType).To(Equal(ptr.To(expinfrav1.Custom)))
+
+	withLaunchTemplateWithoutAMI := &expinfrav1.AWSManagedMachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec: expinfrav1.AWSManagedMachinePoolSpec{
+			AMIType:           &oldAmiType,
+			AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+		},
+	}
+	err = (&AWSManagedMachinePool{}).Default(context.Background(), withLaunchTemplateWithoutAMI)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(withLaunchTemplateWithoutAMI.Spec.AMIType).To(Equal(&oldAmiType))
 }
 
 func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
@@ -156,6 +181,206 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "multiple instance types are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceTypes:    []string{"m5.large", "m5a.large"},
+					CapacityType:     &newCapacityType,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance types with an instance type are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					InstanceType:     ptr.To("m5.large"),
+					InstanceTypes:    []string{"m5.large", "m5a.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "instance types with a launch template are accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					InstanceTypes:     []string{"m5.large", "m5a.large"},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "instance types with a launch template instance type are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					InstanceTypes:     []string{"m5.large", "m5a.large"},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test", InstanceType: "m5.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remote access with a launch template is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					RemoteAccess:      &expinfrav1.ManagedRemoteAccess{SSHKeyName: ptr.To("test")},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "custom AMI type with a launch template is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.Custom),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "other AMI type with a launch template AMI is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIType:          ptr.To(expinfrav1.Al2023x86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "other AMI type with a launch template without AMI is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					AMIType:           ptr.To(expinfrav1.Al2023x86_64),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AMI version with a launch template without AMI is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-3",
+					AMIVersion:        ptr.To("1.33.0-20250101"),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AMI version with a launch template AMI ID is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIVersion:       ptr.To("1.33.0-20250101"),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AMI version with a launch template AMI lookup is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AMIVersion:       ptr.To("1.33.0-20250101"),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI:  infrav1.AMIReference{EKSOptimizedLookupType: ptr.To(infrav1.AmazonLinux)},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "spot market options in the launch template are rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:              "test",
+						SpotMarketOptions: &infrav1.SpotMarketOptions{MaxPrice: ptr.To("0.1")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity block with a launch template targeting it is accepted",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:                  "test",
+						MarketType:            infrav1.MarketTypeCapacityBlock,
+						CapacityReservationID: ptr.To("cr-123"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "capacity block without a launch template is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity block without a capacity reservation is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     ptr.To(expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:       "test",
+						MarketType: infrav1.MarketTypeCapacityBlock,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "capacity block market type with another capacity type is rejected",
+			pool: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					CapacityType:     &oldCapacityType,
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:                  "test",
+						MarketType:            infrav1.MarketTypeCapacityBlock,
+						CapacityReservationID: ptr.To("cr-123"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -672,6 +897,116 @@ func TestAWSManagedMachinePoolValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "changing instance types is rejected",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					InstanceTypes:    []string{"m5.large"},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					InstanceTypes:    []string{"m5.large", "m5a.large"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "replacing the default AMI type with CUSTOM for a launch template AMI is accepted",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIType:          &oldAmiType,
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+					},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIType:          ptr.To(expinfrav1.Custom),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name: "test",
+						AMI:  infrav1.AMIReference{ID: ptr.To("ami-123")},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "replacing the AMI type with CUSTOM for a launch template without AMI is rejected",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-1",
+					AMIType:           &oldAmiType,
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-1",
+					AMIType:           ptr.To(expinfrav1.Custom),
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "updating a pool created with fields now forbidden with a launch template is accepted",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIType:          ptr.To(expinfrav1.Al2023x86_64),
+					AMIVersion:       ptr.To("1.33.0-20250101"),
+					RemoteAccess:     &expinfrav1.ManagedRemoteAccess{Public: true},
+					CapacityType:     &newCapacityType,
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:              "test",
+						AMI:               infrav1.AMIReference{ID: ptr.To("ami-123")},
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AMIType:          ptr.To(expinfrav1.Al2023x86_64),
+					AMIVersion:       ptr.To("1.33.0-20250101"),
+					RemoteAccess:     &expinfrav1.ManagedRemoteAccess{Public: true},
+					CapacityType:     &newCapacityType,
+					Labels:           map[string]string{"team": "a"},
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:              "test",
+						AMI:               infrav1.AMIReference{ID: ptr.To("ami-123")},
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "adding spot market options to a launch template is rejected",
+			old: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName:  "eks-node-group-1",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{Name: "test"},
+				},
+			},
+			new: &expinfrav1.AWSManagedMachinePool{
+				Spec: expinfrav1.AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					AWSLaunchTemplate: &expinfrav1.AWSLaunchTemplate{
+						Name:              "test",
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "changing launch template fields other than name is accepted",
 			old: &expinfrav1.AWSManagedMachinePool{
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
 )
 
 // ROSAMachinePool implements a custom validation webhook for ROSAMachinePool.
@@ -51,6 +52,8 @@ func (w *ROSAMachinePool) ValidateCreate(_ context.Context, obj runtime.Object)
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, rosa.ValidateMaintenanceWindow(r.Spec.MaintenanceWindow, field.NewPath("spec", "maintenanceWindow"))...)
+
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 
 	if len(allErrs) == 0 {
@@ -87,6 +90,8 @@ func (w *ROSAMachinePool) ValidateUpdate(_ context.Context, oldObj, newObj runti
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, rosa.ValidateMaintenanceWindow(r.Spec.MaintenanceWindow, field.NewPath("spec", "maintenanceWindow"))...)
+
 	allErrs = append(allErrs, validateImmutable(oldPool.Spec.AdditionalSecurityGroups, r.Spec.AdditionalSecurityGroups, "additionalSecurityGroups")...)
 	allErrs = append(allErrs, validateImmutable(oldPool.Spec.AdditionalTags, r.Spec.AdditionalTags, "additionalTags")...)
 
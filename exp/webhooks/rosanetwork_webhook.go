@@ -3,6 +3,8 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -48,6 +50,8 @@ func (w *ROSANetwork) ValidateCreate(ctx context.Context, obj runtime.Object) (w
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, validateROSANetworkProvisioner(rosaNet)...)
+
 	if len(allErrs) > 0 {
 		return nil, apierrors.NewInvalid(
 			rosaNet.GroupVersionKind().GroupKind(),
@@ -60,9 +64,73 @@ func (w *ROSANetwork) ValidateCreate(ctx context.Context, obj runtime.Object) (w
 
 // ValidateUpdate implements admission.Validator.
 func (w *ROSANetwork) ValidateUpdate(ctx context.Context, old runtime.Object, updated runtime.Object) (warnings admission.Warnings, err error) {
+	rosaNet, ok := updated.(*expinfrav1.ROSANetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected an ROSANetwork object but got %T", updated)
+	}
+	oldRosaNet, ok := old.(*expinfrav1.ROSANetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected an ROSANetwork object but got %T", old)
+	}
+
+	allErrs := validateROSANetworkProvisioner(rosaNet)
+
+	zoneCountPath := field.NewPath("spec", "availabilityZoneCount")
+	zonesPath := field.NewPath("spec", "availabilityZones")
+	if rosaNet.Spec.Provisioner != expinfrav1.ROSANetworkProvisionerEC2 {
+		if rosaNet.Spec.AvailabilityZoneCount != oldRosaNet.Spec.AvailabilityZoneCount {
+			allErrs = append(allErrs, field.Invalid(zoneCountPath, rosaNet.Spec.AvailabilityZoneCount, "availabilityZoneCount is immutable"))
+		}
+		if !slices.Equal(rosaNet.Spec.AvailabilityZones, oldRosaNet.Spec.AvailabilityZones) {
+			allErrs = append(allErrs, field.Invalid(zonesPath, rosaNet.Spec.AvailabilityZones, "availabilityZones is immutable"))
+		}
+	} else {
+		if rosaNet.Spec.AvailabilityZoneCount < oldRosaNet.Spec.AvailabilityZoneCount {
+			allErrs = append(allErrs, field.Invalid(zoneCountPath, rosaNet.Spec.AvailabilityZoneCount, "availabilityZoneCount can't be decreased"))
+		}
+		for _, zone := range oldRosaNet.Spec.AvailabilityZones {
+			if !slices.Contains(rosaNet.Spec.AvailabilityZones, zone) {
+				allErrs = append(allErrs, field.Invalid(zonesPath, rosaNet.Spec.AvailabilityZones, fmt.Sprintf("availability zone %s can't be removed", zone)))
+			}
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			rosaNet.GroupVersionKind().GroupKind(),
+			rosaNet.Name,
+			allErrs)
+	}
+
 	return nil, nil
 }
 
+// validateROSANetworkProvisioner validates the fields that depend on the provisioner of the ROSANetwork.
+func validateROSANetworkProvisioner(rosaNet *expinfrav1.ROSANetwork) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rosaNet.Spec.Provisioner != expinfrav1.ROSANetworkProvisionerEC2 {
+		if len(rosaNet.Spec.AdditionalCIDRBlocks) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "additionalCIDRBlocks"), "additionalCIDRBlocks is only supported with the EC2 provisioner"))
+		}
+		return allErrs
+	}
+
+	// The EC2 provisioner lays out the subnets like the CloudFormation template, in the first eight /24 blocks of the VPC.
+	if _, ipNet, err := net.ParseCIDR(rosaNet.Spec.CIDRBlock); err == nil {
+		if prefixLength, _ := ipNet.Mask.Size(); ipNet.IP.To4() == nil || prefixLength > 21 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "cidrBlock"), rosaNet.Spec.CIDRBlock, "cidrBlock must be an IPv4 CIDR block with a prefix length of at most 21 with the EC2 provisioner"))
+		}
+	}
+	for i, cidrBlock := range rosaNet.Spec.AdditionalCIDRBlocks {
+		if ip, _, err := net.ParseCIDR(cidrBlock); err != nil || ip.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "additionalCIDRBlocks").Index(i), cidrBlock, "must be an IPv4 CIDR block"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateDelete implements admission.Validator.
 func (w *ROSANetwork) ValidateDelete(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
 	return nil, nil
@@ -456,6 +456,10 @@ func setupReconcilersAndWebhooks(ctx context.Context, mgr ctrl.Manager,
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterStaticIdentity")
 		os.Exit(1)
 	}
+	if err := (&capawebhooks.AWSClusterWebIdentity{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AWSClusterWebIdentity")
+		os.Exit(1)
+	}
 	if err := (&capawebhooks.AWSMachine{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "AWSMachine")
 		os.Exit(1)
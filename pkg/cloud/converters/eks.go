@@ -59,10 +59,26 @@ func AddonSDKToAddonState(eksAddon *ekstypes.Addon) *ekscontrolplanev1.AddonStat
 			})
 		}
 	}
+	addonState.Progress = AddonStatusToProgress(eksAddon.Status, len(addonState.Issues) > 0)
 
 	return addonState
 }
 
+// AddonStatusToProgress converts the status of an addon to the progress of its rollout.
+func AddonStatusToProgress(status ekstypes.AddonStatus, hasIssues bool) ekscontrolplanev1.AddonProgress {
+	switch status {
+	case ekstypes.AddonStatusActive:
+		if hasIssues {
+			return ekscontrolplanev1.AddonProgressDegraded
+		}
+		return ekscontrolplanev1.AddonProgressReady
+	case ekstypes.AddonStatusCreating, ekstypes.AddonStatusUpdating, ekstypes.AddonStatusDeleting:
+		return ekscontrolplanev1.AddonProgressRollingOut
+	default:
+		return ekscontrolplanev1.AddonProgressDegraded
+	}
+}
+
 // FromAWSStringSlice will converts an AWS string pointer slice.
 func FromAWSStringSlice(from []*string) []string {
 	converted := make([]string, 0, len(from))
@@ -176,6 +192,8 @@ func CapacityTypeToSDK(capacityType expinfrav1.ManagedMachinePoolCapacityType) (
 		return ekstypes.CapacityTypesOnDemand, nil
 	case expinfrav1.ManagedMachinePoolCapacityTypeSpot:
 		return ekstypes.CapacityTypesSpot, nil
+	case expinfrav1.ManagedMachinePoolCapacityTypeCapacityBlock:
+		return ekstypes.CapacityTypesCapacityBlock, nil
 	default:
 		return "", ErrUnknownCapacityType
 	}
@@ -23,12 +23,15 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -57,6 +60,9 @@ var (
 		"ssm":                  ssm.ServiceID,
 		"sts":                  sts.ServiceID,
 		"secretsmanager":       secretsmanager.ServiceID,
+		"kms":                  kms.ServiceID,
+		"rolesanywhere":        rolesanywhere.ServiceID,
+		"cloudformation":       cloudformation.ServiceID,
 	}
 )
 
@@ -357,6 +363,72 @@ func (s *SSMEndpointResolver) ResolveEndpoint(ctx context.Context, params ssm.En
 	return ssm.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
 }
 
+// KMSEndpointResolver implements EndpointResolverV2 interface for KMS.
+type KMSEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for KMS.
+func (s *KMSEndpointResolver) ResolveEndpoint(ctx context.Context, params kms.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[kms.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return kms.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return kms.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
+
+// RolesAnywhereEndpointResolver implements EndpointResolverV2 interface for IAM Roles Anywhere.
+type RolesAnywhereEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for IAM Roles Anywhere.
+func (s *RolesAnywhereEndpointResolver) ResolveEndpoint(ctx context.Context, params rolesanywhere.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[rolesanywhere.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return rolesanywhere.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return rolesanywhere.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
+
+// CloudFormationEndpointResolver implements EndpointResolverV2 interface for CloudFormation.
+type CloudFormationEndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for CloudFormation.
+func (s *CloudFormationEndpointResolver) ResolveEndpoint(ctx context.Context, params cloudformation.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[cloudformation.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return cloudformation.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return cloudformation.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
+
 // STSEndpointResolver implements EndpointResolverV2 interface for STS.
 type STSEndpointResolver struct {
 	*MultiServiceEndpointResolver
@@ -29,6 +29,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -37,6 +39,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 )
 
+// DefaultWebIdentityTokenSecretKey is the default key of the secret holding the token of an AWSClusterWebIdentity.
+const DefaultWebIdentityTokenSecretKey = "token"
+
 // AWSPrincipalTypeProvider defines the interface for AWS Principal Type Provider.
 type AWSPrincipalTypeProvider interface {
 	aws.CredentialsProvider
@@ -104,6 +109,61 @@ func NewAWSRolePrincipalTypeProvider(identity *infrav1.AWSClusterRoleIdentity, s
 	}
 }
 
+// NewAWSWebIdentityPrincipalTypeProvider will create a new AWSWebIdentityPrincipalTypeProvider from an AWSClusterWebIdentity.
+// The token is read from the secret when given, or else from the token file of the identity.
+func NewAWSWebIdentityPrincipalTypeProvider(identity *infrav1.AWSClusterWebIdentity, secret *corev1.Secret, region string, log logger.Wrapper) *AWSWebIdentityPrincipalTypeProvider {
+	var token []byte
+	if secret != nil && identity.Spec.TokenSecretRef != nil {
+		token = secret.Data[webIdentityTokenSecretKey(identity.Spec.TokenSecretRef)]
+	}
+	return &AWSWebIdentityPrincipalTypeProvider{
+		credentials: nil,
+		stsClient:   nil,
+		region:      region,
+		Principal:   identity,
+		Token:       token,
+		log:         log.WithName("AWSWebIdentityPrincipalTypeProvider"),
+	}
+}
+
+// GetWebIdentityCredentialsCache will return the CredentialsCache of a given AWSWebIdentityPrincipalTypeProvider.
+func GetWebIdentityCredentialsCache(ctx context.Context, webIdentityProvider *AWSWebIdentityPrincipalTypeProvider, optFns []func(*config.LoadOptions) error) (*aws.CredentialsCache, error) {
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var stsClient stscreds.AssumeRoleWithWebIdentityAPIClient
+	if webIdentityProvider.stsClient != nil {
+		// For testing
+		stsClient = webIdentityProvider.stsClient
+	} else {
+		stsOpts := sts.WithAPIOptions(
+			awsmetrics.WithMiddlewares("identity provider", webIdentityProvider.Principal),
+			awsmetrics.WithCAPAUserAgentMiddleware())
+		stsClient = sts.NewFromConfig(cfg, stsOpts)
+	}
+
+	spec := webIdentityProvider.Principal.Spec
+	var tokenRetriever stscreds.IdentityTokenRetriever = stscreds.IdentityTokenFile(spec.TokenFile)
+	if spec.TokenSecretRef != nil {
+		tokenRetriever = staticIdentityToken(webIdentityProvider.Token)
+	}
+
+	credsProvider := stscreds.NewWebIdentityRoleProvider(stsClient, spec.RoleArn, tokenRetriever, func(o *stscreds.WebIdentityRoleOptions) {
+		o.RoleSessionName = spec.SessionName
+		if spec.InlinePolicy != "" {
+			o.Policy = aws.String(spec.InlinePolicy)
+		}
+		for _, policyARN := range spec.PolicyARNs {
+			o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{Arn: aws.String(policyARN)})
+		}
+		o.Duration = time.Duration(spec.DurationSeconds) * time.Second
+	})
+
+	return aws.NewCredentialsCache(credsProvider), nil
+}
+
 // AWSStaticPrincipalTypeProvider defines the specs for a static AWSPrincipalTypeProvider.
 type AWSStaticPrincipalTypeProvider struct {
 	Principal   *infrav1.AWSClusterStaticIdentity
@@ -182,3 +242,68 @@ func (p *AWSRolePrincipalTypeProvider) Retrieve(ctx context.Context) (aws.Creden
 	}
 	return p.credentials.Retrieve(ctx)
 }
+
+// AWSWebIdentityPrincipalTypeProvider defines the specs for a AWSPrincipalTypeProvider assuming a role with an OpenID Connect token.
+type AWSWebIdentityPrincipalTypeProvider struct {
+	Principal *infrav1.AWSClusterWebIdentity
+	// Token is the token read from the secret of the identity, if any. It is part of the hash,
+	// so that a new provider is used when the token of the secret is rotated.
+	Token       []byte
+	credentials *aws.CredentialsCache
+	region      string
+	log         logger.Wrapper
+	stsClient   stsservice.STSClient
+}
+
+// Hash returns the byte encoded AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Hash() (string, error) {
+	var webIdentityValue bytes.Buffer
+	err := gob.NewEncoder(&webIdentityValue).Encode(p)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	return string(hash.Sum(webIdentityValue.Bytes())), nil
+}
+
+// Name returns the name of the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Name() string {
+	return p.Principal.Name
+}
+
+// Retrieve returns the credential values for the AWSWebIdentityPrincipalTypeProvider.
+func (p *AWSWebIdentityPrincipalTypeProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	if p.credentials == nil {
+		// AssumeRoleWithWebIdentity is authenticated by the token, so no source credentials are needed.
+		optFns := []func(*config.LoadOptions) error{
+			config.WithRegion(p.region),
+			config.WithCredentialsProvider(aws.AnonymousCredentials{}),
+		}
+
+		creds, err := GetWebIdentityCredentialsCache(ctx, p, optFns)
+		if err != nil {
+			return aws.Credentials{}, err
+		}
+		// Update credentials
+		p.credentials = creds
+	}
+	return p.credentials.Retrieve(ctx)
+}
+
+// staticIdentityToken is an IdentityTokenRetriever returning a token read beforehand.
+type staticIdentityToken []byte
+
+// GetIdentityToken returns the token.
+func (t staticIdentityToken) GetIdentityToken() ([]byte, error) {
+	if len(t) == 0 {
+		return nil, errors.New("web identity token is empty")
+	}
+	return t, nil
+}
+
+func webIdentityTokenSecretKey(ref *infrav1.WebIdentityTokenSecretReference) string {
+	if ref.Key == "" {
+		return DefaultWebIdentityTokenSecretKey
+	}
+	return ref.Key
+}
@@ -28,9 +28,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 )
 
 func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
@@ -193,3 +195,138 @@ func TestAWSStaticPrincipalTypeProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSWebIdentityPrincipalTypeProvider(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	webIdentity := &infrav1.AWSClusterWebIdentity{
+		Spec: infrav1.AWSClusterWebIdentitySpec{
+			AWSRoleSpec: infrav1.AWSRoleSpec{
+				RoleArn:         "arn:*:iam::*:role/aws-role/webidentityprovider",
+				SessionName:     "web-identity-provider-session",
+				DurationSeconds: 900,
+				PolicyARNs:      []string{"arn:*:iam::*:policy/session-policy"},
+			},
+			TokenSecretRef: &infrav1.WebIdentityTokenSecretReference{Name: "web-identity-token"},
+		},
+	}
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			DefaultWebIdentityTokenSecretKey: []byte("jwt"),
+		},
+	}
+
+	stsMock := mock_stsiface.NewMockSTSClient(mockCtrl)
+	webIdentityProvider := NewAWSWebIdentityPrincipalTypeProvider(webIdentity, secret, "us-west-2", logger.NewLogger(klog.Background()))
+	webIdentityProvider.stsClient = stsMock
+
+	emptyTokenProvider := NewAWSWebIdentityPrincipalTypeProvider(webIdentity, &corev1.Secret{}, "us-west-2", logger.NewLogger(klog.Background()))
+	emptyTokenProvider.stsClient = stsMock
+
+	roleIdentity := &infrav1.AWSClusterRoleIdentity{
+		Spec: infrav1.AWSClusterRoleIdentitySpec{
+			AWSRoleSpec: infrav1.AWSRoleSpec{
+				RoleArn:         "arn:*:iam::*:role/aws-role/roleprovider",
+				SessionName:     "role-provider-session",
+				DurationSeconds: 900,
+			},
+		},
+	}
+	roleProvider := &AWSRolePrincipalTypeProvider{
+		Principal:      roleIdentity,
+		region:         "us-west-2",
+		sourceProvider: webIdentityProvider,
+		stsClient:      stsMock,
+	}
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		provider  AWSPrincipalTypeProvider
+		expect    func(m *mock_stsiface.MockSTSClientMockRecorder)
+		expectErr bool
+		value     aws.Credentials
+	}{
+		{
+			name:     "Web identity provider successfully retrieves",
+			provider: webIdentityProvider,
+			expect: func(m *mock_stsiface.MockSTSClientMockRecorder) {
+				m.AssumeRoleWithWebIdentity(gomock.Any(), &sts.AssumeRoleWithWebIdentityInput{
+					RoleArn:          aws.String(webIdentity.Spec.RoleArn),
+					RoleSessionName:  aws.String(webIdentity.Spec.SessionName),
+					DurationSeconds:  aws.Int32(webIdentity.Spec.DurationSeconds),
+					PolicyArns:       []ststypes.PolicyDescriptorType{{Arn: aws.String("arn:*:iam::*:policy/session-policy")}},
+					WebIdentityToken: aws.String("jwt"),
+				}, gomock.Any()).Return(&sts.AssumeRoleWithWebIdentityOutput{
+					Credentials: &ststypes.Credentials{
+						AccessKeyId:     aws.String("webIdentityAccessKeyId"),
+						SecretAccessKey: aws.String("webIdentitySecretAccessKey"),
+						SessionToken:    aws.String("webIdentitySessionToken"),
+						Expiration:      aws.Time(expiresAt),
+					},
+				}, nil)
+			},
+			value: aws.Credentials{
+				AccessKeyID:     "webIdentityAccessKeyId",
+				SecretAccessKey: "webIdentitySecretAccessKey",
+				SessionToken:    "webIdentitySessionToken",
+				Source:          "WebIdentityCredentials",
+				CanExpire:       true,
+				Expires:         expiresAt,
+			},
+		},
+		{
+			name:      "Web identity provider fails without a token",
+			provider:  emptyTokenProvider,
+			expect:    func(m *mock_stsiface.MockSTSClientMockRecorder) {},
+			expectErr: true,
+		},
+		{
+			name:     "Role provider with web identity provider source successfully retrieves",
+			provider: roleProvider,
+			expect: func(m *mock_stsiface.MockSTSClientMockRecorder) {
+				m.AssumeRole(gomock.Any(), &sts.AssumeRoleInput{
+					RoleArn:         aws.String(roleIdentity.Spec.RoleArn),
+					RoleSessionName: aws.String(roleIdentity.Spec.SessionName),
+					DurationSeconds: aws.Int32(roleIdentity.Spec.DurationSeconds),
+				}).Return(&sts.AssumeRoleOutput{
+					Credentials: &ststypes.Credentials{
+						AccessKeyId:     aws.String("assumedAccessKeyId"),
+						SecretAccessKey: aws.String("assumedSecretAccessKey"),
+						SessionToken:    aws.String("assumedSessionToken"),
+						Expiration:      aws.Time(expiresAt),
+					},
+				}, nil)
+			},
+			value: aws.Credentials{
+				AccessKeyID:     "assumedAccessKeyId",
+				SecretAccessKey: "assumedSecretAccessKey",
+				SessionToken:    "assumedSessionToken",
+				Source:          "AssumeRoleProvider",
+				CanExpire:       true,
+				Expires:         expiresAt,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			tc.expect(stsMock.EXPECT())
+			value, err := tc.provider.Retrieve(context.TODO())
+			if tc.expectErr {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+
+			g.Expect(err).To(BeNil())
+
+			if !cmp.Equal(tc.value, value) {
+				t.Fatalf("Did not get expected result: %s", cmp.Diff(tc.value, value))
+			}
+		})
+	}
+}
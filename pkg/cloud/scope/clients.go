@@ -18,13 +18,16 @@ package scope
 
 import (
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	elb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -335,6 +338,60 @@ func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logge
 	return s3.NewFromConfig(cfg, s3Opts...)
 }
 
+// NewKMSClient creates a new KMS API client for a given session.
+func NewKMSClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *kms.Client {
+	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	kmsEndpointResolver := &endpoints.KMSEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
+	kmsOpts := []func(*kms.Options){
+		func(o *kms.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = kmsEndpointResolver
+		},
+		kms.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+	}
+	return kms.NewFromConfig(cfg, kmsOpts...)
+}
+
+// NewRolesAnywhereClient creates a new IAM Roles Anywhere API client for a given session.
+func NewRolesAnywhereClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *rolesanywhere.Client {
+	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	rolesAnywhereEndpointResolver := &endpoints.RolesAnywhereEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
+	rolesAnywhereOpts := []func(*rolesanywhere.Options){
+		func(o *rolesanywhere.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = rolesAnywhereEndpointResolver
+		},
+		rolesanywhere.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+	}
+	return rolesanywhere.NewFromConfig(cfg, rolesAnywhereOpts...)
+}
+
+// NewCloudFormationClient creates a new CloudFormation API client for a given session.
+func NewCloudFormationClient(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *cloudformation.Client {
+	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	cloudFormationEndpointResolver := &endpoints.CloudFormationEndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
+	cloudFormationOpts := []func(*cloudformation.Options){
+		func(o *cloudformation.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = cloudFormationEndpointResolver
+		},
+		cloudformation.WithAPIOptions(awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target), awsmetrics.WithCAPAUserAgentMiddleware()),
+	}
+	return cloudformation.NewFromConfig(cfg, cloudFormationOpts...)
+}
+
 // AWSClients contains all the aws clients used by the scopes.
 type AWSClients struct {
 	ELB             *elb.Client
@@ -239,6 +239,59 @@ func (s *ClusterScope) Bucket() *infrav1.S3Bucket {
 	return s.AWSCluster.Spec.S3Bucket
 }
 
+// KMSKey returns the cluster KMS key configuration.
+func (s *ClusterScope) KMSKey() *infrav1.KMSKeySpec {
+	return s.AWSCluster.Spec.KMSKey
+}
+
+// KMSKeyARN returns the ARN of the cluster KMS key.
+func (s *ClusterScope) KMSKeyARN() string {
+	if s.AWSCluster.Status.KMSKey == nil {
+		return ""
+	}
+	return s.AWSCluster.Status.KMSKey.ARN
+}
+
+// SetKMSKeyStatus sets the cluster KMS key status.
+func (s *ClusterScope) SetKMSKeyStatus(status *infrav1.KMSKeyStatus) {
+	s.AWSCluster.Status.KMSKey = status
+}
+
+// RolesAnywhere returns the IAM Roles Anywhere configuration of the cluster.
+func (s *ClusterScope) RolesAnywhere() *infrav1.RolesAnywhereSpec {
+	return s.AWSCluster.Spec.RolesAnywhere
+}
+
+// RolesAnywhereStatus returns the IAM Roles Anywhere resources of the cluster.
+func (s *ClusterScope) RolesAnywhereStatus() *infrav1.RolesAnywhereStatus {
+	return s.AWSCluster.Status.RolesAnywhere
+}
+
+// SetRolesAnywhereStatus sets the IAM Roles Anywhere resources of the cluster.
+func (s *ClusterScope) SetRolesAnywhereStatus(status *infrav1.RolesAnywhereStatus) {
+	s.AWSCluster.Status.RolesAnywhere = status
+}
+
+// Karpenter returns the Karpenter configuration of the cluster.
+func (s *ClusterScope) Karpenter() *infrav1.KarpenterSpec {
+	return s.AWSCluster.Spec.Karpenter
+}
+
+// KarpenterStatus returns the Karpenter resources of the cluster.
+func (s *ClusterScope) KarpenterStatus() *infrav1.KarpenterStatus {
+	return s.AWSCluster.Status.Karpenter
+}
+
+// SetKarpenterStatus sets the Karpenter resources of the cluster.
+func (s *ClusterScope) SetKarpenterStatus(status *infrav1.KarpenterStatus) {
+	s.AWSCluster.Status.Karpenter = status
+}
+
+// KarpenterPodIdentity returns false, as EKS Pod Identity is not available on self-managed clusters.
+func (s *ClusterScope) KarpenterPodIdentity() bool {
+	return false
+}
+
 // ControlPlaneConfigMapName returns the name of the ConfigMap used to
 // coordinate the bootstrapping of control plane nodes.
 func (s *ClusterScope) ControlPlaneConfigMapName() string {
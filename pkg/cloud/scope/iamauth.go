@@ -31,4 +31,12 @@ type IAMAuthScope interface {
 	RemoteClient() (client.Client, error)
 	// IAMAuthConfig returns the IAM authenticator config
 	IAMAuthConfig() *ekscontrolplanev1.IAMAuthenticatorConfig
+	// ConfigMapMigration returns the migration of the aws-auth ConfigMap to access entries, if any.
+	ConfigMapMigration() *ekscontrolplanev1.ConfigMapMigration
+	// ConfigMapMigrationStatus returns the progress of the migration of the aws-auth ConfigMap to access entries.
+	ConfigMapMigrationStatus() *ekscontrolplanev1.ConfigMapMigrationStatus
+	// SetConfigMapMigrationStatus sets the progress of the migration of the aws-auth ConfigMap to access entries.
+	SetConfigMapMigrationStatus(status *ekscontrolplanev1.ConfigMapMigrationStatus)
+	// AccessEntries returns the access entries of the spec.
+	AccessEntries() []ekscontrolplanev1.AccessEntry
 }
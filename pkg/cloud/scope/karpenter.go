@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// KarpenterScope is the interface for the scope to be used with the Karpenter service.
+type KarpenterScope interface {
+	EC2Scope
+
+	// Karpenter returns the Karpenter configuration, nil if Karpenter is not used by the cluster.
+	Karpenter() *infrav1.KarpenterSpec
+
+	// KarpenterStatus returns the Karpenter resources of the cluster, nil until they have been created.
+	KarpenterStatus() *infrav1.KarpenterStatus
+
+	// SetKarpenterStatus sets the Karpenter resources of the cluster.
+	SetKarpenterStatus(status *infrav1.KarpenterStatus)
+
+	// KarpenterPodIdentity returns whether the Karpenter controller assumes its role through EKS Pod Identity.
+	KarpenterPodIdentity() bool
+
+	// SecurityGroupOverrides returns the security groups that are used as overrides in the cluster spec.
+	SecurityGroupOverrides() map[infrav1.SecurityGroupRole]string
+
+	// TagUnmanagedNetworkResources returns if the feature flag tag unmanaged network resources is set.
+	TagUnmanagedNetworkResources() bool
+}
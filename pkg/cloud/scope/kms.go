@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// KMSScope is the interface for the scope to be used with the KMS service.
+type KMSScope interface {
+	cloud.ClusterScoper
+
+	// KMSKey returns the cluster KMS key configuration, nil if the key isn't managed by the provider.
+	KMSKey() *infrav1.KMSKeySpec
+
+	// KMSKeyARN returns the ARN of the cluster KMS key, empty until the key has been created.
+	KMSKeyARN() string
+
+	// SetKMSKeyStatus sets the cluster KMS key status.
+	SetKMSKeyStatus(status *infrav1.KMSKeyStatus)
+}
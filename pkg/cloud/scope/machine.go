@@ -422,3 +422,16 @@ func (m *MachineScope) GetElasticIPPool() *infrav1.ElasticIPPool {
 	}
 	return m.AWSMachine.Spec.ElasticIPPool
 }
+
+// GetElasticIPClaim returns the Elastic IP claim for a machine, when exists.
+func (m *MachineScope) GetElasticIPClaim() *infrav1.ElasticIPClaim {
+	if m.AWSMachine == nil {
+		return nil
+	}
+	return m.AWSMachine.Spec.ElasticIPClaim
+}
+
+// SetElasticIPClaimStatus sets the Elastic IP claim slot held by the machine.
+func (m *MachineScope) SetElasticIPClaimStatus(status *infrav1.ElasticIPClaimStatus) {
+	m.AWSMachine.Status.ElasticIPClaim = status
+}
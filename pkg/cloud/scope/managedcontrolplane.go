@@ -352,6 +352,44 @@ func (s *ManagedControlPlaneScope) Bucket() *infrav1.S3Bucket {
 	return nil
 }
 
+// KMSKey returns the cluster KMS key configuration.
+func (s *ManagedControlPlaneScope) KMSKey() *infrav1.KMSKeySpec {
+	return s.ControlPlane.Spec.KMSKey
+}
+
+// KMSKeyARN returns the ARN of the cluster KMS key.
+func (s *ManagedControlPlaneScope) KMSKeyARN() string {
+	if s.ControlPlane.Status.KMSKey == nil {
+		return ""
+	}
+	return s.ControlPlane.Status.KMSKey.ARN
+}
+
+// SetKMSKeyStatus sets the cluster KMS key status.
+func (s *ManagedControlPlaneScope) SetKMSKeyStatus(status *infrav1.KMSKeyStatus) {
+	s.ControlPlane.Status.KMSKey = status
+}
+
+// Karpenter returns the Karpenter configuration of the cluster.
+func (s *ManagedControlPlaneScope) Karpenter() *infrav1.KarpenterSpec {
+	return s.ControlPlane.Spec.Karpenter
+}
+
+// KarpenterStatus returns the Karpenter resources of the cluster.
+func (s *ManagedControlPlaneScope) KarpenterStatus() *infrav1.KarpenterStatus {
+	return s.ControlPlane.Status.Karpenter
+}
+
+// SetKarpenterStatus sets the Karpenter resources of the cluster.
+func (s *ManagedControlPlaneScope) SetKarpenterStatus(status *infrav1.KarpenterStatus) {
+	s.ControlPlane.Status.Karpenter = status
+}
+
+// KarpenterPodIdentity returns true, as the Karpenter controller of EKS clusters assumes its role through EKS Pod Identity.
+func (s *ManagedControlPlaneScope) KarpenterPodIdentity() bool {
+	return true
+}
+
 // TagUnmanagedNetworkResources returns if the feature flag tag unmanaged network resources is set.
 func (s *ManagedControlPlaneScope) TagUnmanagedNetworkResources() bool {
 	return s.tagUnmanagedNetworkResources
@@ -421,6 +459,29 @@ func (s *ManagedControlPlaneScope) IAMAuthConfig() *ekscontrolplanev1.IAMAuthent
 	return s.ControlPlane.Spec.IAMAuthenticatorConfig
 }
 
+// ConfigMapMigration returns the migration of the aws-auth ConfigMap to access entries, if any.
+func (s *ManagedControlPlaneScope) ConfigMapMigration() *ekscontrolplanev1.ConfigMapMigration {
+	if s.ControlPlane.Spec.AccessConfig == nil {
+		return nil
+	}
+	return s.ControlPlane.Spec.AccessConfig.ConfigMapMigration
+}
+
+// ConfigMapMigrationStatus returns the progress of the migration of the aws-auth ConfigMap to access entries.
+func (s *ManagedControlPlaneScope) ConfigMapMigrationStatus() *ekscontrolplanev1.ConfigMapMigrationStatus {
+	return s.ControlPlane.Status.ConfigMapMigration
+}
+
+// SetConfigMapMigrationStatus sets the progress of the migration of the aws-auth ConfigMap to access entries.
+func (s *ManagedControlPlaneScope) SetConfigMapMigrationStatus(status *ekscontrolplanev1.ConfigMapMigrationStatus) {
+	s.ControlPlane.Status.ConfigMapMigration = status
+}
+
+// AccessEntries returns the access entries of the spec.
+func (s *ManagedControlPlaneScope) AccessEntries() []ekscontrolplanev1.AccessEntry {
+	return s.ControlPlane.Spec.AccessEntries
+}
+
 // Addons returns the list of addons for a EKS cluster.
 func (s *ManagedControlPlaneScope) Addons() []ekscontrolplanev1.Addon {
 	if s.ControlPlane.Spec.Addons == nil {
@@ -441,6 +502,10 @@ func (s *ManagedControlPlaneScope) DisableVPCCNI() bool {
 
 // BootstrapSelfManagedAddons returns whether the AWS EKS networking addons should be disabled.
 func (s *ManagedControlPlaneScope) BootstrapSelfManagedAddons() *bool {
+	// EKS Auto Mode manages the networking addons of the cluster.
+	if s.ControlPlane.Spec.AutoMode != nil && s.ControlPlane.Spec.AutoMode.Enabled {
+		return ptr.To(false)
+	}
 	return &s.ControlPlane.Spec.BootstrapSelfManagedAddons
 }
 
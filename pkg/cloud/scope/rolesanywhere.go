@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud"
+)
+
+// RolesAnywhereScope is the interface for the scope to be used with the IAM Roles Anywhere service.
+type RolesAnywhereScope interface {
+	cloud.ClusterScoper
+
+	// RolesAnywhere returns the IAM Roles Anywhere configuration, nil if not used by the cluster.
+	RolesAnywhere() *infrav1.RolesAnywhereSpec
+
+	// RolesAnywhereStatus returns the IAM Roles Anywhere resources of the cluster, nil until they have been created.
+	RolesAnywhereStatus() *infrav1.RolesAnywhereStatus
+
+	// SetRolesAnywhereStatus sets the IAM Roles Anywhere resources of the cluster.
+	SetRolesAnywhereStatus(status *infrav1.RolesAnywhereStatus)
+}
@@ -178,6 +178,11 @@ func (s *ROSAControlPlaneScope) CredentialsSecret() *corev1.Secret {
 	}
 }
 
+// OCMAccountRef returns the reference to the OCMAccount used to connect to OCM.
+func (s *ROSAControlPlaneScope) OCMAccountRef() *corev1.LocalObjectReference {
+	return s.ControlPlane.Spec.OCMAccountRef
+}
+
 // ClusterAdminPasswordSecret returns the corev1.Secret object for the cluster admin password.
 func (s *ROSAControlPlaneScope) ClusterAdminPasswordSecret() *corev1.Secret {
 	return s.secretWithOwnerReference(fmt.Sprintf("%s-admin-password", s.Cluster.Name))
@@ -191,6 +196,17 @@ func (s *ROSAControlPlaneScope) ExternalAuthBootstrapKubeconfigSecret() *corev1.
 	return s.secretWithOwnerReference(fmt.Sprintf("%s-bootstrap-kubeconfig", s.Cluster.Name))
 }
 
+// BreakGlassCredentialSecret returns the corev1.Secret object for the kubeconfig of the named break glass credential
+// of spec.breakGlassCredentials.
+func (s *ROSAControlPlaneScope) BreakGlassCredentialSecret(name string) *corev1.Secret {
+	breakGlassSecret := s.secretWithOwnerReference(fmt.Sprintf("%s-break-glass-%s", s.Cluster.Name, name))
+	breakGlassSecret.Labels = map[string]string{
+		clusterv1.ClusterNameLabel:                       s.Cluster.Name,
+		rosacontrolplanev1.BreakGlassCredentialNameLabel: name,
+	}
+	return breakGlassSecret
+}
+
 func (s *ROSAControlPlaneScope) secretWithOwnerReference(name string) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
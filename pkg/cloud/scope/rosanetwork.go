@@ -18,9 +18,11 @@ package scope
 
 import (
 	"context"
+	"time"
 
 	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -30,6 +32,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/throttle"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"
 )
 
@@ -50,6 +53,9 @@ type ROSANetworkScope struct {
 	ROSANetwork     *expinfrav1.ROSANetwork
 	serviceLimiters throttle.ServiceLimiters
 	session         awsv2.Config
+
+	// network holds the security groups looked up by the network service, which aren't persisted.
+	network infrav1.NetworkStatus
 }
 
 // NewROSANetworkScope creates a new NewROSANetworkScope from the supplied parameters.
@@ -132,5 +138,165 @@ func (s *ROSANetworkScope) PatchObject() error {
 		s.ROSANetwork,
 		v1beta1patch.WithOwnedConditions{Conditions: []clusterv1beta1.ConditionType{
 			expinfrav1.ROSANetworkReadyCondition,
+			expinfrav1.ROSANetworkStackAdoptedCondition,
+			infrav1.VpcReadyCondition,
+			infrav1.SecondaryCidrsReadyCondition,
+			infrav1.SubnetsReadyCondition,
+			infrav1.InternetGatewayReadyCondition,
+			infrav1.CarrierGatewayReadyCondition,
+			infrav1.EgressOnlyInternetGatewayReadyCondition,
+			infrav1.NatGatewaysReadyCondition,
+			infrav1.RouteTablesReadyCondition,
+			infrav1.VpcEndpointsReadyCondition,
 		}})
 }
+
+// The methods below implement NetworkScope, so that the network service reconciles the network infrastructure
+// of a ROSANetwork using the EC2 provisioner. The state of the network is kept in status.ec2.
+
+// Name returns the name of the network, used to name and tag the network resources.
+func (s *ROSANetworkScope) Name() string {
+	return s.ROSANetwork.Spec.StackName
+}
+
+// KubernetesClusterName returns the name of the network, as a ROSANetwork isn't tied to a cluster.
+func (s *ROSANetworkScope) KubernetesClusterName() string {
+	return s.Name()
+}
+
+// Region returns the region of the network.
+func (s *ROSANetworkScope) Region() string {
+	return s.ROSANetwork.Spec.Region
+}
+
+// ClusterObj returns nil, as a ROSANetwork isn't tied to a cluster. The network service reports the
+// reconciliation errors of the network as warnings, and records its events on the ROSANetwork.
+func (s *ROSANetworkScope) ClusterObj() *clusterv1.Cluster {
+	return nil
+}
+
+// UnstructuredControlPlane returns an error, as a ROSANetwork has no control plane.
+func (s *ROSANetworkScope) UnstructuredControlPlane() (*unstructured.Unstructured, error) {
+	return nil, errors.New("ROSANetwork has no control plane")
+}
+
+// ListOptionsLabelSelector returns a ListOptions with a label selector for the ROSANetwork name.
+func (s *ROSANetworkScope) ListOptionsLabelSelector() client.ListOption {
+	return client.MatchingLabels(map[string]string{
+		clusterv1.ClusterNameLabel: s.ROSANetwork.Name,
+	})
+}
+
+// APIServerPort returns 0, as a ROSANetwork has no API server.
+func (s *ROSANetworkScope) APIServerPort() int32 {
+	return 0
+}
+
+// AdditionalTags returns the tags applied to the network resources, which are the tags the ROSA
+// CloudFormation template applies to its resources, and the stack tags.
+func (s *ROSANetworkScope) AdditionalTags() infrav1.Tags {
+	tags := infrav1.Tags{
+		"rosa_managed_policies": "true",
+		"rosa_hcp_policies":     "true",
+		"service":               "ROSA",
+	}
+	for key, value := range s.ROSANetwork.Spec.StackTags {
+		tags[key] = value
+	}
+	return tags
+}
+
+// SetFailureDomain is a no-op, as a ROSANetwork has no failure domains.
+func (s *ROSANetworkScope) SetFailureDomain(_ string, _ clusterv1.FailureDomain) {}
+
+// Close persists the rosanetwork configuration and status.
+func (s *ROSANetworkScope) Close() error {
+	return s.PatchObject()
+}
+
+// MaxWaitDuration returns 0, as the network service doesn't wait on ROSANetwork resources.
+func (s *ROSANetworkScope) MaxWaitDuration() time.Duration {
+	return 0
+}
+
+func (s *ROSANetworkScope) ec2Status() *expinfrav1.ROSANetworkEC2Status {
+	if s.ROSANetwork.Status.EC2 == nil {
+		s.ROSANetwork.Status.EC2 = &expinfrav1.ROSANetworkEC2Status{}
+	}
+	return s.ROSANetwork.Status.EC2
+}
+
+// Network returns the network status.
+func (s *ROSANetworkScope) Network() *infrav1.NetworkStatus {
+	return &s.network
+}
+
+// VPC returns the VPC of the network.
+func (s *ROSANetworkScope) VPC() *infrav1.VPCSpec {
+	return &s.ec2Status().VPC
+}
+
+// Subnets returns the subnets of the network.
+func (s *ROSANetworkScope) Subnets() infrav1.Subnets {
+	return s.ec2Status().Subnets
+}
+
+// SetSubnets updates the subnets of the network.
+func (s *ROSANetworkScope) SetSubnets(subnets infrav1.Subnets) {
+	s.ec2Status().Subnets = subnets
+}
+
+// CNIIngressRules returns nil, as a ROSANetwork has no security groups for the CNI.
+func (s *ROSANetworkScope) CNIIngressRules() infrav1.CNIIngressRules {
+	return nil
+}
+
+// SecurityGroups returns the security groups of the network.
+func (s *ROSANetworkScope) SecurityGroups() map[infrav1.SecurityGroupRole]infrav1.SecurityGroup {
+	return s.network.SecurityGroups
+}
+
+// SecondaryCidrBlock returns nil, as a ROSANetwork has no dedicated CIDR block for pods.
+func (s *ROSANetworkScope) SecondaryCidrBlock() *string {
+	return nil
+}
+
+// SecondaryCidrBlocks returns the additional CIDR blocks of the VPC.
+func (s *ROSANetworkScope) SecondaryCidrBlocks() []infrav1.VpcCidrBlock {
+	blocks := make([]infrav1.VpcCidrBlock, 0, len(s.ROSANetwork.Spec.AdditionalCIDRBlocks))
+	for _, cidrBlock := range s.ROSANetwork.Spec.AdditionalCIDRBlocks {
+		blocks = append(blocks, infrav1.VpcCidrBlock{IPv4CidrBlock: cidrBlock})
+	}
+	return blocks
+}
+
+// AllSecondaryCidrBlocks returns the additional CIDR blocks of the VPC.
+func (s *ROSANetworkScope) AllSecondaryCidrBlocks() []infrav1.VpcCidrBlock {
+	return s.SecondaryCidrBlocks()
+}
+
+// Bastion returns an empty bastion, as a ROSANetwork has no bastion host.
+func (s *ROSANetworkScope) Bastion() *infrav1.Bastion {
+	return &infrav1.Bastion{}
+}
+
+// Bucket returns an empty bucket, so that the network service creates an S3 gateway endpoint
+// like the CloudFormation template does.
+func (s *ROSANetworkScope) Bucket() *infrav1.S3Bucket {
+	return &infrav1.S3Bucket{}
+}
+
+// TagUnmanagedNetworkResources returns false, as the network resources of a ROSANetwork are always managed.
+func (s *ROSANetworkScope) TagUnmanagedNetworkResources() bool {
+	return false
+}
+
+// SetNatGatewaysIPs sets the public IPs of the NAT gateways.
+func (s *ROSANetworkScope) SetNatGatewaysIPs(ips []string) {
+	s.ec2Status().NatGatewaysIPs = ips
+}
+
+// GetNatGatewaysIPs gets the public IPs of the NAT gateways.
+func (s *ROSANetworkScope) GetNatGatewaysIPs() []string {
+	return s.ec2Status().NatGatewaysIPs
+}
@@ -120,6 +120,11 @@ func (s *ROSAOCMRoleConfigScope) CredentialsSecret() *corev1.Secret {
 	}
 }
 
+// OCMAccountRef returns the reference to the OCMAccount used to connect to OCM.
+func (s *ROSAOCMRoleConfigScope) OCMAccountRef() *corev1.LocalObjectReference {
+	return s.ROSAOCMRoleConfig.Spec.OCMAccountRef
+}
+
 // GetClient returns the controller-runtime client.
 func (s *ROSAOCMRoleConfigScope) GetClient() client.Client {
 	return s.Client
@@ -158,6 +158,11 @@ func (s *RosaRoleConfigScope) CredentialsSecret() *corev1.Secret {
 	}
 }
 
+// OCMAccountRef returns the reference to the OCMAccount used to connect to OCM.
+func (s *RosaRoleConfigScope) OCMAccountRef() *corev1.LocalObjectReference {
+	return s.RosaRoleConfig.Spec.OCMAccountRef
+}
+
 // IAMClient returns the IAM client.
 func (s *RosaRoleConfigScope) IAMClient() *iam.Client {
 	return s.iamClient
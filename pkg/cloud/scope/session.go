@@ -255,6 +255,12 @@ func buildProvidersForRef(
 			return providers, err
 		}
 		providers = append(providers, provider)
+	case infrav1.ClusterWebIdentityKind:
+		provider, err := buildAWSClusterWebIdentity(ctx, identityObjectKey, k8sClient, clusterScoper, region, log)
+		if err != nil {
+			return providers, err
+		}
+		providers = append(providers, provider)
 	case infrav1.ClusterRoleIdentityKind:
 		roleIdentity := &infrav1.AWSClusterRoleIdentity{}
 		err := k8sClient.Get(ctx, identityObjectKey, roleIdentity)
@@ -352,6 +358,53 @@ func buildAWSClusterStaticIdentity(ctx context.Context, identityObjectKey client
 	return identity.NewAWSStaticPrincipalTypeProvider(staticPrincipal, secret), nil
 }
 
+func buildAWSClusterWebIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.SessionMetadata, region string, log logger.Wrapper) (*identity.AWSWebIdentityPrincipalTypeProvider, error) {
+	webIdentity := &infrav1.AWSClusterWebIdentity{}
+	err := k8sClient.Get(ctx, identityObjectKey, webIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	canUse, err := isClusterPermittedToUsePrincipal(k8sClient, webIdentity.Spec.AllowedNamespaces, clusterScoper.Namespace(), clusterScoper.InfraCluster())
+	if err != nil {
+		return nil, err
+	}
+	if !canUse {
+		setPrincipalUsageNotAllowedCondition(infrav1.ClusterWebIdentityKind, identityObjectKey, clusterScoper)
+		return nil, errors.Errorf(notPermittedError, infrav1.ClusterWebIdentityKind, identityObjectKey.Name)
+	}
+	setPrincipalUsageAllowedCondition(clusterScoper)
+
+	if webIdentity.Spec.TokenSecretRef == nil {
+		return identity.NewAWSWebIdentityPrincipalTypeProvider(webIdentity, nil, region, log), nil
+	}
+
+	secret := &corev1.Secret{}
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: webIdentity.Spec.TokenSecretRef.Name, Namespace: system.GetManagerNamespace()}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set ClusterWebIdentity as Secret's owner reference for 'clusterctl move'.
+	patchHelper, err := v1beta1patch.NewHelper(secret, k8sClient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to init patch helper for secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	secret.OwnerReferences = util.EnsureOwnerRef(secret.OwnerReferences, metav1.OwnerReference{
+		APIVersion: infrav1.GroupVersion.String(),
+		Kind:       string(infrav1.ClusterWebIdentityKind),
+		Name:       webIdentity.Name,
+		UID:        webIdentity.UID,
+	})
+
+	if err := patchHelper.Patch(ctx, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch secret name:%s namespace:%s", secret.Name, secret.Namespace)
+	}
+
+	return identity.NewAWSWebIdentityPrincipalTypeProvider(webIdentity, secret, region, log), nil
+}
+
 func buildAWSClusterControllerIdentity(ctx context.Context, identityObjectKey client.ObjectKey, k8sClient client.Client, clusterScoper cloud.SessionMetadata) error {
 	controllerIdentity := &infrav1.AWSClusterControllerIdentity{}
 	controllerIdentity.Kind = string(infrav1.ControllerIdentityKind)
@@ -416,6 +469,12 @@ func isClusterPermittedToUsePrincipal(k8sClient client.Client, allowedNs *infrav
 		return true, nil
 	}
 
+	return IsNamespacePermitted(k8sClient, allowedNs, clusterNamespace)
+}
+
+// IsNamespacePermitted returns whether the objects of a namespace are allowed to use an identity
+// restricted to allowedNs.
+func IsNamespacePermitted(k8sClient client.Client, allowedNs *infrav1.AllowedNamespaces, clusterNamespace string) (bool, error) {
 	// nil value does not match with any namespaces
 	if allowedNs == nil {
 		return false, nil
@@ -554,6 +554,192 @@ func TestPrincipalParsing(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Can get a session for a web identity Principal with a token secret",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster4",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenSecretRef: &infrav1.WebIdentityTokenSecretReference{Name: "web-identity-token"},
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				tokenSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "web-identity-token",
+						Namespace: system.GetManagerNamespace(),
+					},
+					Data: map[string][]byte{
+						"token": []byte("jwt"),
+					},
+				}
+				tokenSecret.SetGroupVersionKind(schema.GroupVersionKind{Group: "", Kind: "Secret", Version: "v1"})
+				err = c.Create(context.Background(), tokenSecret)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(providers []identity.AWSPrincipalTypeProvider) {
+				if len(providers) != 1 {
+					t.Fatalf("Expected 1 provider, got %v", len(providers))
+				}
+				p, ok := providers[0].(*identity.AWSWebIdentityPrincipalTypeProvider)
+				if !ok {
+					t.Fatal("Expected providers to be of type AWSWebIdentityPrincipalTypeProvider")
+				}
+				if string(p.Token) != "jwt" {
+					t.Fatalf("Expected Token to be '%s', got '%s'", "jwt", p.Token)
+				}
+			},
+		},
+		{
+			name: "Can chain a role Principal to a web identity Principal",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster5",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "role-identity",
+						Kind: infrav1.ClusterRoleIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				webIdentity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "web-identity-role-arn",
+						},
+						TokenFile: "/var/run/secrets/tokens/aws-token",
+					},
+				}
+				webIdentity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), webIdentity)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				roleIdentity := &infrav1.AWSClusterRoleIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "role-identity",
+					},
+					Spec: infrav1.AWSClusterRoleIdentitySpec{
+						AWSClusterIdentitySpec: infrav1.AWSClusterIdentitySpec{
+							AllowedNamespaces: &infrav1.AllowedNamespaces{},
+						},
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						SourceIdentityRef: &infrav1.AWSIdentityReference{
+							Name: "web-identity",
+							Kind: infrav1.ClusterWebIdentityKind,
+						},
+					},
+				}
+				roleIdentity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterRoleIdentity"))
+				err = c.Create(context.Background(), roleIdentity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expect: func(providers []identity.AWSPrincipalTypeProvider) {
+				if len(providers) != 1 {
+					t.Fatalf("Expected 1 provider, got %v", len(providers))
+				}
+				p, ok := providers[0].(*identity.AWSRolePrincipalTypeProvider)
+				if !ok {
+					t.Fatal("Expected providers to be of type AWSRolePrincipalTypeProvider")
+				}
+				if p.Principal.Spec.RoleArn != "role-arn" {
+					t.Fatal(errors.Errorf("Expected Role Provider ARN to be 'role-arn', got '%s'", p.Principal.Spec.RoleArn))
+				}
+			},
+		},
+		{
+			name: "Can't use a web identity Principal from a namespace not allowed",
+			awsCluster: infrav1.AWSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster6",
+					Namespace: "default",
+				},
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AWSCluster",
+				},
+				Spec: infrav1.AWSClusterSpec{
+					IdentityRef: &infrav1.AWSIdentityReference{
+						Name: "web-identity",
+						Kind: infrav1.ClusterWebIdentityKind,
+					},
+				},
+			},
+			setup: func(t *testing.T, c client.Client) {
+				t.Helper()
+
+				identity := &infrav1.AWSClusterWebIdentity{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "web-identity",
+					},
+					Spec: infrav1.AWSClusterWebIdentitySpec{
+						AWSRoleSpec: infrav1.AWSRoleSpec{
+							RoleArn: "role-arn",
+						},
+						TokenFile: "/var/run/secrets/tokens/aws-token",
+					},
+				}
+				identity.SetGroupVersionKind(infrav1.GroupVersion.WithKind("AWSClusterWebIdentity"))
+				err := c.Create(context.Background(), identity)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
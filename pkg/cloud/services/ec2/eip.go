@@ -3,12 +3,17 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 )
 
@@ -16,6 +21,10 @@ func getElasticIPRoleName(instanceID string) string {
 	return fmt.Sprintf("ec2-%s", instanceID)
 }
 
+func getElasticIPClaimRoleName(claim string) string {
+	return fmt.Sprintf("eip-claim-%s", claim)
+}
+
 // ReconcileElasticIPFromPublicPool reconciles the elastic IP from a custom Public IPv4 Pool.
 func (s *Service) ReconcileElasticIPFromPublicPool(pool *infrav1.ElasticIPPool, instance *infrav1.Instance) (bool, error) {
 	shouldRequeue := true
@@ -87,3 +96,185 @@ func (s *Service) getAndAssociateAddressesToInstance(pool *infrav1.ElasticIPPool
 	}
 	return nil
 }
+
+// ReconcileElasticIPClaim associates an instance with a slot of a named Elastic IP claim, allocating
+// the address of the slot when needed. The slots of a claim belong to the MachineDeployment of the
+// first machine allocating them, given as owner. It returns the slot held by the instance, or nil when
+// the instance is not running yet or all the slots of the claim are held by other instances.
+func (s *Service) ReconcileElasticIPClaim(claim *infrav1.ElasticIPClaim, pool *infrav1.ElasticIPPool, instance *infrav1.Instance, owner string) (*infrav1.ElasticIPClaimStatus, error) {
+	// Should not happen
+	if claim == nil {
+		return nil, fmt.Errorf("unexpected behavior, claim must be set when reconcile ElasticIPClaim")
+	}
+	if instance.State != infrav1.InstanceStateRunning {
+		s.scope.Debug("Unable to reconcile Elastic IP claim for instance", "instance-id", instance.ID, "instance-state", instance.State)
+		return nil, nil
+	}
+
+	role := getElasticIPClaimRoleName(claim.Name)
+	slots, err := s.getElasticIPClaimSlots(claim.Name, owner)
+	if err != nil {
+		return nil, err
+	}
+	for slot, addrs := range slots {
+		for _, addr := range addrs {
+			// Prevent running association every reconciliation when it is already done.
+			if aws.ToString(addr.InstanceId) == instance.ID {
+				return elasticIPClaimStatus(claim.Name, slot, addr), nil
+			}
+		}
+	}
+
+	for slot := int32(0); slot < max(claim.Slots, 1); slot++ {
+		addr, ok := elasticIPClaimSlotAddress(slots[slot])
+		if ok && addr.AssociationId != nil {
+			continue
+		}
+
+		// The instance claims the slot by tagging its address, or allocating it already tagged, before
+		// associating it, so that instances reconciled concurrently don't associate the same slot.
+		holderTags := infrav1.Tags{infrav1.NameAWSElasticIPClaimHolder: instance.ID}
+		if owner != "" {
+			holderTags[infrav1.NameAWSElasticIPClaimOwner] = owner
+		}
+		if !ok {
+			if _, err := s.netService.AllocateAddressForSlot(pool, role, slot, holderTags); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedAllocateEIP", "Failed to allocate Elastic IP for slot %d of claim %q: %v", slot, claim.Name, err)
+				return nil, err
+			}
+		} else if _, err := s.EC2Client.CreateTags(context.TODO(), &ec2.CreateTagsInput{
+			Resources: []string{aws.ToString(addr.AllocationId)},
+			Tags:      converters.MapToTags(holderTags),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to claim Elastic IP %q for instance %q: %w", aws.ToString(addr.AllocationId), instance.ID, err)
+		}
+
+		// Check the claim once tagged, as another instance may have claimed the slot concurrently.
+		addr, ok, err := s.checkElasticIPClaimSlot(claim.Name, owner, slot, instance.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			s.scope.Debug("Slot of the Elastic IP claim was claimed by another instance", "claim", claim.Name, "slot", slot, "instance-id", instance.ID)
+			continue
+		}
+
+		// Reassociation is not allowed, so an instance reconciled concurrently can't take over the slot.
+		if _, err := s.EC2Client.AssociateAddress(context.TODO(), &ec2.AssociateAddressInput{
+			InstanceId:         aws.String(instance.ID),
+			AllocationId:       addr.AllocationId,
+			AllowReassociation: aws.Bool(false),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedAssociateEIP", "Failed to associate Elastic IP for slot %d of claim %q: %v", slot, claim.Name, err)
+			return nil, fmt.Errorf("failed to associate Elastic IP %q to instance %q: %w", aws.ToString(addr.AllocationId), instance.ID, err)
+		}
+		s.scope.Info("Associated Elastic IP claim to instance", "claim", claim.Name, "slot", slot, "allocation-id", aws.ToString(addr.AllocationId), "instance-id", instance.ID)
+		return elasticIPClaimStatus(claim.Name, slot, addr), nil
+	}
+
+	s.scope.Info("All slots of the Elastic IP claim are held by other instances", "claim", claim.Name, "slots", max(claim.Slots, 1), "instance-id", instance.ID)
+	return nil, nil
+}
+
+// getElasticIPClaimSlots returns the addresses of the slots of an Elastic IP claim. It fails when the
+// slots belong to another MachineDeployment than owner.
+func (s *Service) getElasticIPClaimSlots(claim string, owner string) (map[int32][]types.Address, error) {
+	out, err := s.netService.GetAddresses(getElasticIPClaimRoleName(claim))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Elastic IPs of claim %q: %w", claim, err)
+	}
+
+	slots := map[int32][]types.Address{}
+	for _, addr := range out.Addresses {
+		slot, ok := elasticIPClaimSlot(addr)
+		if !ok {
+			continue
+		}
+		if addrOwner, ok := addressTag(addr, infrav1.NameAWSElasticIPClaimOwner); ok && addrOwner != owner {
+			return nil, fmt.Errorf("Elastic IP claim %q belongs to MachineDeployment %q", claim, addrOwner)
+		}
+		slots[slot] = append(slots[slot], addr)
+	}
+	return slots, nil
+}
+
+// checkElasticIPClaimSlot returns the address of a slot of an Elastic IP claim, and whether the slot is
+// claimed by the instance. The addresses allocated concurrently for the slot, which aren't claimed, are
+// released.
+func (s *Service) checkElasticIPClaimSlot(claim string, owner string, slot int32, instanceID string) (types.Address, bool, error) {
+	slots, err := s.getElasticIPClaimSlots(claim, owner)
+	if err != nil {
+		return types.Address{}, false, err
+	}
+
+	addr, ok := elasticIPClaimSlotAddress(slots[slot])
+	if !ok {
+		return types.Address{}, false, nil
+	}
+	for _, other := range slots[slot] {
+		if aws.ToString(other.AllocationId) == aws.ToString(addr.AllocationId) || other.AssociationId != nil {
+			continue
+		}
+		if err := s.netService.ReleaseAddress(other); err != nil {
+			return types.Address{}, false, fmt.Errorf("failed to release unclaimed Elastic IP of slot %d of claim %q: %w", slot, claim, err)
+		}
+	}
+
+	holder, _ := addressTag(addr, infrav1.NameAWSElasticIPClaimHolder)
+	return addr, addr.AssociationId == nil && holder == instanceID, nil
+}
+
+// ReleaseElasticIPClaim returns the Elastic IP held by an instance to its claim. The address
+// stays allocated to the cluster until the cluster is deleted.
+func (s *Service) ReleaseElasticIPClaim(claim *infrav1.ElasticIPClaim, instanceID string) error {
+	return s.netService.DisassociateInstanceAddresses(getElasticIPClaimRoleName(claim.Name), instanceID)
+}
+
+// elasticIPClaimSlot returns the claim slot an address is tagged with.
+func elasticIPClaimSlot(addr types.Address) (int32, bool) {
+	value, ok := addressTag(addr, infrav1.NameAWSElasticIPClaimSlot)
+	if !ok {
+		return 0, false
+	}
+	slot, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || slot < 0 {
+		return 0, false
+	}
+	return int32(slot), true
+}
+
+// elasticIPClaimSlotAddress returns the address of a slot among the addresses allocated for it. The
+// associated address is preferred, otherwise the first allocated one, so that all the instances agree
+// on the address of the slot when several are allocated concurrently.
+func elasticIPClaimSlotAddress(addrs []types.Address) (types.Address, bool) {
+	if len(addrs) == 0 {
+		return types.Address{}, false
+	}
+	for _, addr := range addrs {
+		if addr.AssociationId != nil {
+			return addr, true
+		}
+	}
+	return slices.MinFunc(addrs, func(a, b types.Address) int {
+		return strings.Compare(aws.ToString(a.AllocationId), aws.ToString(b.AllocationId))
+	}), true
+}
+
+// addressTag returns the value of a tag of an address.
+func addressTag(addr types.Address, key string) (string, bool) {
+	for _, tag := range addr.Tags {
+		if aws.ToString(tag.Key) == key {
+			return aws.ToString(tag.Value), true
+		}
+	}
+	return "", false
+}
+
+func elasticIPClaimStatus(claim string, slot int32, addr types.Address) *infrav1.ElasticIPClaimStatus {
+	return &infrav1.ElasticIPClaimStatus{
+		Name:         claim,
+		Slot:         slot,
+		AllocationID: aws.ToString(addr.AllocationId),
+		PublicIP:     aws.ToString(addr.PublicIp),
+	}
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+)
+
+func claimAddress(allocationID, publicIP string, slot string, instanceID *string) types.Address {
+	addr := types.Address{
+		AllocationId: aws.String(allocationID),
+		PublicIp:     aws.String(publicIP),
+		InstanceId:   instanceID,
+		Tags: []types.Tag{
+			{Key: aws.String(infrav1.NameAWSElasticIPClaimSlot), Value: aws.String(slot)},
+		},
+	}
+	if instanceID != nil {
+		addr.AssociationId = aws.String("eipassoc-" + allocationID)
+	}
+	return addr
+}
+
+func claimedAddress(allocationID, publicIP string, slot string, holder string) types.Address {
+	addr := claimAddress(allocationID, publicIP, slot, nil)
+	addr.Tags = append(addr.Tags,
+		types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimHolder), Value: aws.String(holder)},
+		types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimOwner), Value: aws.String("sftp-md")},
+	)
+	return addr
+}
+
+func TestReconcileElasticIPClaim(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	claim := &infrav1.ElasticIPClaim{Name: "sftp", Slots: 2}
+	describeAddresses := func(m *mocks.MockEC2APIMockRecorder, addrs ...types.Address) {
+		m.DescribeAddresses(gomock.Any(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{Addresses: addrs}, nil)
+	}
+	expectClaim := func(m *mocks.MockEC2APIMockRecorder, allocationID string) {
+		m.CreateTags(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, input *ec2.CreateTagsInput, _ ...interface{}) (*ec2.CreateTagsOutput, error) {
+			g := NewWithT(t)
+			g.Expect(input.Resources).To(Equal([]string{allocationID}))
+			g.Expect(input.Tags).To(ConsistOf(
+				types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimHolder), Value: aws.String("i-new")},
+				types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimOwner), Value: aws.String("sftp-md")},
+			))
+			return &ec2.CreateTagsOutput{}, nil
+		})
+	}
+
+	tests := []struct {
+		name       string
+		instance   *infrav1.Instance
+		setupMocks func(m *mocks.MockEC2APIMockRecorder)
+		expected   *infrav1.ElasticIPClaimStatus
+		expectErr  bool
+	}{
+		{
+			name:       "does nothing until the instance is running",
+			instance:   &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStatePending},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:     "reports the slot already held by the instance",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+					claimAddress("eipalloc-1", "1.1.1.2", "1", aws.String("i-new")),
+				)
+			},
+			expected: &infrav1.ElasticIPClaimStatus{Name: "sftp", Slot: 1, AllocationID: "eipalloc-1", PublicIP: "1.1.1.2"},
+		},
+		{
+			name:     "re-associates a slot released by a deleted machine",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+					claimAddress("eipalloc-1", "1.1.1.2", "1", nil),
+				)
+				expectClaim(m, "eipalloc-1")
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+					claimedAddress("eipalloc-1", "1.1.1.2", "1", "i-new"),
+				)
+				m.AssociateAddress(gomock.Any(), &ec2.AssociateAddressInput{
+					InstanceId:         aws.String("i-new"),
+					AllocationId:       aws.String("eipalloc-1"),
+					AllowReassociation: aws.Bool(false),
+				}).Return(&ec2.AssociateAddressOutput{}, nil)
+			},
+			expected: &infrav1.ElasticIPClaimStatus{Name: "sftp", Slot: 1, AllocationID: "eipalloc-1", PublicIP: "1.1.1.2"},
+		},
+		{
+			name:     "allocates the address of an unused slot",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+				)
+				m.AllocateAddress(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, input *ec2.AllocateAddressInput, _ ...interface{}) (*ec2.AllocateAddressOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.TagSpecifications).To(HaveLen(1))
+					g.Expect(input.TagSpecifications[0].Tags).To(ContainElements(
+						types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimSlot), Value: aws.String("1")},
+						types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimHolder), Value: aws.String("i-new")},
+						types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimOwner), Value: aws.String("sftp-md")},
+					))
+					return &ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("1.1.1.2")}, nil
+				})
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+					claimedAddress("eipalloc-1", "1.1.1.2", "1", "i-new"),
+				)
+				m.AssociateAddress(gomock.Any(), gomock.Any()).Return(&ec2.AssociateAddressOutput{}, nil)
+			},
+			expected: &infrav1.ElasticIPClaimStatus{Name: "sftp", Slot: 1, AllocationID: "eipalloc-1", PublicIP: "1.1.1.2"},
+		},
+		{
+			name:     "skips a slot claimed concurrently by another instance",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", nil),
+				)
+				expectClaim(m, "eipalloc-0")
+				describeAddresses(m,
+					claimedAddress("eipalloc-0", "1.1.1.1", "0", "i-other"),
+				)
+				m.AllocateAddress(gomock.Any(), gomock.Any()).Return(&ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("1.1.1.2")}, nil)
+				describeAddresses(m,
+					claimedAddress("eipalloc-0", "1.1.1.1", "0", "i-other"),
+					claimedAddress("eipalloc-1", "1.1.1.2", "1", "i-new"),
+				)
+				m.AssociateAddress(gomock.Any(), &ec2.AssociateAddressInput{
+					InstanceId:         aws.String("i-new"),
+					AllocationId:       aws.String("eipalloc-1"),
+					AllowReassociation: aws.Bool(false),
+				}).Return(&ec2.AssociateAddressOutput{}, nil)
+			},
+			expected: &infrav1.ElasticIPClaimStatus{Name: "sftp", Slot: 1, AllocationID: "eipalloc-1", PublicIP: "1.1.1.2"},
+		},
+		{
+			name:     "releases the address allocated concurrently for a slot",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+				)
+				m.AllocateAddress(gomock.Any(), gomock.Any()).Return(&ec2.AllocateAddressOutput{AllocationId: aws.String("eipalloc-2"), PublicIp: aws.String("1.1.1.3")}, nil)
+				// Another instance allocated an address for the same slot first, so it keeps the slot.
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+					claimedAddress("eipalloc-1", "1.1.1.2", "1", "i-another"),
+					claimedAddress("eipalloc-2", "1.1.1.3", "1", "i-new"),
+				)
+				m.ReleaseAddress(gomock.Any(), &ec2.ReleaseAddressInput{AllocationId: aws.String("eipalloc-2")}).Return(&ec2.ReleaseAddressOutput{}, nil)
+			},
+		},
+		{
+			name:     "waits when all slots are held by other instances",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+					claimAddress("eipalloc-1", "1.1.1.2", "1", aws.String("i-another")),
+				)
+			},
+		},
+		{
+			name:     "fails when the slots belong to another MachineDeployment",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				addr := claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other"))
+				addr.Tags = append(addr.Tags, types.Tag{Key: aws.String(infrav1.NameAWSElasticIPClaimOwner), Value: aws.String("other-md")})
+				describeAddresses(m, addr)
+			},
+			expectErr: true,
+		},
+		{
+			name:     "fails when the address is associated concurrently",
+			instance: &infrav1.Instance{ID: "i-new", State: infrav1.InstanceStateRunning},
+			setupMocks: func(m *mocks.MockEC2APIMockRecorder) {
+				describeAddresses(m,
+					claimAddress("eipalloc-0", "1.1.1.1", "0", nil),
+				)
+				expectClaim(m, "eipalloc-0")
+				describeAddresses(m,
+					claimedAddress("eipalloc-0", "1.1.1.1", "0", "i-new"),
+				)
+				m.AssociateAddress(gomock.Any(), gomock.Any()).Return(nil, errors.New("Resource.AlreadyAssociated"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.setupMocks(ec2Mock.EXPECT())
+
+			s := NewService(createTestClusterScope(t))
+			s.EC2Client = ec2Mock
+			s.netService.EC2Client = ec2Mock
+
+			status, err := s.ReconcileElasticIPClaim(claim, nil, tt.instance, "sftp-md")
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(status).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestReleaseElasticIPClaim(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	g := NewWithT(t)
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeAddresses(gomock.Any(), gomock.Any()).Return(&ec2.DescribeAddressesOutput{
+		Addresses: []types.Address{
+			claimAddress("eipalloc-0", "1.1.1.1", "0", aws.String("i-other")),
+			claimAddress("eipalloc-1", "1.1.1.2", "1", aws.String("i-deleted")),
+		},
+	}, nil)
+	// Only the address held by the deleted instance is disassociated, and none is released.
+	ec2Mock.EXPECT().DisassociateAddress(gomock.Any(), &ec2.DisassociateAddressInput{
+		AssociationId: aws.String("eipassoc-eipalloc-1"),
+	}).Return(&ec2.DisassociateAddressOutput{}, nil)
+
+	s := NewService(createTestClusterScope(t))
+	s.EC2Client = ec2Mock
+	s.netService.EC2Client = ec2Mock
+
+	g.Expect(s.ReleaseElasticIPClaim(&infrav1.ElasticIPClaim{Name: "sftp"}, "i-deleted")).To(Succeed())
+}
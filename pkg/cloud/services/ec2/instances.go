@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"math"
 	"net"
 	"slices"
 	"sort"
@@ -224,6 +225,12 @@ func (s *Service) CreateInstance(ctx context.Context, scope *scope.MachineScope,
 	}
 	input.SecurityGroupIDs = append(input.SecurityGroupIDs, ids...)
 
+	// Additional network interfaces default to the subnet and security groups of the primary network interface.
+	input.AdditionalNetworkInterfaces, err = s.resolveNetworkInterfaceSpecs(scope.AWSMachine.Spec.AdditionalNetworkInterfaces, input.SubnetID, input.SecurityGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// If SSHKeyName WAS NOT provided in the AWSMachine Spec, fallback to the value provided in the AWSCluster Spec.
 	// If a value was not provided in the AWSCluster Spec, then use the defaultSSHKeyName
 	// Note that:
@@ -634,6 +641,16 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		input.NetworkInterfaces[0].InterfaceType = aws.String(string(i.NetworkInterfaceType))
 	}
 
+	if len(i.AdditionalNetworkInterfaces) > 0 {
+		// EC2 rejects the public IP association parameter, even when false, when launching with multiple network interfaces.
+		for idx := range input.NetworkInterfaces {
+			input.NetworkInterfaces[idx].AssociatePublicIpAddress = nil
+		}
+		for _, ni := range i.AdditionalNetworkInterfaces {
+			input.NetworkInterfaces = append(input.NetworkInterfaces, networkInterfaceSpecToSDK(ni))
+		}
+	}
+
 	if i.IAMProfile != "" {
 		input.IamInstanceProfile = &types.IamInstanceProfileSpecification{
 			Name: aws.String(i.IAMProfile),
@@ -649,7 +666,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		i.RootVolume.DeviceName = aws.ToString(rootDeviceName)
-		blockDeviceMapping := volumeToBlockDeviceMapping(i.RootVolume)
+		blockDeviceMapping := volumeToBlockDeviceMapping(s.volumeWithDefaultEncryptionKey(i.RootVolume))
 		blockdeviceMappings = append(blockdeviceMappings, blockDeviceMapping)
 	}
 
@@ -660,7 +677,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 			return nil, errors.Errorf("non root volume should have device name specified")
 		}
 
-		blockDeviceMapping := volumeToBlockDeviceMapping(&nonRootVolume)
+		blockDeviceMapping := volumeToBlockDeviceMapping(s.volumeWithDefaultEncryptionKey(&nonRootVolume))
 		blockdeviceMappings = append(blockdeviceMappings, blockDeviceMapping)
 	}
 
@@ -671,7 +688,8 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	if len(i.Tags) > 0 {
 		resources := []types.ResourceType{types.ResourceTypeInstance, types.ResourceTypeVolume}
 
-		if len(i.NetworkInterfaces) == 0 {
+		// The network interfaces created at launch are tagged, which excludes the existing ones attached by ID.
+		if len(i.NetworkInterfaces) == 0 || len(i.AdditionalNetworkInterfaces) > 0 {
 			resources = append(resources, types.ResourceTypeNetworkInterface)
 		}
 
@@ -765,6 +783,19 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	return s.SDKToInstance(out.Instances[0])
 }
 
+// volumeWithDefaultEncryptionKey returns the volume encrypted with the cluster KMS key, if the cluster has one and
+// the volume neither specifies another key nor explicitly disables encryption.
+func (s *Service) volumeWithDefaultEncryptionKey(v *infrav1.Volume) *infrav1.Volume {
+	kmsScope, ok := s.scope.(scope.KMSScope)
+	if !ok || kmsScope.KMSKeyARN() == "" || v.EncryptionKey != "" || (v.Encrypted != nil && !*v.Encrypted) {
+		return v
+	}
+
+	out := v.DeepCopy()
+	out.EncryptionKey = kmsScope.KMSKeyARN()
+	return out
+}
+
 func volumeToBlockDeviceMapping(v *infrav1.Volume) types.BlockDeviceMapping {
 	ebsDevice := &types.EbsBlockDevice{
 		DeleteOnTermination: aws.Bool(true),
@@ -1038,7 +1069,15 @@ func (s *Service) getInstanceAddresses(instance types.Instance) []clusterv1beta1
 	addresses := []clusterv1beta1.MachineAddress{}
 	// Check if the DHCP Option Set has domain name set
 	domainName := s.GetDHCPOptionSetDomainName(s.EC2Client, instance.VpcId)
-	for _, eni := range instance.NetworkInterfaces {
+
+	// Report addresses in device index order, so that the primary network interface
+	// addresses always come first when additional network interfaces are attached.
+	enis := slices.Clone(instance.NetworkInterfaces)
+	sort.SliceStable(enis, func(i, j int) bool {
+		return networkInterfaceDeviceIndex(enis[i]) < networkInterfaceDeviceIndex(enis[j])
+	})
+
+	for _, eni := range enis {
 		if addr := aws.ToString(eni.PrivateDnsName); addr != "" {
 			privateDNSAddress := clusterv1beta1.MachineAddress{
 				Type:    clusterv1beta1.MachineInternalDNS,
@@ -1101,6 +1140,15 @@ func (s *Service) getInstanceAddresses(instance types.Instance) []clusterv1beta1
 	return addresses
 }
 
+// networkInterfaceDeviceIndex returns the device index of an instance network interface,
+// sorting interfaces without attachment information last.
+func networkInterfaceDeviceIndex(eni types.InstanceNetworkInterface) int32 {
+	if eni.Attachment == nil || eni.Attachment.DeviceIndex == nil {
+		return math.MaxInt32
+	}
+	return *eni.Attachment.DeviceIndex
+}
+
 func (s *Service) getNetworkInterfaceSecurityGroups(interfaceID string) ([]string, error) {
 	input := &ec2.DescribeNetworkInterfaceAttributeInput{
 		Attribute:          types.NetworkInterfaceAttributeGroupSet,
@@ -6942,3 +6942,56 @@ func TestGetInstanceCPUOptionsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestVolumeWithDefaultEncryptionKey(t *testing.T) {
+	const clusterKeyARN = "arn:aws:kms:us-west-2:123456789012:key/cluster-key"
+
+	testCases := []struct {
+		name          string
+		clusterKey    *infrav1.KMSKeyStatus
+		volume        *infrav1.Volume
+		expectedKeyID string
+	}{
+		{
+			name:   "without a cluster KMS key",
+			volume: &infrav1.Volume{Size: 8},
+		},
+		{
+			name:          "with a cluster KMS key",
+			clusterKey:    &infrav1.KMSKeyStatus{ARN: clusterKeyARN},
+			volume:        &infrav1.Volume{Size: 8},
+			expectedKeyID: clusterKeyARN,
+		},
+		{
+			name:          "with a key specified on the volume",
+			clusterKey:    &infrav1.KMSKeyStatus{ARN: clusterKeyARN},
+			volume:        &infrav1.Volume{Size: 8, EncryptionKey: "volume-key"},
+			expectedKeyID: "volume-key",
+		},
+		{
+			name:       "with encryption disabled on the volume",
+			clusterKey: &infrav1.KMSKeyStatus{ARN: clusterKeyARN},
+			volume:     &infrav1.Volume{Size: 8, Encrypted: aws.Bool(false)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:  fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}},
+				AWSCluster: &infrav1.AWSCluster{
+					Status: infrav1.AWSClusterStatus{KMSKey: tc.clusterKey},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			volume := NewService(clusterScope).volumeWithDefaultEncryptionKey(tc.volume)
+			g.Expect(volume.EncryptionKey).To(Equal(tc.expectedKeyID))
+			g.Expect(aws.ToString(volumeToBlockDeviceMapping(volume).Ebs.KmsKeyId)).To(Equal(tc.expectedKeyID))
+		})
+	}
+}
@@ -670,6 +670,28 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	}
 	data.SecurityGroupIds = append(data.SecurityGroupIds, securityGroupIDs...)
 
+	if len(lt.AdditionalNetworkInterfaces) > 0 {
+		additionalNetworkInterfaces, err := s.resolveNetworkInterfaceSpecs(lt.AdditionalNetworkInterfaces, "", data.SecurityGroupIds)
+		if err != nil {
+			return nil, err
+		}
+
+		// When network interfaces are specified, security groups must be set on the network interfaces
+		// instead of on the instance. The subnet of the primary network interface is chosen by the
+		// Auto Scaling group.
+		data.NetworkInterfaces = []types.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+			{
+				DeviceIndex:         aws.Int32(0),
+				Groups:              data.SecurityGroupIds,
+				DeleteOnTermination: aws.Bool(true),
+			},
+		}
+		for _, ni := range additionalNetworkInterfaces {
+			data.NetworkInterfaces = append(data.NetworkInterfaces, networkInterfaceSpecToLaunchTemplateRequest(ni))
+		}
+		data.SecurityGroupIds = nil
+	}
+
 	// set the AMI ID
 	data.ImageId = imageID
 
@@ -692,14 +714,14 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 
 		lt.RootVolume.DeviceName = aws.ToString(rootDeviceName)
 
-		req := volumeToLaunchTemplateBlockDeviceMappingRequest(lt.RootVolume)
+		req := volumeToLaunchTemplateBlockDeviceMappingRequest(s.volumeWithDefaultEncryptionKey(lt.RootVolume))
 		blockDeviceMappings = append(blockDeviceMappings, *req)
 	}
 
 	for vi := range lt.NonRootVolumes {
 		nonRootVolume := lt.NonRootVolumes[vi]
 
-		blockDeviceMapping := volumeToLaunchTemplateBlockDeviceMappingRequest(&nonRootVolume)
+		blockDeviceMapping := volumeToLaunchTemplateBlockDeviceMappingRequest(s.volumeWithDefaultEncryptionKey(&nonRootVolume))
 		blockDeviceMappings = append(blockDeviceMappings, *blockDeviceMapping)
 	}
 
@@ -970,13 +992,22 @@ func (s *Service) SDKToLaunchTemplate(d types.LaunchTemplateVersion) (*expinfrav
 		}
 	}
 
-	for _, id := range v.SecurityGroupIds {
+	securityGroupIDs := v.SecurityGroupIds
+	for _, ni := range v.NetworkInterfaces {
+		// Security groups are set on the primary network interface when additional network interfaces are used.
+		if len(securityGroupIDs) == 0 && aws.ToInt32(ni.DeviceIndex) == 0 {
+			securityGroupIDs = ni.Groups
+		}
+	}
+	for _, id := range securityGroupIDs {
 		// FIXME(dlipovetsky): This will include the core security groups as well, making the
 		// "Additional" a bit dishonest. However, including the core groups drastically simplifies
 		// comparison with the incoming security groups.
 		i.AdditionalSecurityGroups = append(i.AdditionalSecurityGroups, infrav1.AWSResourceReference{ID: aws.String(id)})
 	}
 
+	i.AdditionalNetworkInterfaces = SDKToNetworkInterfaceSpecs(v.NetworkInterfaces)
+
 	if v.UserData == nil {
 		return i, userdata.ComputeHash(nil), nil, nil, nil
 	}
@@ -1069,6 +1100,14 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 		return true, services.LaunchTemplateNeedsUpdateReasonAdditionalSecurityGroupIDs, nil
 	}
 
+	incomingNetworkInterfaces, err := s.resolveNetworkInterfaceSpecs(incoming.AdditionalNetworkInterfaces, "", incomingIDs)
+	if err != nil {
+		return false, services.LaunchTemplateNeedsUpdateReasonNone, err
+	}
+	if !networkInterfaceSpecsEqual(incomingNetworkInterfaces, existing.AdditionalNetworkInterfaces) {
+		return true, services.LaunchTemplateNeedsUpdateReasonAdditionalNetworkInterfaces, nil
+	}
+
 	return false, services.LaunchTemplateNeedsUpdateReasonNone, nil
 }
 
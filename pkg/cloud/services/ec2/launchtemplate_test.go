@@ -224,6 +224,9 @@ func TestGetLaunchTemplate(t *testing.T) {
 					SSHKeyName:               aws.String("foo-keyname"),
 					VersionNumber:            aws.Int64(1),
 					AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-id")}},
+					AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+						{DeviceIndex: 1, SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}}},
+					},
 				}
 
 				g.Expect(err).NotTo(HaveOccurred())
@@ -282,6 +285,9 @@ func TestGetLaunchTemplate(t *testing.T) {
 					SSHKeyName:               aws.String("foo-keyname"),
 					VersionNumber:            aws.Int64(1),
 					AdditionalSecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-id")}},
+					AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+						{DeviceIndex: 1, SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}}},
+					},
 				}
 
 				g.Expect(err).NotTo(HaveOccurred())
@@ -364,6 +370,9 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 				IamInstanceProfile: "foo-profile",
 				SSHKeyName:         aws.String("foo-keyname"),
 				VersionNumber:      aws.Int64(1),
+				AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 1, SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}}},
+				},
 			},
 			wantUserDataHash:      testUserDataHash,
 			wantDataSecretKey:     nil, // respective tag is not given
@@ -556,6 +565,9 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 				IamInstanceProfile: "foo-profile",
 				SSHKeyName:         aws.String("foo-keyname"),
 				VersionNumber:      aws.Int64(1),
+				AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+					{DeviceIndex: 1, SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("foo-group")}}},
+				},
 			},
 			wantUserDataHash:      testUserDataHash,
 			wantDataSecretKey:     &types.NamespacedName{Namespace: "bootstrap-secret-ns", Name: "bootstrap-secret"},
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+)
+
+// resolveNetworkInterfaceSpecs returns a copy of the given network interfaces where subnet and
+// security group references have been resolved to IDs. Network interfaces without a subnet get
+// defaultSubnetID, which may be empty to let AWS pick the subnet of the primary network interface.
+// Network interfaces without security groups get defaultSecurityGroupIDs.
+func (s *Service) resolveNetworkInterfaceSpecs(specs []infrav1.NetworkInterfaceSpec, defaultSubnetID string, defaultSecurityGroupIDs []string) ([]infrav1.NetworkInterfaceSpec, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	resolved := make([]infrav1.NetworkInterfaceSpec, 0, len(specs))
+	for _, spec := range specs {
+		out := *spec.DeepCopy()
+
+		subnetID := defaultSubnetID
+		if spec.Subnet != nil && (spec.Subnet.ID != nil || spec.Subnet.Filters != nil) {
+			id, err := s.resolveNetworkInterfaceSubnet(spec.Subnet)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve subnet for network interface with device index %d", spec.DeviceIndex)
+			}
+			subnetID = id
+		}
+		out.Subnet = nil
+		if subnetID != "" {
+			out.Subnet = &infrav1.AWSResourceReference{ID: aws.String(subnetID)}
+		}
+
+		groupIDs := defaultSecurityGroupIDs
+		if len(spec.SecurityGroups) > 0 {
+			ids, err := s.GetAdditionalSecurityGroupsIDs(spec.SecurityGroups)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to resolve security groups for network interface with device index %d", spec.DeviceIndex)
+			}
+			groupIDs = ids
+		}
+		out.SecurityGroups = nil
+		for _, id := range groupIDs {
+			out.SecurityGroups = append(out.SecurityGroups, infrav1.AWSResourceReference{ID: aws.String(id)})
+		}
+
+		resolved = append(resolved, out)
+	}
+
+	sort.SliceStable(resolved, func(i, j int) bool { return resolved[i].DeviceIndex < resolved[j].DeviceIndex })
+	return resolved, nil
+}
+
+// resolveNetworkInterfaceSubnet returns the ID of the subnet matching the given reference.
+func (s *Service) resolveNetworkInterfaceSubnet(ref *infrav1.AWSResourceReference) (string, error) {
+	criteria := []types.Filter{
+		filter.EC2.SubnetStates(types.SubnetStatePending, types.SubnetStateAvailable),
+	}
+	if ref.ID != nil {
+		criteria = append(criteria, types.Filter{Name: aws.String("subnet-id"), Values: []string{*ref.ID}})
+	}
+	for _, f := range ref.Filters {
+		criteria = append(criteria, types.Filter{Name: aws.String(f.Name), Values: f.Values})
+	}
+
+	subnets, err := s.getFilteredSubnets(criteria...)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to filter subnets for criteria %v", criteria)
+	}
+	if len(subnets) == 0 {
+		return "", awserrors.NewFailedDependency(fmt.Sprintf("no subnets available matching criteria %v", criteria))
+	}
+	return aws.ToString(subnets[0].SubnetId), nil
+}
+
+// networkInterfaceSecurityGroupIDs returns the IDs of a resolved network interface's security groups.
+func networkInterfaceSecurityGroupIDs(spec infrav1.NetworkInterfaceSpec) []string {
+	ids := make([]string, 0, len(spec.SecurityGroups))
+	for _, sg := range spec.SecurityGroups {
+		if sg.ID != nil {
+			ids = append(ids, *sg.ID)
+		}
+	}
+	return ids
+}
+
+// networkInterfaceSpecToSDK converts a resolved network interface into a RunInstances network interface specification.
+// Network interfaces created on launch are always deleted together with the instance.
+func networkInterfaceSpecToSDK(spec infrav1.NetworkInterfaceSpec) types.InstanceNetworkInterfaceSpecification {
+	out := types.InstanceNetworkInterfaceSpecification{
+		DeviceIndex:                    aws.Int32(spec.DeviceIndex),
+		Groups:                         networkInterfaceSecurityGroupIDs(spec),
+		SecondaryPrivateIpAddressCount: spec.SecondaryPrivateIPAddressCount,
+		Ipv4PrefixCount:                spec.IPv4PrefixCount,
+		Ipv6PrefixCount:                spec.IPv6PrefixCount,
+		DeleteOnTermination:            aws.Bool(true),
+	}
+	if spec.Subnet != nil {
+		out.SubnetId = spec.Subnet.ID
+	}
+	if spec.Description != "" {
+		out.Description = aws.String(spec.Description)
+	}
+	if spec.NetworkInterfaceType != "" {
+		out.InterfaceType = aws.String(string(spec.NetworkInterfaceType))
+	}
+	return out
+}
+
+// networkInterfaceSpecToLaunchTemplateRequest converts a resolved network interface into a launch template network interface specification.
+func networkInterfaceSpecToLaunchTemplateRequest(spec infrav1.NetworkInterfaceSpec) types.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	out := types.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+		DeviceIndex:                    aws.Int32(spec.DeviceIndex),
+		Groups:                         networkInterfaceSecurityGroupIDs(spec),
+		SecondaryPrivateIpAddressCount: spec.SecondaryPrivateIPAddressCount,
+		Ipv4PrefixCount:                spec.IPv4PrefixCount,
+		Ipv6PrefixCount:                spec.IPv6PrefixCount,
+		DeleteOnTermination:            aws.Bool(true),
+	}
+	if spec.Subnet != nil {
+		out.SubnetId = spec.Subnet.ID
+	}
+	if spec.Description != "" {
+		out.Description = aws.String(spec.Description)
+	}
+	if spec.NetworkInterfaceType != "" {
+		out.InterfaceType = aws.String(string(spec.NetworkInterfaceType))
+	}
+	return out
+}
+
+// SDKToNetworkInterfaceSpecs converts the additional network interfaces of a launch template,
+// that is all but the one at device index 0, to the CAPA network interface type.
+func SDKToNetworkInterfaceSpecs(interfaces []types.LaunchTemplateInstanceNetworkInterfaceSpecification) []infrav1.NetworkInterfaceSpec {
+	var specs []infrav1.NetworkInterfaceSpec
+	for _, ni := range interfaces {
+		if aws.ToInt32(ni.DeviceIndex) == 0 {
+			continue
+		}
+		spec := infrav1.NetworkInterfaceSpec{
+			DeviceIndex:                    aws.ToInt32(ni.DeviceIndex),
+			Description:                    aws.ToString(ni.Description),
+			SecondaryPrivateIPAddressCount: ni.SecondaryPrivateIpAddressCount,
+			IPv4PrefixCount:                ni.Ipv4PrefixCount,
+			IPv6PrefixCount:                ni.Ipv6PrefixCount,
+			NetworkInterfaceType:           infrav1.NetworkInterfaceType(aws.ToString(ni.InterfaceType)),
+		}
+		if ni.SubnetId != nil {
+			spec.Subnet = &infrav1.AWSResourceReference{ID: ni.SubnetId}
+		}
+		for _, id := range ni.Groups {
+			spec.SecurityGroups = append(spec.SecurityGroups, infrav1.AWSResourceReference{ID: aws.String(id)})
+		}
+		specs = append(specs, spec)
+	}
+	sort.SliceStable(specs, func(i, j int) bool { return specs[i].DeviceIndex < specs[j].DeviceIndex })
+	return specs
+}
+
+// networkInterfaceSpecsEqual compares two lists of resolved network interfaces, ignoring the order of security groups.
+func networkInterfaceSpecsEqual(a, b []infrav1.NetworkInterfaceSpec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		x, y := a[i].DeepCopy(), b[i].DeepCopy()
+		xIDs, yIDs := networkInterfaceSecurityGroupIDs(*x), networkInterfaceSecurityGroupIDs(*y)
+		sort.Strings(xIDs)
+		sort.Strings(yIDs)
+		x.SecurityGroups, y.SecurityGroups = nil, nil
+		if !cmp.Equal(x, y) || !cmp.Equal(xIDs, yIDs) {
+			return false
+		}
+	}
+	return true
+}
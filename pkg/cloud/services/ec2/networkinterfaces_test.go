@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+)
+
+func TestResolveNetworkInterfaceSpecs(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name       string
+		specs      []infrav1.NetworkInterfaceSpec
+		setupMocks func(m *mocks.MockEC2API)
+		expected   []infrav1.NetworkInterfaceSpec
+		expectErr  bool
+	}{
+		{
+			name: "defaults subnet and security groups to the primary network interface",
+			specs: []infrav1.NetworkInterfaceSpec{
+				{DeviceIndex: 2},
+				{DeviceIndex: 1, Description: "storage"},
+			},
+			setupMocks: func(m *mocks.MockEC2API) {},
+			expected: []infrav1.NetworkInterfaceSpec{
+				{
+					DeviceIndex:    1,
+					Description:    "storage",
+					Subnet:         &infrav1.AWSResourceReference{ID: aws.String("subnet-primary")},
+					SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-core")}},
+				},
+				{
+					DeviceIndex:    2,
+					Subnet:         &infrav1.AWSResourceReference{ID: aws.String("subnet-primary")},
+					SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-core")}},
+				},
+			},
+		},
+		{
+			name: "resolves subnet and security group filters",
+			specs: []infrav1.NetworkInterfaceSpec{
+				{
+					DeviceIndex:    1,
+					Subnet:         &infrav1.AWSResourceReference{Filters: []infrav1.Filter{{Name: "tag:Name", Values: []string{"storage"}}}},
+					SecurityGroups: []infrav1.AWSResourceReference{{Filters: []infrav1.Filter{{Name: "tag:Name", Values: []string{"storage-sg"}}}}},
+				},
+			},
+			setupMocks: func(m *mocks.MockEC2API) {
+				m.EXPECT().DescribeSubnets(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []types.Subnet{{SubnetId: aws.String("subnet-storage")}},
+				}, nil)
+				m.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []types.SecurityGroup{{GroupId: aws.String("sg-storage")}},
+				}, nil)
+			},
+			expected: []infrav1.NetworkInterfaceSpec{
+				{
+					DeviceIndex:    1,
+					Subnet:         &infrav1.AWSResourceReference{ID: aws.String("subnet-storage")},
+					SecurityGroups: []infrav1.AWSResourceReference{{ID: aws.String("sg-storage")}},
+				},
+			},
+		},
+		{
+			name: "fails when no subnet matches",
+			specs: []infrav1.NetworkInterfaceSpec{
+				{DeviceIndex: 1, Subnet: &infrav1.AWSResourceReference{ID: aws.String("subnet-missing")}},
+			},
+			setupMocks: func(m *mocks.MockEC2API) {
+				m.EXPECT().DescribeSubnets(gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{}, nil)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tt.setupMocks(ec2Mock)
+
+			s := NewService(createTestClusterScope(t))
+			s.EC2Client = ec2Mock
+
+			resolved, err := s.resolveNetworkInterfaceSpecs(tt.specs, "subnet-primary", []string{"sg-core"})
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(resolved).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestNetworkInterfaceSpecToSDK(t *testing.T) {
+	g := NewWithT(t)
+
+	spec := infrav1.NetworkInterfaceSpec{
+		DeviceIndex:                    1,
+		Description:                    "storage",
+		Subnet:                         &infrav1.AWSResourceReference{ID: aws.String("subnet-storage")},
+		SecurityGroups:                 []infrav1.AWSResourceReference{{ID: aws.String("sg-storage")}},
+		SecondaryPrivateIPAddressCount: aws.Int32(2),
+		IPv4PrefixCount:                aws.Int32(1),
+		NetworkInterfaceType:           infrav1.NetworkInterfaceTypeEFAWithENAInterface,
+	}
+
+	g.Expect(networkInterfaceSpecToSDK(spec)).To(Equal(types.InstanceNetworkInterfaceSpecification{
+		DeviceIndex:                    aws.Int32(1),
+		Description:                    aws.String("storage"),
+		SubnetId:                       aws.String("subnet-storage"),
+		Groups:                         []string{"sg-storage"},
+		SecondaryPrivateIpAddressCount: aws.Int32(2),
+		Ipv4PrefixCount:                aws.Int32(1),
+		InterfaceType:                  aws.String("efa"),
+		DeleteOnTermination:            aws.Bool(true),
+	}))
+
+	// A launch template round trip must not report a difference.
+	request := networkInterfaceSpecToLaunchTemplateRequest(spec)
+	existing := SDKToNetworkInterfaceSpecs([]types.LaunchTemplateInstanceNetworkInterfaceSpecification{
+		{DeviceIndex: aws.Int32(0), Groups: []string{"sg-core"}},
+		{
+			DeviceIndex:                    request.DeviceIndex,
+			Description:                    request.Description,
+			SubnetId:                       request.SubnetId,
+			Groups:                         request.Groups,
+			SecondaryPrivateIpAddressCount: request.SecondaryPrivateIpAddressCount,
+			Ipv4PrefixCount:                request.Ipv4PrefixCount,
+			InterfaceType:                  request.InterfaceType,
+			DeleteOnTermination:            request.DeleteOnTermination,
+		},
+	})
+	g.Expect(networkInterfaceSpecsEqual([]infrav1.NetworkInterfaceSpec{spec}, existing)).To(BeTrue())
+}
+
+func TestGetInstanceAddressesOrdersByDeviceIndex(t *testing.T) {
+	g := NewWithT(t)
+
+	s := NewService(createTestClusterScope(t))
+	instance := types.Instance{
+		NetworkInterfaces: []types.InstanceNetworkInterface{
+			{
+				Attachment:       &types.InstanceNetworkInterfaceAttachment{DeviceIndex: aws.Int32(1)},
+				PrivateIpAddress: aws.String("10.0.1.10"),
+			},
+			{
+				Attachment:       &types.InstanceNetworkInterfaceAttachment{DeviceIndex: aws.Int32(0)},
+				PrivateIpAddress: aws.String("10.0.0.10"),
+			},
+		},
+	}
+
+	g.Expect(s.getInstanceAddresses(instance)).To(Equal([]clusterv1beta1.MachineAddress{
+		{Type: clusterv1beta1.MachineInternalIP, Address: "10.0.0.10"},
+		{Type: clusterv1beta1.MachineInternalIP, Address: "10.0.1.10"},
+	}))
+}
+
+func TestRunInstanceTagsCreatedNetworkInterfaces(t *testing.T) {
+	tests := []struct {
+		name                        string
+		networkInterfaces           []string
+		additionalNetworkInterfaces []infrav1.NetworkInterfaceSpec
+		expectNetworkInterfaceTags  bool
+	}{
+		{
+			name:                       "tags the primary network interface created at launch",
+			expectNetworkInterfaceTags: true,
+		},
+		{
+			name:              "does not tag existing network interfaces",
+			networkInterfaces: []string{"eni-existing"},
+		},
+		{
+			name:                        "tags the additional network interfaces created at launch",
+			networkInterfaces:           []string{"eni-existing"},
+			additionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{{DeviceIndex: 1}},
+			expectNetworkInterfaceTags:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			var resourceTypes []types.ResourceType
+			ec2Mock.EXPECT().RunInstances(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, input *ec2.RunInstancesInput, _ ...interface{}) (*ec2.RunInstancesOutput, error) {
+				for _, spec := range input.TagSpecifications {
+					resourceTypes = append(resourceTypes, spec.ResourceType)
+				}
+				return &ec2.RunInstancesOutput{}, nil
+			})
+
+			s := NewService(createTestClusterScope(t))
+			s.EC2Client = ec2Mock
+
+			_, err := s.runInstance("node", &infrav1.Instance{
+				ImageID:                     "ami-1",
+				Type:                        "m5.large",
+				SubnetID:                    "subnet-1",
+				UserData:                    aws.String(""),
+				NetworkInterfaces:           tt.networkInterfaces,
+				AdditionalNetworkInterfaces: tt.additionalNetworkInterfaces,
+				Tags:                        infrav1.Tags{"key": "value"},
+			})
+			g.Expect(err).To(MatchError(ContainSubstring("no instance returned")))
+			g.Expect(resourceTypes).To(ContainElements(types.ResourceTypeInstance, types.ResourceTypeVolume))
+			if tt.expectNetworkInterfaceTags {
+				g.Expect(resourceTypes).To(ContainElement(types.ResourceTypeNetworkInterface))
+			} else {
+				g.Expect(resourceTypes).NotTo(ContainElement(types.ResourceTypeNetworkInterface))
+			}
+		})
+	}
+}
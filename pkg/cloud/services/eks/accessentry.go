@@ -30,15 +30,13 @@ import (
 )
 
 func (s *Service) reconcileAccessEntries(ctx context.Context) error {
-	if len(s.scope.ControlPlane.Spec.AccessEntries) == 0 {
+	accessEntries := s.accessEntries()
+	if len(accessEntries) == 0 {
 		s.scope.Info("no access entries defined, skipping reconcile")
 		return nil
 	}
 
-	if s.scope.ControlPlane.Spec.AccessConfig == nil ||
-		s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode == "" ||
-		(s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPI &&
-			s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode != ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap) {
+	if !s.authenticationMode().AtLeast(ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap) {
 		s.scope.Info("access mode is not api or api_and_config_map, skipping reconcile")
 		return nil
 	}
@@ -48,7 +46,7 @@ func (s *Service) reconcileAccessEntries(ctx context.Context) error {
 		return errors.Wrap(err, "failed to list existing access entries")
 	}
 
-	for _, accessEntry := range s.scope.ControlPlane.Spec.AccessEntries {
+	for _, accessEntry := range accessEntries {
 		if _, exists := managedAccessEntries[accessEntry.PrincipalARN]; exists {
 			if err := s.updateAccessEntry(ctx, accessEntry); err != nil {
 				return errors.Wrapf(err, "failed to update access entry for principal %s", accessEntry.PrincipalARN)
@@ -71,6 +69,37 @@ func (s *Service) reconcileAccessEntries(ctx context.Context) error {
 	return nil
 }
 
+// accessEntries returns the access entries of the spec, along with the access entries of the mappings of the aws-auth
+// ConfigMap being migrated and the access entry of the Karpenter node role once it has been resolved, unless the spec
+// already has an access entry for the same principal.
+func (s *Service) accessEntries() []ekscontrolplanev1.AccessEntry {
+	accessEntries := s.scope.ControlPlane.Spec.AccessEntries
+
+	for _, migrated := range s.configMapMigrationAccessEntries() {
+		if !slices.ContainsFunc(accessEntries, func(accessEntry ekscontrolplanev1.AccessEntry) bool {
+			return accessEntry.PrincipalARN == migrated.PrincipalARN
+		}) {
+			accessEntries = append(slices.Clone(accessEntries), migrated)
+		}
+	}
+
+	karpenterStatus := s.scope.ControlPlane.Status.Karpenter
+	if s.scope.ControlPlane.Spec.Karpenter == nil || karpenterStatus == nil || karpenterStatus.NodeRoleARN == "" {
+		return accessEntries
+	}
+
+	if !slices.ContainsFunc(accessEntries, func(accessEntry ekscontrolplanev1.AccessEntry) bool {
+		return accessEntry.PrincipalARN == karpenterStatus.NodeRoleARN
+	}) {
+		accessEntries = append(slices.Clone(accessEntries), ekscontrolplanev1.AccessEntry{
+			PrincipalARN: karpenterStatus.NodeRoleARN,
+			Type:         ekscontrolplanev1.AccessEntryTypeEC2Linux,
+		})
+	}
+
+	return accessEntries
+}
+
 func (s *Service) getManagedAccessEntries(ctx context.Context) (map[string]bool, error) {
 	existingAccessEntries := make(map[string]bool)
 	var nextToken *string
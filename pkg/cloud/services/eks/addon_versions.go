@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/utils/ptr"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// resolveAddonVersions sets the version of the addons with a version policy other than pinned. The version is
+// resolved for the Kubernetes version of the control plane and kept until the control plane is upgraded or the
+// policy is changed, so that addons are not updated whenever a new version is released.
+func (s *Service) resolveAddonVersions(ctx context.Context, addons []ekscontrolplanev1.Addon) (map[string]*ekscontrolplanev1.AddonResolvedVersion, error) {
+	resolved := map[string]*ekscontrolplanev1.AddonResolvedVersion{}
+
+	kubernetesVersion := ""
+	for i := range addons {
+		addon := &addons[i]
+		if addon.VersionPolicy == "" || addon.VersionPolicy == ekscontrolplanev1.AddonVersionPolicyPinned {
+			continue
+		}
+
+		if kubernetesVersion == "" {
+			current := ptr.Deref(s.scope.ControlPlane.Status.Version, "")
+			if current == "" {
+				return nil, fmt.Errorf("resolving version of eks addon %s: the version of the control plane is not known yet", addon.Name)
+			}
+			v, err := parseEKSVersion(current)
+			if err != nil {
+				return nil, fmt.Errorf("parsing EKS version from status: %w", err)
+			}
+			kubernetesVersion = versionToEKS(v)
+		}
+
+		if previous := s.resolvedAddonVersion(addon.Name); previous != nil && previous.Policy == addon.VersionPolicy && previous.KubernetesVersion == kubernetesVersion {
+			addon.Version = previous.Version
+			resolved[addon.Name] = previous
+			continue
+		}
+
+		addonVersion, err := s.describeAddonVersion(ctx, addon.Name, addon.VersionPolicy, kubernetesVersion)
+		if err != nil {
+			return nil, err
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulResolveEKSAddonVersion", "Resolved version %s of addon %s for Kubernetes %s with policy %s", addonVersion, addon.Name, kubernetesVersion, addon.VersionPolicy)
+
+		addon.Version = addonVersion
+		resolved[addon.Name] = &ekscontrolplanev1.AddonResolvedVersion{
+			Version:           addonVersion,
+			Policy:            addon.VersionPolicy,
+			KubernetesVersion: kubernetesVersion,
+		}
+	}
+
+	return resolved, nil
+}
+
+// resolvedAddonVersion returns the version of an addon resolved by a previous reconciliation.
+func (s *Service) resolvedAddonVersion(name string) *ekscontrolplanev1.AddonResolvedVersion {
+	for _, state := range s.scope.ControlPlane.Status.Addons {
+		if state.Name == name {
+			return state.ResolvedVersion
+		}
+	}
+	return nil
+}
+
+// describeAddonVersion returns the version of an addon matching the version policy among the versions
+// compatible with a Kubernetes version.
+func (s *Service) describeAddonVersion(ctx context.Context, name string, policy ekscontrolplanev1.AddonVersionPolicy, kubernetesVersion string) (string, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(name),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	}
+
+	selected := ""
+	var selectedVersion *version.Version
+	for {
+		output, err := s.EKSClient.DescribeAddonVersions(ctx, input)
+		if err != nil {
+			return "", fmt.Errorf("describing versions of eks addon %s: %w", name, err)
+		}
+
+		for _, info := range output.Addons {
+			for _, addonVersion := range info.AddonVersions {
+				for _, compatibility := range addonVersion.Compatibilities {
+					if aws.ToString(compatibility.ClusterVersion) != kubernetesVersion {
+						continue
+					}
+
+					switch policy {
+					case ekscontrolplanev1.AddonVersionPolicyClusterDefault:
+						if compatibility.DefaultVersion {
+							return aws.ToString(addonVersion.AddonVersion), nil
+						}
+					case ekscontrolplanev1.AddonVersionPolicyLatestCompatible:
+						v, err := version.ParseSemantic(aws.ToString(addonVersion.AddonVersion))
+						if err != nil {
+							s.scope.Debug("ignoring eks addon version", "addon", name, "version", aws.ToString(addonVersion.AddonVersion), "error", err)
+							continue
+						}
+						if selectedVersion == nil || selectedVersion.LessThan(v) {
+							selected = aws.ToString(addonVersion.AddonVersion)
+							selectedVersion = v
+						}
+					}
+				}
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	if selected == "" {
+		return "", fmt.Errorf("no %s version of eks addon %s found for Kubernetes %s", policy, name, kubernetesVersion)
+	}
+	return selected, nil
+}
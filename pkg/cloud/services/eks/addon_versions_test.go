@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestResolveAddonVersions(t *testing.T) {
+	coreDNSVersions := &eks.DescribeAddonVersionsOutput{
+		Addons: []ekstypes.AddonInfo{
+			{
+				AddonName: aws.String("coredns"),
+				AddonVersions: []ekstypes.AddonVersionInfo{
+					{
+						AddonVersion:    aws.String("v1.11.3-eksbuild.1"),
+						Compatibilities: []ekstypes.Compatibility{{ClusterVersion: aws.String("1.31"), DefaultVersion: true}},
+					},
+					{
+						AddonVersion:    aws.String("v1.11.4-eksbuild.10"),
+						Compatibilities: []ekstypes.Compatibility{{ClusterVersion: aws.String("1.31")}},
+					},
+					{
+						AddonVersion:    aws.String("v1.11.4-eksbuild.2"),
+						Compatibilities: []ekstypes.Compatibility{{ClusterVersion: aws.String("1.31")}},
+					},
+				},
+			},
+		},
+	}
+	describeCoreDNSVersions := func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+		m.DescribeAddonVersions(gomock.Any(), &eks.DescribeAddonVersionsInput{
+			AddonName:         aws.String("coredns"),
+			KubernetesVersion: aws.String("1.31"),
+		}).Return(coreDNSVersions, nil)
+	}
+
+	tests := []struct {
+		name           string
+		addons         []ekscontrolplanev1.Addon
+		controlPlane   string
+		previous       []ekscontrolplanev1.AddonState
+		expect         func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectVersions map[string]string
+		expectResolved map[string]*ekscontrolplanev1.AddonResolvedVersion
+		expectError    bool
+	}{
+		{
+			name: "pinned versions are not resolved",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", Version: "v1.11.3-eksbuild.1"},
+				{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyPinned},
+			},
+			expect:         func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectVersions: map[string]string{"coredns": "v1.11.3-eksbuild.1", "vpc-cni": "v1.19.0-eksbuild.1"},
+			expectResolved: map[string]*ekscontrolplanev1.AddonResolvedVersion{},
+		},
+		{
+			name: "resolve the latest compatible version",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible},
+			},
+			controlPlane:   "1.31",
+			expect:         describeCoreDNSVersions,
+			expectVersions: map[string]string{"coredns": "v1.11.4-eksbuild.10"},
+			expectResolved: map[string]*ekscontrolplanev1.AddonResolvedVersion{
+				"coredns": {Version: "v1.11.4-eksbuild.10", Policy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible, KubernetesVersion: "1.31"},
+			},
+		},
+		{
+			name: "resolve the default version",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyClusterDefault},
+			},
+			controlPlane:   "1.31.2",
+			expect:         describeCoreDNSVersions,
+			expectVersions: map[string]string{"coredns": "v1.11.3-eksbuild.1"},
+			expectResolved: map[string]*ekscontrolplanev1.AddonResolvedVersion{
+				"coredns": {Version: "v1.11.3-eksbuild.1", Policy: ekscontrolplanev1.AddonVersionPolicyClusterDefault, KubernetesVersion: "1.31"},
+			},
+		},
+		{
+			name: "keep the version resolved for the same Kubernetes version and policy",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible},
+			},
+			controlPlane: "1.31",
+			previous: []ekscontrolplanev1.AddonState{
+				{Name: "coredns", ResolvedVersion: &ekscontrolplanev1.AddonResolvedVersion{Version: "v1.11.3-eksbuild.1", Policy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible, KubernetesVersion: "1.31"}},
+			},
+			expect:         func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectVersions: map[string]string{"coredns": "v1.11.3-eksbuild.1"},
+			expectResolved: map[string]*ekscontrolplanev1.AddonResolvedVersion{
+				"coredns": {Version: "v1.11.3-eksbuild.1", Policy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible, KubernetesVersion: "1.31"},
+			},
+		},
+		{
+			name: "resolve again after an upgrade of the control plane",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyClusterDefault},
+			},
+			controlPlane: "1.31",
+			previous: []ekscontrolplanev1.AddonState{
+				{Name: "coredns", ResolvedVersion: &ekscontrolplanev1.AddonResolvedVersion{Version: "v1.11.1-eksbuild.9", Policy: ekscontrolplanev1.AddonVersionPolicyClusterDefault, KubernetesVersion: "1.30"}},
+			},
+			expect:         describeCoreDNSVersions,
+			expectVersions: map[string]string{"coredns": "v1.11.3-eksbuild.1"},
+			expectResolved: map[string]*ekscontrolplanev1.AddonResolvedVersion{
+				"coredns": {Version: "v1.11.3-eksbuild.1", Policy: ekscontrolplanev1.AddonVersionPolicyClusterDefault, KubernetesVersion: "1.31"},
+			},
+		},
+		{
+			name: "no compatible version",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible},
+			},
+			controlPlane: "1.31",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonVersions(gomock.Any(), gomock.Any()).Return(&eks.DescribeAddonVersionsOutput{}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown control plane version",
+			addons: []ekscontrolplanev1.Addon{
+				{Name: "coredns", VersionPolicy: ekscontrolplanev1.AddonVersionPolicyLatestCompatible},
+			},
+			expect:      func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      clusterName,
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: clusterName,
+					Addons:         &tc.addons,
+				},
+				Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+					Version: ptr.To(tc.controlPlane),
+					Addons:  tc.previous,
+				},
+			}
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: controlPlane,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			addons := append([]ekscontrolplanev1.Addon{}, tc.addons...)
+			resolved, err := s.resolveAddonVersions(context.TODO(), addons)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(resolved).To(Equal(tc.expectResolved))
+
+			versions := map[string]string{}
+			for _, addon := range addons {
+				versions[addon.Name] = addon.Version
+			}
+			g.Expect(versions).To(Equal(tc.expectVersions))
+		})
+	}
+}
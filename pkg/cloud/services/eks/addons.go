@@ -19,6 +19,8 @@ package eks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -30,6 +32,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func (s *Service) reconcileAddons(ctx context.Context) error {
@@ -52,11 +56,20 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	}
 
 	// Get the addons from the spec we want for the cluster
-	desiredAddons := s.translateAPIToAddon(s.scope.Addons())
+	addons := append([]ekscontrolplanev1.Addon{}, s.scope.Addons()...)
+	resolvedVersions, err := s.resolveAddonVersions(ctx, addons)
+	if err != nil {
+		return fmt.Errorf("resolving eks addon versions: %w", err)
+	}
+	if agent := s.podIdentityAgentAddon(addons); agent != nil {
+		addons = append(addons, *agent)
+	}
+	desiredAddons := s.translateAPIToAddon(addons)
 
 	// If there are no addons desired or installed then do nothing
 	if len(installed) == 0 && len(desiredAddons) == 0 {
 		s.scope.Info("no addons installed and no addons to install, no action needed")
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
 		return nil
 	}
 
@@ -87,8 +100,26 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("getting installed state of eks addons: %w", err)
 	}
+	waitingFor := addonsPlan.WaitingForDependencies()
+	addonState = addonStateWithDependencies(addonState, desiredAddons, waitingFor)
+	for i := range addonState {
+		addonState[i].ResolvedVersion = resolvedVersions[addonState[i].Name]
+	}
 	s.scope.ControlPlane.Status.Addons = addonState
 
+	if len(waitingFor) > 0 {
+		waiting := make([]string, 0, len(waitingFor))
+		for _, addon := range desiredAddons {
+			if dependencies, ok := waitingFor[*addon.Name]; ok {
+				waiting = append(waiting, fmt.Sprintf("%s waits for %s", *addon.Name, strings.Join(dependencies, ", ")))
+			}
+		}
+		s.scope.Info("EKS addons are waiting for their dependencies", "addons", waiting)
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsWaitingForDependenciesReason, clusterv1beta1.ConditionSeverityInfo, "%s", strings.Join(waiting, "; "))
+	} else {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
+	}
+
 	// Persist status and record event
 	if err := s.scope.PatchObject(); err != nil {
 		return fmt.Errorf("failed to update control plane: %w", err)
@@ -135,6 +166,17 @@ func (s *Service) getClusterAddonsInstalled(ctx context.Context, eksClusterName
 		for k, v := range describeOutput.Addon.Tags {
 			installedAddon.Tags[k] = v
 		}
+		if describeOutput.Addon.Health != nil {
+			for _, issue := range describeOutput.Addon.Health.Issues {
+				installedAddon.Issues = append(installedAddon.Issues, string(issue.Code))
+			}
+		}
+		if len(describeOutput.Addon.PodIdentityAssociations) > 0 {
+			installedAddon.PodIdentityAssociations, err = s.describeAddonPodIdentityAssociations(ctx, eksClusterName, describeOutput.Addon.PodIdentityAssociations)
+			if err != nil {
+				return addonsInstalled, fmt.Errorf("describing pod identity associations of eks addon %s: %w", addon, err)
+			}
+		}
 
 		addonsInstalled = append(addonsInstalled, installedAddon)
 	}
@@ -173,6 +215,28 @@ func (s *Service) getInstalledState(ctx context.Context, eksClusterName string,
 	return addonState, nil
 }
 
+// addonStateWithDependencies marks the addons waiting for their dependencies in the state of the
+// installed addons, and adds the state of the ones which are not installed yet.
+func addonStateWithDependencies(addonState []ekscontrolplanev1.AddonState, desiredAddons []*eksaddons.EKSAddon, waitingFor map[string][]string) []ekscontrolplanev1.AddonState {
+	for _, addon := range desiredAddons {
+		dependencies, ok := waitingFor[*addon.Name]
+		if !ok {
+			continue
+		}
+		i := slices.IndexFunc(addonState, func(state ekscontrolplanev1.AddonState) bool { return state.Name == *addon.Name })
+		if i < 0 {
+			addonState = append(addonState, ekscontrolplanev1.AddonState{
+				Name:    *addon.Name,
+				Version: aws.ToString(addon.Version),
+			})
+			i = len(addonState) - 1
+		}
+		addonState[i].Progress = ekscontrolplanev1.AddonProgressWaitingForDependencies
+		addonState[i].WaitingFor = dependencies
+	}
+	return addonState
+}
+
 func (s *Service) listAddons(ctx context.Context, eksClusterName string) ([]string, error) {
 	s.Debug("getting list of eks addons")
 
@@ -208,6 +272,13 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 			ResolveConflict:       conflict,
 			ServiceAccountRoleARN: addon.ServiceAccountRoleArn,
 			Preserve:              addon.PreserveOnDelete,
+			DependsOn:             addon.DependsOn,
+		}
+		for _, association := range addon.PodIdentityAssociations {
+			convertedAddon.PodIdentityAssociations = append(convertedAddon.PodIdentityAssociations, eksaddons.PodIdentityAssociation{
+				ServiceAccount: association.ServiceAccountName,
+				RoleARN:        association.RoleARN,
+			})
 		}
 
 		converted = append(converted, convertedAddon)
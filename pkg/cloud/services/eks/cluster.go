@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
@@ -131,6 +132,7 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 	if err := s.reconcileAccessEntries(ctx); err != nil {
 		return errors.Wrap(err, "failed reconciling access entries")
 	}
+	s.reconcileConfigMapMigrationStep(cluster.AccessConfig)
 
 	if err := s.reconcileLogging(ctx, cluster.Logging); err != nil {
 		return errors.Wrap(err, "failed reconciling logging")
@@ -200,6 +202,14 @@ func (s *Service) setStatus(cluster *ekstypes.Cluster) error {
 	// Set the current Kubernetes control plane version in the status.
 	s.scope.ControlPlane.Status.Version = computeCurrentStatusVersion(cluster.Version, specSemver, clusterSemver)
 
+	// Set the built-in node pools that are active with EKS Auto Mode.
+	s.scope.ControlPlane.Status.AutoModeNodePools = nil
+	if cluster.ComputeConfig != nil && aws.ToBool(cluster.ComputeConfig.Enabled) {
+		for _, nodePool := range cluster.ComputeConfig.NodePools {
+			s.scope.ControlPlane.Status.AutoModeNodePools = append(s.scope.ControlPlane.Status.AutoModeNodePools, ekscontrolplanev1.AutoModeNodePool(nodePool))
+		}
+	}
+
 	// Set the current cluster status in the control plane status.
 	switch cluster.Status {
 	case ekstypes.ClusterStatusDeleting:
@@ -437,7 +447,7 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		err       error
 	)
 	logging := makeEksLogging(s.scope.ControlPlane.Spec.Logging)
-	encryptionConfigs := makeEksEncryptionConfigs(s.scope.ControlPlane.Spec.EncryptionConfig)
+	encryptionConfigs := makeEksEncryptionConfigs(s.encryptionConfig())
 	if s.scope.ControlPlane.Spec.RestrictPrivateSubnets {
 		s.scope.Info("Filtering private subnets")
 		vpcConfig, err = makeVpcConfig(s.scope.Subnets().FilterPrivate(), s.scope.ControlPlane.Spec.EndpointAccess, s.scope.SecurityGroups())
@@ -472,6 +482,11 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't create Kubernetes network config for cluster")
 		}
+		if netConfig != nil {
+			if err := checkRemoteNetworksOutsideServiceCIDR(s.scope.ControlPlane.Spec.RemoteNetworkConfig, *netConfig.ServiceIpv4Cidr); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Make sure to use the MachineScope here to get the merger of AWSCluster and AWSMachine tags
@@ -508,6 +523,19 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		}
 	}
 
+	var (
+		computeConfig *ekstypes.ComputeConfigRequest
+		storageConfig *ekstypes.StorageConfigRequest
+	)
+	if autoMode := s.scope.ControlPlane.Spec.AutoMode; autoMode != nil && autoMode.Enabled {
+		var elasticLoadBalancing *ekstypes.ElasticLoadBalancing
+		computeConfig, storageConfig, elasticLoadBalancing = makeAutoModeConfig(autoMode)
+		if netConfig == nil {
+			netConfig = &ekstypes.KubernetesNetworkConfigRequest{}
+		}
+		netConfig.ElasticLoadBalancing = elasticLoadBalancing
+	}
+
 	bootstrapAddon := s.scope.BootstrapSelfManagedAddons()
 	input := &eks.CreateClusterInput{
 		Name:                       aws.String(eksClusterName),
@@ -521,6 +549,10 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		KubernetesNetworkConfig:    netConfig,
 		BootstrapSelfManagedAddons: bootstrapAddon,
 		UpgradePolicy:              upgradePolicy,
+		ComputeConfig:              computeConfig,
+		StorageConfig:              storageConfig,
+		RemoteNetworkConfig:        makeRemoteNetworkConfig(s.scope.ControlPlane.Spec.RemoteNetworkConfig),
+		ZonalShiftConfig:           makeZonalShiftConfig(s.scope.ControlPlane.Spec.ZonalShiftConfig),
 	}
 
 	var out *eks.CreateClusterOutput
@@ -563,48 +595,101 @@ func (s *Service) waitForClusterActive(ctx context.Context) (*ekstypes.Cluster,
 	return cluster, nil
 }
 
+// reconcileClusterConfig updates the configuration of the cluster. EKS only accepts a single type of update per call
+// and rejects updates while another one is in progress, so a single update is initiated per reconcile, and the
+// remaining ones are initiated once the cluster is active again.
 func (s *Service) reconcileClusterConfig(ctx context.Context, cluster *ekstypes.Cluster) error {
-	var needsUpdate bool
-	input := &eks.UpdateClusterConfigInput{Name: aws.String(s.scope.KubernetesClusterName())}
+	updates, err := s.clusterConfigUpdates(cluster)
+	if err != nil {
+		return err
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	if v1beta1conditions.IsTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition) {
+		s.scope.Debug("EKS control plane is updating, postponing cluster config update", "pending", len(updates))
+		return nil
+	}
+
+	input := updates[0]
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+
+		// Wait until status transitions to UPDATING because there's a short
+		// window after UpdateClusterConfig returns where the cluster
+		// status is ACTIVE and the update would be tried again
+		if err := s.EKSClient.WaitUntilClusterUpdating(
+			ctx,
+			&eks.DescribeClusterInput{Name: aws.String(s.scope.KubernetesClusterName())},
+			s.scope.MaxWaitActiveUpdateDelete,
+		); err != nil {
+			return false, err
+		}
+
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated update of a new EKS control plane %s", s.scope.KubernetesClusterName())
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update the EKS control plane: %v", err)
+		return errors.Wrapf(err, "failed to update EKS cluster")
+	}
+	return nil
+}
+
+// clusterConfigUpdates returns the updates of the cluster configuration, one per type of update.
+func (s *Service) clusterConfigUpdates(cluster *ekstypes.Cluster) ([]*eks.UpdateClusterConfigInput, error) {
+	var updates []*eks.UpdateClusterConfigInput
+	newInput := func() *eks.UpdateClusterConfigInput {
+		return &eks.UpdateClusterConfigInput{Name: aws.String(s.scope.KubernetesClusterName())}
+	}
 
 	updateVpcConfig, err := s.reconcileVpcConfig(cluster.ResourcesVpcConfig)
 	if err != nil {
-		return errors.Wrap(err, "couldn't create vpc config for cluster")
+		return nil, errors.Wrap(err, "couldn't create vpc config for cluster")
 	}
 	if updateVpcConfig != nil {
-		needsUpdate = true
+		input := newInput()
 		input.ResourcesVpcConfig = updateVpcConfig
+		updates = append(updates, input)
 	}
 
 	if updateUpgradePolicy := s.reconcileUpgradePolicy(cluster.UpgradePolicy); updateUpgradePolicy != nil {
-		needsUpdate = true
+		input := newInput()
 		input.UpgradePolicy = updateUpgradePolicy
+		updates = append(updates, input)
 	}
 
-	if needsUpdate {
-		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
-			if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
-				return false, err
-			}
-			v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
-			record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated update of a new EKS control plane %s", s.scope.KubernetesClusterName())
-			return true, nil
-		}); err != nil {
-			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update the EKS control plane: %v", err)
-			return errors.Wrapf(err, "failed to update EKS cluster")
-		}
+	if input := newInput(); s.reconcileAutoMode(cluster, input) {
+		updates = append(updates, input)
 	}
-	return nil
+
+	if updateRemoteNetworkConfig := s.reconcileRemoteNetworkConfig(cluster.RemoteNetworkConfig); updateRemoteNetworkConfig != nil {
+		input := newInput()
+		input.RemoteNetworkConfig = updateRemoteNetworkConfig
+		updates = append(updates, input)
+	}
+
+	if updateZonalShiftConfig := s.reconcileZonalShiftConfig(cluster.ZonalShiftConfig); updateZonalShiftConfig != nil {
+		input := newInput()
+		input.ZonalShiftConfig = updateZonalShiftConfig
+		updates = append(updates, input)
+	}
+
+	return updates, nil
 }
 
 func (s *Service) reconcileAccessConfig(ctx context.Context, accessConfig *ekstypes.AccessConfigResponse) error {
 	input := &eks.UpdateClusterConfigInput{Name: aws.String(s.scope.KubernetesClusterName())}
 
-	if s.scope.ControlPlane.Spec.AccessConfig == nil || s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode == "" {
+	authenticationMode := s.authenticationMode()
+	if authenticationMode == "" {
 		return nil
 	}
 
-	expectedAuthenticationMode := s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode.APIValue()
+	expectedAuthenticationMode := authenticationMode.APIValue()
 	s.scope.Debug("Reconciling EKS Access Config for cluster", "cluster-name", s.scope.KubernetesClusterName(), "expected", expectedAuthenticationMode, "current", accessConfig.AuthenticationMode)
 	if expectedAuthenticationMode != accessConfig.AuthenticationMode {
 		input.AccessConfig = &ekstypes.UpdateAccessConfigRequest{
@@ -739,7 +824,7 @@ func (s *Service) reconcileEKSEncryptionConfig(ctx context.Context, currentClust
 		currentClusterConfig = []ekstypes.EncryptionConfig{}
 	}
 
-	encryptionConfigs := s.scope.ControlPlane.Spec.EncryptionConfig
+	encryptionConfigs := s.encryptionConfig()
 	updatedEncryptionConfigs := makeEksEncryptionConfigs(encryptionConfigs)
 
 	if compareEncryptionConfig(currentClusterConfig, updatedEncryptionConfigs) {
@@ -790,6 +875,14 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 		// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
 		nextVersionString := versionToEKS(clusterVersion.WithMinor(clusterVersion.Minor() + 1))
 
+		blocked, err := s.upgradeBlockedByInsights(ctx, nextVersionString)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return nil
+		}
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(s.scope.KubernetesClusterName()),
 			Version: &nextVersionString,
@@ -819,6 +912,8 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "failed to update the EKS control plane: %v", err)
 			return errors.Wrapf(err, "failed to update EKS cluster")
 		}
+	} else if v1beta1conditions.IsFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition) {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition)
 	}
 	return nil
 }
@@ -843,6 +938,181 @@ func (s *Service) reconcileUpgradePolicy(upgradePolicy *ekstypes.UpgradePolicyRe
 	}
 }
 
+// makeAutoModeConfig returns the compute, block storage and load balancing configuration of EKS Auto Mode.
+func makeAutoModeConfig(autoMode *ekscontrolplanev1.AutoMode) (*ekstypes.ComputeConfigRequest, *ekstypes.StorageConfigRequest, *ekstypes.ElasticLoadBalancing) {
+	computeConfig := &ekstypes.ComputeConfigRequest{
+		Enabled: aws.Bool(autoMode.Enabled),
+	}
+	if autoMode.Enabled {
+		computeConfig.NodePools = []string{}
+		if autoMode.Compute != nil {
+			for _, nodePool := range autoMode.Compute.NodePools {
+				computeConfig.NodePools = append(computeConfig.NodePools, string(nodePool))
+			}
+			if autoMode.Compute.NodeRoleARN != "" {
+				computeConfig.NodeRoleArn = aws.String(autoMode.Compute.NodeRoleARN)
+			}
+		}
+	}
+
+	storageConfig := &ekstypes.StorageConfigRequest{
+		BlockStorage: &ekstypes.BlockStorage{
+			Enabled: aws.Bool(autoMode.BlockStorageEnabled()),
+		},
+	}
+	elasticLoadBalancing := &ekstypes.ElasticLoadBalancing{
+		Enabled: aws.Bool(autoMode.ElasticLoadBalancingEnabled()),
+	}
+	return computeConfig, storageConfig, elasticLoadBalancing
+}
+
+// reconcileAutoMode adds the EKS Auto Mode configuration to the update input when it differs from the cluster one,
+// and returns whether it did. EKS requires the compute, block storage and load balancing settings to be updated together.
+func (s *Service) reconcileAutoMode(cluster *ekstypes.Cluster, input *eks.UpdateClusterConfigInput) bool {
+	autoMode := s.scope.ControlPlane.Spec.AutoMode
+	// Cluster stays unchanged when Auto Mode is omitted
+	if autoMode == nil {
+		return false
+	}
+
+	computeConfig, storageConfig, elasticLoadBalancing := makeAutoModeConfig(autoMode)
+	if autoModeUpToDate(cluster, computeConfig, storageConfig, elasticLoadBalancing) {
+		return false
+	}
+
+	s.scope.Debug("Updating EKS Auto Mode", "enabled", autoMode.Enabled)
+	input.ComputeConfig = computeConfig
+	input.StorageConfig = storageConfig
+	input.KubernetesNetworkConfig = &ekstypes.KubernetesNetworkConfigRequest{
+		ElasticLoadBalancing: elasticLoadBalancing,
+	}
+	return true
+}
+
+func autoModeUpToDate(cluster *ekstypes.Cluster, computeConfig *ekstypes.ComputeConfigRequest, storageConfig *ekstypes.StorageConfigRequest, elasticLoadBalancing *ekstypes.ElasticLoadBalancing) bool {
+	var (
+		currentCompute              = &ekstypes.ComputeConfigResponse{}
+		currentBlockStorage         bool
+		currentElasticLoadBalancing bool
+	)
+	if cluster.ComputeConfig != nil {
+		currentCompute = cluster.ComputeConfig
+	}
+	if cluster.StorageConfig != nil && cluster.StorageConfig.BlockStorage != nil {
+		currentBlockStorage = aws.ToBool(cluster.StorageConfig.BlockStorage.Enabled)
+	}
+	if cluster.KubernetesNetworkConfig != nil && cluster.KubernetesNetworkConfig.ElasticLoadBalancing != nil {
+		currentElasticLoadBalancing = aws.ToBool(cluster.KubernetesNetworkConfig.ElasticLoadBalancing.Enabled)
+	}
+
+	if aws.ToBool(currentCompute.Enabled) != aws.ToBool(computeConfig.Enabled) ||
+		currentBlockStorage != aws.ToBool(storageConfig.BlockStorage.Enabled) ||
+		currentElasticLoadBalancing != aws.ToBool(elasticLoadBalancing.Enabled) {
+		return false
+	}
+	if !aws.ToBool(computeConfig.Enabled) {
+		return true
+	}
+	if computeConfig.NodeRoleArn != nil && aws.ToString(computeConfig.NodeRoleArn) != aws.ToString(currentCompute.NodeRoleArn) {
+		return false
+	}
+	return sets.New(computeConfig.NodePools...).Equal(sets.New(currentCompute.NodePools...))
+}
+
+// makeRemoteNetworkConfig returns the remote network configuration of the EKS hybrid nodes.
+func makeRemoteNetworkConfig(remoteNetworkConfig *ekscontrolplanev1.RemoteNetworkConfig) *ekstypes.RemoteNetworkConfigRequest {
+	if remoteNetworkConfig == nil {
+		return nil
+	}
+
+	request := &ekstypes.RemoteNetworkConfigRequest{
+		RemoteNodeNetworks: []ekstypes.RemoteNodeNetwork{
+			{Cidrs: remoteNetworkConfig.RemoteNodeNetworks},
+		},
+		RemotePodNetworks: []ekstypes.RemotePodNetwork{},
+	}
+	if len(remoteNetworkConfig.RemotePodNetworks) > 0 {
+		request.RemotePodNetworks = append(request.RemotePodNetworks, ekstypes.RemotePodNetwork{
+			Cidrs: remoteNetworkConfig.RemotePodNetworks,
+		})
+	}
+	return request
+}
+
+// checkRemoteNetworksOutsideServiceCIDR returns an error when a remote network of the EKS hybrid nodes
+// overlaps the service CIDR block of the cluster.
+func checkRemoteNetworksOutsideServiceCIDR(remoteNetworkConfig *ekscontrolplanev1.RemoteNetworkConfig, serviceCIDR string) error {
+	if remoteNetworkConfig == nil {
+		return nil
+	}
+
+	_, serviceNet, err := net.ParseCIDR(serviceCIDR)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't parse service CIDR block %s", serviceCIDR)
+	}
+	for _, cidrBlock := range append(slices.Clone(remoteNetworkConfig.RemoteNodeNetworks), remoteNetworkConfig.RemotePodNetworks...) {
+		_, remoteNet, err := net.ParseCIDR(cidrBlock)
+		if err != nil {
+			return errors.Wrapf(err, "couldn't parse remote network %s", cidrBlock)
+		}
+		if remoteNet.Contains(serviceNet.IP) || serviceNet.Contains(remoteNet.IP) {
+			return errors.Errorf("remote network %s overlaps the service CIDR block %s", cidrBlock, serviceCIDR)
+		}
+	}
+	return nil
+}
+
+func (s *Service) reconcileRemoteNetworkConfig(remoteNetworkConfig *ekstypes.RemoteNetworkConfigResponse) *ekstypes.RemoteNetworkConfigRequest {
+	// Cluster stays unchanged when the remote network config is omitted
+	if s.scope.ControlPlane.Spec.RemoteNetworkConfig == nil {
+		return nil
+	}
+
+	var nodeCIDRs, podCIDRs []string
+	if remoteNetworkConfig != nil {
+		for _, network := range remoteNetworkConfig.RemoteNodeNetworks {
+			nodeCIDRs = append(nodeCIDRs, network.Cidrs...)
+		}
+		for _, network := range remoteNetworkConfig.RemotePodNetworks {
+			podCIDRs = append(podCIDRs, network.Cidrs...)
+		}
+	}
+
+	spec := s.scope.ControlPlane.Spec.RemoteNetworkConfig
+	if sets.New(nodeCIDRs...).Equal(sets.New(spec.RemoteNodeNetworks...)) &&
+		sets.New(podCIDRs...).Equal(sets.New(spec.RemotePodNetworks...)) {
+		return nil
+	}
+
+	s.scope.Debug("Updating EKS remote network config", "remoteNodeNetworks", spec.RemoteNodeNetworks, "remotePodNetworks", spec.RemotePodNetworks)
+	return makeRemoteNetworkConfig(spec)
+}
+
+// makeZonalShiftConfig returns the ARC zonal shift configuration of the cluster.
+func makeZonalShiftConfig(zonalShiftConfig *ekscontrolplanev1.ZonalShiftConfig) *ekstypes.ZonalShiftConfigRequest {
+	if zonalShiftConfig == nil {
+		return nil
+	}
+	return &ekstypes.ZonalShiftConfigRequest{
+		Enabled: aws.Bool(zonalShiftConfig.Enabled),
+	}
+}
+
+func (s *Service) reconcileZonalShiftConfig(zonalShiftConfig *ekstypes.ZonalShiftConfigResponse) *ekstypes.ZonalShiftConfigRequest {
+	// Cluster stays unchanged when the zonal shift config is omitted
+	if s.scope.ControlPlane.Spec.ZonalShiftConfig == nil {
+		return nil
+	}
+
+	enabled := zonalShiftConfig != nil && aws.ToBool(zonalShiftConfig.Enabled)
+	if enabled == s.scope.ControlPlane.Spec.ZonalShiftConfig.Enabled {
+		return nil
+	}
+
+	s.scope.Debug("Updating EKS zonal shift config", "enabled", s.scope.ControlPlane.Spec.ZonalShiftConfig.Enabled)
+	return makeZonalShiftConfig(s.scope.ControlPlane.Spec.ZonalShiftConfig)
+}
+
 func (s *Service) describeEKSCluster(ctx context.Context, eksClusterName string) (*ekstypes.Cluster, error) {
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(eksClusterName),
@@ -40,6 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
 func TestMakeEKSEncryptionConfigs(t *testing.T) {
@@ -369,9 +370,11 @@ func TestMakeEKSLogging(t *testing.T) {
 func TestReconcileClusterVersion(t *testing.T) {
 	clusterName := "default.cluster"
 	tests := []struct {
-		name        string
-		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder)
-		expectError bool
+		name          string
+		annotations   map[string]string
+		expect        func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectError   bool
+		expectBlocked bool
 	}{
 		{
 			name: "no upgrade necessary",
@@ -398,6 +401,9 @@ func TestReconcileClusterVersion(t *testing.T) {
 							Version: aws.String("1.14"),
 						},
 					}, nil)
+				m.
+					ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{}), gomock.Any()).
+					Return(&eks.ListInsightsOutput{}, nil)
 				m.WaitUntilClusterUpdating(
 					gomock.Eq(context.TODO()),
 					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}),
@@ -420,12 +426,76 @@ func TestReconcileClusterVersion(t *testing.T) {
 							Version: aws.String("1.14"),
 						},
 					}, nil)
+				m.
+					ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{}), gomock.Any()).
+					Return(&eks.ListInsightsOutput{}, nil)
 				m.
 					UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
 					Return(&eks.UpdateClusterVersionOutput{}, errors.New(""))
 			},
 			expectError: true,
 		},
+		{
+			name: "upgrade blocked by failing insights",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &ekstypes.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.14"),
+						},
+					}, nil)
+				m.
+					ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *eks.ListInsightsInput, _ ...func(*eks.Options)) (*eks.ListInsightsOutput, error) {
+						if input.Filter.KubernetesVersions[0] != "1.15" || input.Filter.Statuses[0] != ekstypes.InsightStatusValueError {
+							return nil, errors.New("unexpected filter")
+						}
+						return &eks.ListInsightsOutput{
+							Insights: []ekstypes.InsightSummary{
+								{
+									Name:          aws.String("Deprecated APIs removed in Kubernetes v1.15"),
+									InsightStatus: &ekstypes.InsightStatus{Status: ekstypes.InsightStatusValueError, Reason: aws.String("Deprecated API usage detected")},
+								},
+							},
+						}, nil
+					})
+			},
+			expectBlocked: true,
+		},
+		{
+			name:        "failing insights skipped by annotation",
+			annotations: map[string]string{ekscontrolplanev1.SkipUpgradeInsightsAnnotation: "true"},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &ekstypes.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.14"),
+						},
+					}, nil)
+				m.
+					ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{}), gomock.Any()).
+					Return(&eks.ListInsightsOutput{
+						Insights: []ekstypes.InsightSummary{
+							{
+								Name:          aws.String("Deprecated APIs removed in Kubernetes v1.15"),
+								InsightStatus: &ekstypes.InsightStatus{Status: ekstypes.InsightStatusValueError},
+							},
+						},
+					}, nil)
+				m.WaitUntilClusterUpdating(
+					gomock.Eq(context.TODO()),
+					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}),
+					gomock.Any(),
+				).Return(nil)
+				m.
+					UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
+					Return(&eks.UpdateClusterVersionOutput{}, nil)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -450,6 +520,9 @@ func TestReconcileClusterVersion(t *testing.T) {
 					},
 				},
 				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tc.annotations,
+					},
 					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
 						Version: aws.String("1.16"),
 					},
@@ -470,6 +543,7 @@ func TestReconcileClusterVersion(t *testing.T) {
 				return
 			}
 			g.Expect(err).To(BeNil())
+			g.Expect(v1beta1conditions.IsFalse(scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition)).To(Equal(tc.expectBlocked))
 		})
 	}
 }
@@ -894,6 +968,366 @@ func TestReconcileUpgradePolicy(t *testing.T) {
 	}
 }
 
+func TestReconcileClusterConfig(t *testing.T) {
+	clusterName := "default.cluster"
+	tests := []struct {
+		name           string
+		updating       bool
+		expect         func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectUpdating bool
+	}{
+		{
+			name: "initiates a single update per reconcile",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Eq(context.TODO()), gomock.Eq(&eks.UpdateClusterConfigInput{
+					Name:          aws.String(clusterName),
+					UpgradePolicy: &ekstypes.UpgradePolicyRequest{SupportType: ekstypes.SupportTypeExtended},
+				})).Return(&eks.UpdateClusterConfigOutput{}, nil)
+				m.WaitUntilClusterUpdating(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).Return(nil)
+			},
+			expectUpdating: true,
+		},
+		{
+			name:           "postpones the updates while the control plane is updating",
+			updating:       true,
+			expect:         func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectUpdating: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName:   clusterName,
+						Version:          aws.String("1.31"),
+						UpgradePolicy:    ekscontrolplanev1.UpgradePolicyExtended,
+						ZonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: true},
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: infrav1.Subnets{
+								{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+								{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+							},
+						},
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+			if tc.updating {
+				v1beta1conditions.MarkTrue(scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+			}
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			// The upgrade policy and the zonal shift config both need an update.
+			cluster := &ekstypes.Cluster{
+				Name:               aws.String(clusterName),
+				ResourcesVpcConfig: &ekstypes.VpcConfigResponse{EndpointPublicAccess: true},
+				UpgradePolicy:      &ekstypes.UpgradePolicyResponse{SupportType: ekstypes.SupportTypeStandard},
+			}
+			g.Expect(s.reconcileClusterConfig(context.TODO(), cluster)).To(Succeed())
+			g.Expect(v1beta1conditions.IsTrue(scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)).To(Equal(tc.expectUpdating))
+		})
+	}
+}
+
+func TestReconcileAutoMode(t *testing.T) {
+	clusterName := "default.cluster"
+	nodeRoleARN := "arn:aws:iam::123456789012:role/nodes"
+	enabledCluster := &ekstypes.Cluster{
+		ComputeConfig: &ekstypes.ComputeConfigResponse{
+			Enabled:     aws.Bool(true),
+			NodePools:   []string{"system", "general-purpose"},
+			NodeRoleArn: aws.String(nodeRoleARN),
+		},
+		StorageConfig: &ekstypes.StorageConfigResponse{
+			BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)},
+		},
+		KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigResponse{
+			ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+		},
+	}
+	tests := []struct {
+		name         string
+		cluster      *ekstypes.Cluster
+		autoMode     *ekscontrolplanev1.AutoMode
+		expectUpdate bool
+		expect       *eks.UpdateClusterConfigInput
+	}{
+		{
+			name:         "no update necessary - auto mode omitted",
+			cluster:      &ekstypes.Cluster{},
+			expectUpdate: false,
+		},
+		{
+			name:         "no update necessary - auto mode disabled",
+			cluster:      &ekstypes.Cluster{},
+			autoMode:     &ekscontrolplanev1.AutoMode{},
+			expectUpdate: false,
+		},
+		{
+			name:    "no update necessary - auto mode unchanged",
+			cluster: enabledCluster,
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{
+					NodePools:   []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem},
+					NodeRoleARN: nodeRoleARN,
+				},
+			},
+			expectUpdate: false,
+		},
+		{
+			name:    "needs update - enable auto mode",
+			cluster: &ekstypes.Cluster{},
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: &ekscontrolplanev1.AutoModeCompute{
+					NodePools:   []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolGeneralPurpose},
+					NodeRoleARN: nodeRoleARN,
+				},
+			},
+			expectUpdate: true,
+			expect: &eks.UpdateClusterConfigInput{
+				ComputeConfig: &ekstypes.ComputeConfigRequest{
+					Enabled:     aws.Bool(true),
+					NodePools:   []string{"general-purpose"},
+					NodeRoleArn: aws.String(nodeRoleARN),
+				},
+				StorageConfig: &ekstypes.StorageConfigRequest{
+					BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)},
+				},
+				KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+					ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+				},
+			},
+		},
+		{
+			name:    "needs update - remove node pools",
+			cluster: enabledCluster,
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+			},
+			expectUpdate: true,
+			expect: &eks.UpdateClusterConfigInput{
+				ComputeConfig: &ekstypes.ComputeConfigRequest{
+					Enabled:   aws.Bool(true),
+					NodePools: []string{},
+				},
+				StorageConfig: &ekstypes.StorageConfigRequest{
+					BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)},
+				},
+				KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+					ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+				},
+			},
+		},
+		{
+			name:         "needs update - disable auto mode",
+			cluster:      enabledCluster,
+			autoMode:     &ekscontrolplanev1.AutoMode{},
+			expectUpdate: true,
+			expect: &eks.UpdateClusterConfigInput{
+				ComputeConfig: &ekstypes.ComputeConfigRequest{
+					Enabled: aws.Bool(false),
+				},
+				StorageConfig: &ekstypes.StorageConfigRequest{
+					BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(false)},
+				},
+				KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+					ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(false)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						Version:  aws.String("1.31"),
+						AutoMode: tc.autoMode,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+
+			input := &eks.UpdateClusterConfigInput{}
+			g.Expect(s.reconcileAutoMode(tc.cluster, input)).To(Equal(tc.expectUpdate))
+			if tc.expectUpdate {
+				g.Expect(input).To(Equal(tc.expect))
+			}
+		})
+	}
+}
+
+func TestReconcileRemoteNetworkConfig(t *testing.T) {
+	clusterName := "default.cluster"
+	tests := []struct {
+		name                string
+		current             *ekstypes.RemoteNetworkConfigResponse
+		remoteNetworkConfig *ekscontrolplanev1.RemoteNetworkConfig
+		expect              *ekstypes.RemoteNetworkConfigRequest
+	}{
+		{
+			name:    "no update necessary - remote network config omitted",
+			current: &ekstypes.RemoteNetworkConfigResponse{},
+			expect:  nil,
+		},
+		{
+			name: "no update necessary - remote networks unchanged",
+			current: &ekstypes.RemoteNetworkConfigResponse{
+				RemoteNodeNetworks: []ekstypes.RemoteNodeNetwork{{Cidrs: []string{"10.201.0.0/16", "10.200.0.0/16"}}},
+				RemotePodNetworks:  []ekstypes.RemotePodNetwork{{Cidrs: []string{"10.202.0.0/16"}}},
+			},
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16", "10.201.0.0/16"},
+				RemotePodNetworks:  []string{"10.202.0.0/16"},
+			},
+			expect: nil,
+		},
+		{
+			name: "needs update - add remote networks",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+			},
+			expect: &ekstypes.RemoteNetworkConfigRequest{
+				RemoteNodeNetworks: []ekstypes.RemoteNodeNetwork{{Cidrs: []string{"10.200.0.0/16"}}},
+				RemotePodNetworks:  []ekstypes.RemotePodNetwork{},
+			},
+		},
+		{
+			name: "needs update - change remote pod networks",
+			current: &ekstypes.RemoteNetworkConfigResponse{
+				RemoteNodeNetworks: []ekstypes.RemoteNodeNetwork{{Cidrs: []string{"10.200.0.0/16"}}},
+			},
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"10.202.0.0/16"},
+			},
+			expect: &ekstypes.RemoteNetworkConfigRequest{
+				RemoteNodeNetworks: []ekstypes.RemoteNodeNetwork{{Cidrs: []string{"10.200.0.0/16"}}},
+				RemotePodNetworks:  []ekstypes.RemotePodNetwork{{Cidrs: []string{"10.202.0.0/16"}}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						Version:             aws.String("1.31"),
+						RemoteNetworkConfig: tc.remoteNetworkConfig,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+
+			g.Expect(s.reconcileRemoteNetworkConfig(tc.current)).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestCheckRemoteNetworksOutsideServiceCIDR(t *testing.T) {
+	tests := []struct {
+		name                string
+		remoteNetworkConfig *ekscontrolplanev1.RemoteNetworkConfig
+		expectError         bool
+	}{
+		{
+			name: "no remote network config",
+		},
+		{
+			name: "remote networks outside the service CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"10.201.0.0/16"},
+			},
+		},
+		{
+			name: "remote node network containing the service CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"172.16.0.0/12"},
+			},
+			expectError: true,
+		},
+		{
+			name: "remote pod network within the service CIDR block",
+			remoteNetworkConfig: &ekscontrolplanev1.RemoteNetworkConfig{
+				RemoteNodeNetworks: []string{"10.200.0.0/16"},
+				RemotePodNetworks:  []string{"172.20.128.0/17"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := checkRemoteNetworksOutsideServiceCIDR(tc.remoteNetworkConfig, "172.20.0.0/16")
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
 func TestCreateIPv6Cluster(t *testing.T) {
 	g := NewWithT(t)
 
@@ -1064,3 +1498,65 @@ func TestCreateClusterWithBootstrapClusterCreatorAdminPermissions(t *testing.T)
 	_, err = s.createCluster(context.TODO(), clusterName)
 	g.Expect(err).To(BeNil())
 }
+
+func TestReconcileZonalShiftConfig(t *testing.T) {
+	clusterName := "default.cluster"
+	tests := []struct {
+		name             string
+		current          *ekstypes.ZonalShiftConfigResponse
+		zonalShiftConfig *ekscontrolplanev1.ZonalShiftConfig
+		expect           *ekstypes.ZonalShiftConfigRequest
+	}{
+		{
+			name:    "no update when omitted",
+			current: &ekstypes.ZonalShiftConfigResponse{Enabled: aws.Bool(true)},
+		},
+		{
+			name:             "no update when unchanged",
+			current:          &ekstypes.ZonalShiftConfigResponse{Enabled: aws.Bool(true)},
+			zonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: true},
+		},
+		{
+			name:             "needs update - enable",
+			zonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: true},
+			expect:           &ekstypes.ZonalShiftConfigRequest{Enabled: aws.Bool(true)},
+		},
+		{
+			name:             "needs update - disable",
+			current:          &ekstypes.ZonalShiftConfigResponse{Enabled: aws.Bool(true)},
+			zonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: false},
+			expect:           &ekstypes.ZonalShiftConfigRequest{Enabled: aws.Bool(false)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						Version:          aws.String("1.31"),
+						ZonalShiftConfig: tc.zonalShiftConfig,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+
+			g.Expect(s.reconcileZonalShiftConfig(tc.current)).To(Equal(tc.expect))
+		})
+	}
+}
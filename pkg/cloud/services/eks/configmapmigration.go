@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// authenticationMode returns the authentication mode of the cluster, which is the mode of the spec unless the
+// migration of the aws-auth ConfigMap to access entries requires a later one. The migration switches to the
+// api_and_config_map mode first, and to the api mode once the access entries of all the mappings have been created.
+func (s *Service) authenticationMode() ekscontrolplanev1.EKSAuthenticationMode {
+	accessConfig := s.scope.ControlPlane.Spec.AccessConfig
+	if accessConfig == nil {
+		return ""
+	}
+
+	mode := accessConfig.AuthenticationMode
+	migration := accessConfig.ConfigMapMigration
+	status := s.scope.ControlPlane.Status.ConfigMapMigration
+	if migration == nil || status == nil {
+		return mode
+	}
+
+	migrationMode := ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap
+	if status.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI) ||
+		(migration.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI) &&
+			status.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAccessEntries) &&
+			len(status.UnmigratedMappings) == 0) {
+		migrationMode = ekscontrolplanev1.EKSAuthenticationModeAPI
+	}
+
+	if mode.AtLeast(migrationMode) {
+		return mode
+	}
+	return migrationMode
+}
+
+// configMapMigrationAccessEntries returns the access entries equivalent to the mappings of the aws-auth ConfigMap,
+// once they have been read from the workload cluster.
+func (s *Service) configMapMigrationAccessEntries() []ekscontrolplanev1.AccessEntry {
+	accessConfig := s.scope.ControlPlane.Spec.AccessConfig
+	status := s.scope.ControlPlane.Status.ConfigMapMigration
+	if accessConfig == nil || accessConfig.ConfigMapMigration == nil || status == nil {
+		return nil
+	}
+	return status.AccessEntries
+}
+
+// reconcileConfigMapMigrationStep records the steps of the migration of the aws-auth ConfigMap completed by the
+// cluster, given its current access config. The access entries step is completed once the access entries of the
+// mappings have been created in the api_and_config_map mode, and the api step once the mode has been switched.
+func (s *Service) reconcileConfigMapMigrationStep(accessConfig *ekstypes.AccessConfigResponse) {
+	status := s.scope.ControlPlane.Status.ConfigMapMigration
+	if accessConfig == nil || s.scope.ControlPlane.Spec.AccessConfig == nil || s.scope.ControlPlane.Spec.AccessConfig.ConfigMapMigration == nil || status == nil {
+		// The status is only set once the mappings of the aws-auth ConfigMap have been read.
+		return
+	}
+
+	switch {
+	case accessConfig.AuthenticationMode == ekstypes.AuthenticationModeApi &&
+		!status.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI):
+		status.Step = ekscontrolplanev1.ConfigMapMigrationStepAPI
+	case accessConfig.AuthenticationMode == ekstypes.AuthenticationModeApiAndConfigMap && status.Step == "":
+		status.Step = ekscontrolplanev1.ConfigMapMigrationStepAccessEntries
+	default:
+		return
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulMigrateAWSAuthConfigMap", "Completed step %s of the aws-auth ConfigMap migration", status.Step)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestConfigMapMigration(t *testing.T) {
+	nodeEntry := ekscontrolplanev1.AccessEntry{
+		PrincipalARN: "arn:aws:iam::123456789012:role/nodes",
+		Type:         ekscontrolplanev1.AccessEntryTypeEC2Linux,
+	}
+
+	tests := []struct {
+		name          string
+		accessConfig  *ekscontrolplanev1.AccessConfig
+		accessEntries []ekscontrolplanev1.AccessEntry
+		status        *ekscontrolplanev1.ConfigMapMigrationStatus
+		clusterMode   ekstypes.AuthenticationMode
+		expectMode    ekscontrolplanev1.EKSAuthenticationMode
+		expectEntries []ekscontrolplanev1.AccessEntry
+		expectStep    ekscontrolplanev1.ConfigMapMigrationStep
+	}{
+		{
+			name:         "without migration",
+			accessConfig: &ekscontrolplanev1.AccessConfig{AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap},
+			clusterMode:  ekstypes.AuthenticationModeConfigMap,
+			expectMode:   ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+		},
+		{
+			name: "mappings not read yet",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+			clusterMode: ekstypes.AuthenticationModeConfigMap,
+			expectMode:  ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+		},
+		{
+			name: "access entries are created in the api_and_config_map mode",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+			status:        &ekscontrolplanev1.ConfigMapMigrationStatus{AccessEntries: []ekscontrolplanev1.AccessEntry{nodeEntry}},
+			clusterMode:   ekstypes.AuthenticationModeConfigMap,
+			expectMode:    ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+			expectEntries: []ekscontrolplanev1.AccessEntry{nodeEntry},
+		},
+		{
+			name: "access entries step completed once the mode has been switched",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+			status:        &ekscontrolplanev1.ConfigMapMigrationStatus{AccessEntries: []ekscontrolplanev1.AccessEntry{nodeEntry}},
+			clusterMode:   ekstypes.AuthenticationModeApiAndConfigMap,
+			expectMode:    ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+			expectEntries: []ekscontrolplanev1.AccessEntry{nodeEntry},
+			expectStep:    ekscontrolplanev1.ConfigMapMigrationStepAccessEntries,
+		},
+		{
+			name: "api mode once the access entries have been created",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+			status: &ekscontrolplanev1.ConfigMapMigrationStatus{
+				Step:          ekscontrolplanev1.ConfigMapMigrationStepAccessEntries,
+				AccessEntries: []ekscontrolplanev1.AccessEntry{nodeEntry},
+			},
+			clusterMode:   ekstypes.AuthenticationModeApi,
+			expectMode:    ekscontrolplanev1.EKSAuthenticationModeAPI,
+			expectEntries: []ekscontrolplanev1.AccessEntry{nodeEntry},
+			expectStep:    ekscontrolplanev1.ConfigMapMigrationStepAPI,
+		},
+		{
+			name: "api mode blocked by unmigrated mappings",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+			status: &ekscontrolplanev1.ConfigMapMigrationStatus{
+				Step:               ekscontrolplanev1.ConfigMapMigrationStepAccessEntries,
+				AccessEntries:      []ekscontrolplanev1.AccessEntry{nodeEntry},
+				UnmigratedMappings: []string{"arn:aws:iam::123456789012:role/other: reserved group system:authenticated"},
+			},
+			clusterMode:   ekstypes.AuthenticationModeApiAndConfigMap,
+			expectMode:    ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+			expectEntries: []ekscontrolplanev1.AccessEntry{nodeEntry},
+			expectStep:    ekscontrolplanev1.ConfigMapMigrationStepAccessEntries,
+		},
+		{
+			name: "api mode once unmigrated mappings have an access entry in the spec",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+			accessEntries: []ekscontrolplanev1.AccessEntry{{PrincipalARN: "arn:aws:iam::123456789012:role/other", Type: ekscontrolplanev1.AccessEntryTypeStandard}},
+			// The mappings of the principals which have an access entry in the spec are no longer reported as unmigrated.
+			status: &ekscontrolplanev1.ConfigMapMigrationStatus{
+				Step:          ekscontrolplanev1.ConfigMapMigrationStepAccessEntries,
+				AccessEntries: []ekscontrolplanev1.AccessEntry{nodeEntry},
+			},
+			clusterMode: ekstypes.AuthenticationModeApi,
+			expectMode:  ekscontrolplanev1.EKSAuthenticationModeAPI,
+			expectEntries: []ekscontrolplanev1.AccessEntry{
+				{PrincipalARN: "arn:aws:iam::123456789012:role/other", Type: ekscontrolplanev1.AccessEntryTypeStandard},
+				nodeEntry,
+			},
+			expectStep: ekscontrolplanev1.ConfigMapMigrationStepAPI,
+		},
+		{
+			name: "access entries of the spec are kept",
+			accessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAccessEntries},
+			},
+			accessEntries: []ekscontrolplanev1.AccessEntry{{PrincipalARN: nodeEntry.PrincipalARN, Type: ekscontrolplanev1.AccessEntryTypeEC2}},
+			status:        &ekscontrolplanev1.ConfigMapMigrationStatus{AccessEntries: []ekscontrolplanev1.AccessEntry{nodeEntry}},
+			clusterMode:   ekstypes.AuthenticationModeApiAndConfigMap,
+			expectMode:    ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+			expectEntries: []ekscontrolplanev1.AccessEntry{{PrincipalARN: nodeEntry.PrincipalARN, Type: ekscontrolplanev1.AccessEntryTypeEC2}},
+			expectStep:    ekscontrolplanev1.ConfigMapMigrationStepAccessEntries,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: clusterName,
+						AccessConfig:   tc.accessConfig,
+						AccessEntries:  tc.accessEntries,
+					},
+					Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+						ConfigMapMigration: tc.status,
+					},
+				},
+			})
+			g.Expect(err).To(BeNil())
+
+			s := NewService(scope)
+			g.Expect(s.authenticationMode()).To(Equal(tc.expectMode))
+			g.Expect(s.accessEntries()).To(Equal(tc.expectEntries))
+
+			s.reconcileConfigMapMigrationStep(&ekstypes.AccessConfigResponse{AuthenticationMode: tc.clusterMode})
+			if tc.status != nil {
+				g.Expect(scope.ControlPlane.Status.ConfigMapMigration.Step).To(Equal(tc.expectStep))
+			}
+		})
+	}
+}
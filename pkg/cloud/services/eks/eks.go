@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
@@ -42,6 +43,15 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	}
 	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
 
+	// Cluster KMS key, granting the control plane IAM role before the cluster uses the key
+	if err := s.reconcileKMSKey(ctx); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, infrav1.KMSKeyReadyCondition, infrav1.KMSKeyFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return err
+	}
+	if s.scope.KMSKey() != nil {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, infrav1.KMSKeyReadyCondition)
+	}
+
 	// EKS Cluster
 	if err := s.reconcileCluster(ctx); err != nil {
 		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition, ekscontrolplanev1.EKSControlPlaneReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
@@ -54,7 +64,15 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		return errors.Wrap(err, "failed reconciling eks addons")
 	}
-	v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
+
+	// EKS Pod Identity associations
+	if err := s.reconcilePodIdentityAssociations(ctx); err != nil {
+		v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return errors.Wrap(err, "failed reconciling eks pod identity associations")
+	}
+	if len(s.scope.ControlPlane.Spec.PodIdentityAssociations) > 0 {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition)
+	}
 
 	// EKS Identity Provider
 	if err := s.reconcileIdentityProvider(ctx); err != nil {
@@ -76,6 +94,16 @@ func (s *Service) DeleteControlPlane(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Cluster KMS key
+	if err := s.deleteKMSKey(ctx); err != nil {
+		return err
+	}
+
+	// Pod identity IAM roles, the associations are deleted with the cluster
+	if err := s.deletePodIdentityRoles(ctx); err != nil {
+		return err
+	}
+
 	// Control Plane IAM role
 	if err := s.deleteControlPlaneIAMRole(ctx); err != nil {
 		return err
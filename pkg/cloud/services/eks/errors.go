@@ -35,6 +35,9 @@ var (
 	// ErrCannotUseAdditionalRoles is an error if the spec contains additional role and the
 	// EKSAllowAddRoles feature flag isn't enabled.
 	ErrCannotUseAdditionalRoles = errors.New("additional rules cannot be added as this has been disabled")
+	// ErrPodIdentityRoleCreationDisabled is an error if a pod identity association requires a role to be
+	// created and the EKSEnableIAM feature flag isn't enabled.
+	ErrPodIdentityRoleCreationDisabled = errors.New("pod identity roles cannot be created as IAM role creation has been disabled")
 	// ErrNoSecurityGroup is an error when no security group is found for an EKS cluster.
 	ErrNoSecurityGroup = errors.New("no security group for EKS cluster")
 )
@@ -18,7 +18,9 @@ package eks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -31,11 +33,17 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
 )
 
+const (
+	maxProfileNameLength           = 100
+	replacementProfileSuffixLength = 5
+)
+
 func requeueProfileUpdating() reconcile.Result {
 	return reconcile.Result{RequeueAfter: 10 * time.Second}
 }
@@ -88,15 +96,21 @@ func (s *FargateService) Reconcile(ctx context.Context) (reconcile.Result, error
 }
 
 func (s *FargateService) reconcileFargateProfile(ctx context.Context) (requeue bool, err error) {
-	profileName := s.scope.FargateProfile.Spec.ProfileName
+	// Only one profile of a cluster can be created or deleted at a time, the replaced profile is deleted before
+	// the active one is reconciled.
+	if requeue, err := s.reconcileReplacedFargateProfile(ctx); err != nil || requeue {
+		return requeue, err
+	}
 
-	profile, err := s.describeFargateProfile(ctx)
+	profileName := s.activeProfileName()
+
+	profile, err := s.describeFargateProfile(ctx, profileName)
 	if err != nil {
 		return false, errors.Wrap(err, "failed to describe profile")
 	}
 
 	if eksClusterName := s.scope.KubernetesClusterName(); profile == nil {
-		profile, err = s.createFargateProfile(ctx)
+		profile, err = s.createFargateProfile(ctx, profileName)
 		if err != nil {
 			return false, errors.Wrap(err, "failed to create profile")
 		}
@@ -104,19 +118,212 @@ func (s *FargateService) reconcileFargateProfile(ctx context.Context) (requeue b
 		profile.Status = ekstypes.FargateProfileStatusCreating
 		s.scope.Info("Created EKS fargate profile", "cluster-name", eksClusterName, "profile-name", profileName)
 	} else {
-		tagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.ClusterName())
-		ownedTag := profile.Tags[tagKey]
-		if ownedTag == "" {
-			return false, errors.New("owned tag not found for this cluster")
+		if err := s.checkOwnedFargateProfile(profile); err != nil {
+			return false, err
 		}
 		s.scope.Debug("Found owned EKS fargate profile", "cluster-name", eksClusterName, "profile-name", profileName)
 	}
+	s.scope.FargateProfile.Status.ActiveProfileName = profileName
 
 	if err := s.reconcileTags(ctx, profile); err != nil {
 		return false, errors.Wrapf(err, "failed to reconcile profile tags")
 	}
 
-	return s.handleStatus(profile), nil
+	if requeue := s.handleStatus(profile); requeue || profile.Status != ekstypes.FargateProfileStatusActive {
+		return requeue, nil
+	}
+
+	return s.reconcileFargateProfileReplacement(ctx, profile)
+}
+
+// reconcileFargateProfileReplacement replaces the active profile when its selectors or subnets differ from the
+// spec, which EKS can't update. The replacement profile is created along the active one, which is deleted once
+// the replacement is active so that the pods always have a matching profile.
+func (s *FargateService) reconcileFargateProfileReplacement(ctx context.Context, active *ekstypes.FargateProfile) (requeue bool, err error) {
+	subnets, selectors := s.subnets(), s.selectors()
+	replace := fargateProfileSpec(active.Subnets, active.Selectors) != fargateProfileSpec(subnets, selectors)
+
+	profileName := ""
+	if replace {
+		profileName, err = s.replacementProfileName(subnets, selectors)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// A replacement created for a previous spec is deleted before another one is created, as only one profile of
+	// a cluster can be created or deleted at a time.
+	if pendingName := s.scope.FargateProfile.Status.PendingProfileName; pendingName != "" && pendingName != profileName {
+		if requeue, err := s.deletePendingFargateProfile(ctx, pendingName); err != nil || requeue {
+			return requeue, err
+		}
+	}
+
+	if !replace {
+		if v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateReplacingCondition) {
+			v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateReplacingCondition, expinfrav1.EKSFargateReplacedReason, clusterv1beta1.ConditionSeverityInfo, "")
+		}
+		return false, nil
+	}
+
+	activeName := aws.ToString(active.FargateProfileName)
+	profile, err := s.describeFargateProfile(ctx, profileName)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to describe replacement profile")
+	}
+	if profile == nil {
+		// The name is recorded before the creation so that the replacement is never orphaned.
+		s.scope.FargateProfile.Status.PendingProfileName = profileName
+		if _, err := s.createFargateProfile(ctx, profileName); err != nil {
+			record.Warnf(s.scope.FargateProfile, "FailedReplaceEKSFargateProfile", "Failed to create EKS fargate profile %s replacing %s: %v", profileName, activeName, err)
+			return false, errors.Wrap(err, "failed to create replacement profile")
+		}
+		record.Eventf(s.scope.FargateProfile, "InitiatedReplaceEKSFargateProfile", "Started creating EKS fargate profile %s replacing %s", profileName, activeName)
+		v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateReplacingCondition)
+		return true, nil
+	}
+	if err := s.checkOwnedFargateProfile(profile); err != nil {
+		return false, err
+	}
+	s.scope.FargateProfile.Status.PendingProfileName = profileName
+
+	switch profile.Status {
+	case ekstypes.FargateProfileStatusActive:
+		s.scope.FargateProfile.Status.ActiveProfileName = profileName
+		s.scope.FargateProfile.Status.ReplacedProfileName = activeName
+		s.scope.FargateProfile.Status.PendingProfileName = ""
+		record.Eventf(s.scope.FargateProfile, "SuccessfulReplaceEKSFargateProfile", "Replaced EKS fargate profile %s with %s", activeName, profileName)
+		return s.reconcileReplacedFargateProfile(ctx)
+	case ekstypes.FargateProfileStatusCreateFailed:
+		// The failed replacement is deleted so that its creation is retried.
+		record.Warnf(s.scope.FargateProfile, "FailedReplaceEKSFargateProfile", "Failed to create EKS fargate profile %s replacing %s", profileName, activeName)
+		if _, err := s.deletePendingFargateProfile(ctx, profileName); err != nil {
+			return false, err
+		}
+		return false, errors.Errorf("replacement profile %s failed to create", profileName)
+	case ekstypes.FargateProfileStatusDeleteFailed:
+		return false, errors.Errorf("unexpected replacement profile status: %s", string(profile.Status))
+	default:
+		return true, nil
+	}
+}
+
+// deletePendingFargateProfile deletes a replacement profile which hasn't become the active one, waiting for it to
+// be created first.
+func (s *FargateService) deletePendingFargateProfile(ctx context.Context, profileName string) (requeue bool, err error) {
+	profile, err := s.describeFargateProfile(ctx, profileName)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to describe pending replacement profile")
+	}
+
+	switch {
+	case profile == nil:
+		s.scope.FargateProfile.Status.PendingProfileName = ""
+		return false, nil
+	case profile.Status == ekstypes.FargateProfileStatusActive || profile.Status == ekstypes.FargateProfileStatusCreateFailed:
+		if _, err := s.EKSClient.DeleteFargateProfile(ctx, &eks.DeleteFargateProfileInput{
+			ClusterName:        aws.String(s.scope.KubernetesClusterName()),
+			FargateProfileName: aws.String(profileName),
+		}); err != nil {
+			return false, errors.Wrap(err, "failed to delete pending replacement profile")
+		}
+		record.Eventf(s.scope.FargateProfile, "InitiatedDeleteEKSFargateProfile", "Started deleting pending replacement EKS fargate profile %s", profileName)
+		return true, nil
+	case profile.Status == ekstypes.FargateProfileStatusDeleteFailed:
+		return false, errors.Errorf("failed to delete pending replacement profile %s", profileName)
+	default:
+		return true, nil
+	}
+}
+
+// reconcileReplacedFargateProfile deletes the profile replaced by the active one.
+func (s *FargateService) reconcileReplacedFargateProfile(ctx context.Context) (requeue bool, err error) {
+	profileName := s.scope.FargateProfile.Status.ReplacedProfileName
+	if profileName == "" {
+		return false, nil
+	}
+
+	profile, err := s.describeFargateProfile(ctx, profileName)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to describe replaced profile")
+	}
+
+	switch {
+	case profile == nil:
+		s.scope.FargateProfile.Status.ReplacedProfileName = ""
+		record.Eventf(s.scope.FargateProfile, "SuccessfulDeleteEKSFargateProfile", "Deleted replaced EKS fargate profile %s", profileName)
+		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateReplacingCondition, expinfrav1.EKSFargateReplacedReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return false, nil
+	case profile.Status == ekstypes.FargateProfileStatusActive || profile.Status == ekstypes.FargateProfileStatusCreateFailed:
+		if _, err := s.EKSClient.DeleteFargateProfile(ctx, &eks.DeleteFargateProfileInput{
+			ClusterName:        aws.String(s.scope.KubernetesClusterName()),
+			FargateProfileName: aws.String(profileName),
+		}); err != nil {
+			return false, errors.Wrap(err, "failed to delete replaced profile")
+		}
+		record.Eventf(s.scope.FargateProfile, "InitiatedDeleteEKSFargateProfile", "Started deleting replaced EKS fargate profile %s", profileName)
+		return true, nil
+	case profile.Status == ekstypes.FargateProfileStatusDeleteFailed:
+		return false, errors.Errorf("failed to delete replaced profile %s", profileName)
+	default:
+		return true, nil
+	}
+}
+
+func (s *FargateService) checkOwnedFargateProfile(profile *ekstypes.FargateProfile) error {
+	tagKey := infrav1.ClusterAWSCloudProviderTagKey(s.scope.ClusterName())
+	if profile.Tags[tagKey] == "" {
+		return errors.New("owned tag not found for this cluster")
+	}
+	return nil
+}
+
+// activeProfileName returns the name of the EKS profile serving the pods of the profile.
+func (s *FargateService) activeProfileName() string {
+	if name := s.scope.FargateProfile.Status.ActiveProfileName; name != "" {
+		return name
+	}
+	return s.scope.FargateProfile.Spec.ProfileName
+}
+
+// replacementProfileName returns the name of a profile with the given subnets and selectors, which is the profile
+// name of the spec suffixed with their hash.
+func (s *FargateService) replacementProfileName(subnets []string, selectors []ekstypes.FargateProfileSelector) (string, error) {
+	suffix, err := hash.Base36TruncatedHash(fargateProfileSpec(subnets, selectors), replacementProfileSuffixLength)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash profile spec")
+	}
+	profileName := s.scope.FargateProfile.Spec.ProfileName
+	if maxLength := maxProfileNameLength - replacementProfileSuffixLength - 1; len(profileName) > maxLength {
+		profileName = profileName[:maxLength]
+	}
+	return fmt.Sprintf("%s-%s", profileName, suffix), nil
+}
+
+// fargateProfileSpec returns a representation of the subnets and selectors of a profile which doesn't depend on
+// their order.
+func fargateProfileSpec(subnets []string, selectors []ekstypes.FargateProfileSelector) string {
+	type selector struct {
+		Namespace string            `json:"namespace"`
+		Labels    map[string]string `json:"labels,omitempty"`
+	}
+
+	spec := struct {
+		Subnets   []string `json:"subnets"`
+		Selectors []string `json:"selectors"`
+	}{
+		Subnets:   slices.Sorted(slices.Values(subnets)),
+		Selectors: []string{},
+	}
+	for _, s := range selectors {
+		// Maps are marshalled with sorted keys.
+		out, _ := json.Marshal(selector{Namespace: aws.ToString(s.Namespace), Labels: s.Labels})
+		spec.Selectors = append(spec.Selectors, string(out))
+	}
+	slices.Sort(spec.Selectors)
+
+	out, _ := json.Marshal(spec)
+	return string(out)
 }
 
 func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue bool) {
@@ -128,7 +335,7 @@ func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue
 			v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition, expinfrav1.EKSFargateCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
 		}
 		if !v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition) {
-			record.Eventf(s.scope.FargateProfile, "InitiatedCreateEKSFargateProfile", "Started creating EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "InitiatedCreateEKSFargateProfile", "Started creating EKS fargate profile %s", aws.ToString(profile.FargateProfileName))
 			v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition)
 		}
 		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateCreatingReason, clusterv1beta1.ConditionSeverityInfo, "")
@@ -141,14 +348,14 @@ func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue
 	case ekstypes.FargateProfileStatusActive:
 		s.scope.FargateProfile.Status.Ready = true
 		if v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition) {
-			record.Eventf(s.scope.FargateProfile, "SuccessfulCreateEKSFargateProfile", "Created new EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "SuccessfulCreateEKSFargateProfile", "Created new EKS fargate profile %s", aws.ToString(profile.FargateProfileName))
 			v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateCreatingCondition, expinfrav1.EKSFargateCreatedReason, clusterv1beta1.ConditionSeverityInfo, "")
 		}
 		v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition)
 	case ekstypes.FargateProfileStatusDeleting:
 		s.scope.FargateProfile.Status.Ready = false
 		if !v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition) {
-			record.Eventf(s.scope.FargateProfile, "InitiatedDeleteEKSFargateProfile", "Started deleting EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "InitiatedDeleteEKSFargateProfile", "Started deleting EKS fargate profile %s", aws.ToString(profile.FargateProfileName))
 			v1beta1conditions.MarkTrue(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition)
 		}
 		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateDeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
@@ -165,7 +372,7 @@ func (s *FargateService) handleStatus(profile *ekstypes.FargateProfile) (requeue
 func (s *FargateService) ReconcileDelete(ctx context.Context) (reconcile.Result, error) {
 	s.scope.Debug("Reconciling EKS fargate profile deletion")
 
-	requeue, err := s.deleteFargateProfile(ctx)
+	requeue, err := s.deleteFargateProfiles(ctx)
 	if err != nil {
 		v1beta1conditions.MarkFalse(
 			s.scope.FargateProfile,
@@ -196,9 +403,8 @@ func (s *FargateService) ReconcileDelete(ctx context.Context) (reconcile.Result,
 	return reconcile.Result{}, err
 }
 
-func (s *FargateService) describeFargateProfile(ctx context.Context) (*ekstypes.FargateProfile, error) {
+func (s *FargateService) describeFargateProfile(ctx context.Context, profileName string) (*ekstypes.FargateProfile, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
-	profileName := s.scope.FargateProfile.Spec.ProfileName
 	input := &eks.DescribeFargateProfileInput{
 		ClusterName:        aws.String(eksClusterName),
 		FargateProfileName: aws.String(profileName),
@@ -217,9 +423,8 @@ func (s *FargateService) describeFargateProfile(ctx context.Context) (*ekstypes.
 	return out.FargateProfile, nil
 }
 
-func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.FargateProfile, error) {
+func (s *FargateService) createFargateProfile(ctx context.Context, profileName string) (*ekstypes.FargateProfile, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
-	profileName := s.scope.FargateProfile.Spec.ProfileName
 
 	additionalTags := s.scope.AdditionalTags()
 
@@ -230,29 +435,13 @@ func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.Fa
 
 	tags := ngTags(s.scope.ClusterName(), additionalTags)
 
-	subnets := s.scope.FargateProfile.Spec.SubnetIDs
-	if len(subnets) == 0 {
-		subnets = []string{}
-		for _, s := range s.scope.ControlPlane.Spec.NetworkSpec.Subnets.FilterPrivate() {
-			subnets = append(subnets, s.ID)
-		}
-	}
-
-	selectors := []ekstypes.FargateProfileSelector{}
-	for _, s := range s.scope.FargateProfile.Spec.Selectors {
-		selectors = append(selectors, ekstypes.FargateProfileSelector{
-			Labels:    s.Labels,
-			Namespace: aws.String(s.Namespace),
-		})
-	}
-
 	input := &eks.CreateFargateProfileInput{
 		ClusterName:         aws.String(eksClusterName),
 		FargateProfileName:  aws.String(profileName),
 		PodExecutionRoleArn: roleArn,
-		Subnets:             subnets,
+		Subnets:             s.subnets(),
 		Tags:                tags,
-		Selectors:           selectors,
+		Selectors:           s.selectors(),
 	}
 
 	out, err := s.EKSClient.CreateFargateProfile(ctx, input)
@@ -263,17 +452,59 @@ func (s *FargateService) createFargateProfile(ctx context.Context) (*ekstypes.Fa
 	return out.FargateProfile, nil
 }
 
-func (s *FargateService) deleteFargateProfile(ctx context.Context) (requeue bool, err error) {
+// subnets returns the subnets of the profile, which are the private subnets of the cluster unless set in the spec.
+func (s *FargateService) subnets() []string {
+	if len(s.scope.FargateProfile.Spec.SubnetIDs) > 0 {
+		return s.scope.FargateProfile.Spec.SubnetIDs
+	}
+	subnets := []string{}
+	for _, s := range s.scope.ControlPlane.Spec.NetworkSpec.Subnets.FilterPrivate() {
+		subnets = append(subnets, s.ID)
+	}
+	return subnets
+}
+
+func (s *FargateService) selectors() []ekstypes.FargateProfileSelector {
+	selectors := []ekstypes.FargateProfileSelector{}
+	for _, s := range s.scope.FargateProfile.Spec.Selectors {
+		selectors = append(selectors, ekstypes.FargateProfileSelector{
+			Labels:    s.Labels,
+			Namespace: aws.String(s.Namespace),
+		})
+	}
+	return selectors
+}
+
+// deleteFargateProfiles deletes the profile replaced by the active one, the replacement being created if any,
+// and the active profile, one at a time.
+func (s *FargateService) deleteFargateProfiles(ctx context.Context) (requeue bool, err error) {
+	profileNames := []string{}
+	if name := s.scope.FargateProfile.Status.ReplacedProfileName; name != "" {
+		profileNames = append(profileNames, name)
+	}
+	if name := s.scope.FargateProfile.Status.PendingProfileName; name != "" {
+		profileNames = append(profileNames, name)
+	}
+	profileNames = append(profileNames, s.activeProfileName())
+
+	for _, profileName := range slices.Compact(profileNames) {
+		if requeue, err := s.deleteFargateProfile(ctx, profileName); err != nil || requeue {
+			return requeue, err
+		}
+	}
+	return false, nil
+}
+
+func (s *FargateService) deleteFargateProfile(ctx context.Context, profileName string) (requeue bool, err error) {
 	eksClusterName := s.scope.KubernetesClusterName()
-	profileName := s.scope.FargateProfile.Spec.ProfileName
 
-	profile, err := s.describeFargateProfile(ctx)
+	profile, err := s.describeFargateProfile(ctx, profileName)
 	if err != nil {
 		return false, errors.Wrap(err, "failed to describe profile")
 	}
 	if profile == nil {
 		if v1beta1conditions.IsTrue(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition) {
-			record.Eventf(s.scope.FargateProfile, "SuccessfulDeleteEKSFargateProfile", "Deleted EKS fargate profile %s", s.scope.FargateProfile.Spec.ProfileName)
+			record.Eventf(s.scope.FargateProfile, "SuccessfulDeleteEKSFargateProfile", "Deleted EKS fargate profile %s", profileName)
 			v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateDeletingCondition, expinfrav1.EKSFargateDeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
 		}
 		v1beta1conditions.MarkFalse(s.scope.FargateProfile, expinfrav1.EKSFargateProfileReadyCondition, expinfrav1.EKSFargateDeletedReason, clusterv1beta1.ConditionSeverityInfo, "")
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestFargateProfileSpec(t *testing.T) {
+	g := NewWithT(t)
+
+	selectors := []ekstypes.FargateProfileSelector{
+		{Namespace: aws.String("default"), Labels: map[string]string{"app": "foo", "tier": "web"}},
+		{Namespace: aws.String("kube-system")},
+	}
+	reordered := []ekstypes.FargateProfileSelector{
+		{Namespace: aws.String("kube-system"), Labels: map[string]string{}},
+		{Namespace: aws.String("default"), Labels: map[string]string{"tier": "web", "app": "foo"}},
+	}
+
+	g.Expect(fargateProfileSpec([]string{"subnet-1", "subnet-2"}, selectors)).To(Equal(fargateProfileSpec([]string{"subnet-2", "subnet-1"}, reordered)))
+	g.Expect(fargateProfileSpec([]string{"subnet-1"}, selectors)).ToNot(Equal(fargateProfileSpec([]string{"subnet-1", "subnet-2"}, selectors)))
+	g.Expect(fargateProfileSpec([]string{"subnet-1"}, selectors)).ToNot(Equal(fargateProfileSpec([]string{"subnet-1"}, selectors[:1])))
+}
+
+func TestReconcileFargateProfileReplacement(t *testing.T) {
+	const profileName = "profile"
+	ownedTags := map[string]string{infrav1.ClusterAWSCloudProviderTagKey(clusterName): string(infrav1.ResourceLifecycleOwned)}
+
+	profile := func(name string, status ekstypes.FargateProfileStatus, namespace string) *ekstypes.FargateProfile {
+		return &ekstypes.FargateProfile{
+			FargateProfileName: aws.String(name),
+			FargateProfileArn:  aws.String("arn:aws:eks:us-east-1:123456789012:fargateprofile/" + name),
+			Status:             status,
+			Subnets:            []string{"subnet-1"},
+			Selectors:          []ekstypes.FargateProfileSelector{{Namespace: aws.String(namespace)}},
+			Tags:               ownedTags,
+		}
+	}
+	describe := func(m *mock_eksiface.MockEKSAPIMockRecorder, name string, profile *ekstypes.FargateProfile) {
+		m.DescribeFargateProfile(gomock.Any(), &eks.DescribeFargateProfileInput{
+			ClusterName:        aws.String(clusterName),
+			FargateProfileName: aws.String(name),
+		}).Return(&eks.DescribeFargateProfileOutput{FargateProfile: profile}, nil)
+	}
+	notFound := func(m *mock_eksiface.MockEKSAPIMockRecorder, name string) {
+		m.DescribeFargateProfile(gomock.Any(), &eks.DescribeFargateProfileInput{
+			ClusterName:        aws.String(clusterName),
+			FargateProfileName: aws.String(name),
+		}).Return(nil, &ekstypes.ResourceNotFoundException{Message: aws.String("not found")})
+	}
+
+	fargateProfile := &expinfrav1.AWSFargateProfile{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: profileName},
+		Spec: expinfrav1.FargateProfileSpec{
+			ClusterName: clusterName,
+			ProfileName: profileName,
+			SubnetIDs:   []string{"subnet-1"},
+			Selectors:   []expinfrav1.FargateSelector{{Namespace: "apps"}},
+		},
+	}
+	s := &FargateService{scope: &scope.FargateProfileScope{FargateProfile: fargateProfile}}
+	replacementName, err := s.replacementProfileName([]string{"subnet-1"}, s.selectors())
+	NewWithT(t).Expect(err).To(BeNil())
+
+	tests := []struct {
+		name                string
+		status              expinfrav1.FargateProfileStatus
+		expect              func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectRequeue       bool
+		expectActiveProfile string
+		expectReplaced      string
+		expectPending       string
+	}{
+		{
+			name: "active profile matches the spec",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "apps"))
+			},
+			expectActiveProfile: profileName,
+		},
+		{
+			name: "replacement profile being created",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "default"))
+				describe(m, replacementName, profile(replacementName, ekstypes.FargateProfileStatusCreating, "apps"))
+			},
+			expectRequeue:       true,
+			expectActiveProfile: profileName,
+			expectPending:       replacementName,
+		},
+		{
+			name: "pending replacement of a previous spec being created",
+			status: expinfrav1.FargateProfileStatus{
+				PendingProfileName: "profile-previous",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "default"))
+				describe(m, "profile-previous", profile("profile-previous", ekstypes.FargateProfileStatusCreating, "other"))
+			},
+			expectRequeue:       true,
+			expectActiveProfile: profileName,
+			expectPending:       "profile-previous",
+		},
+		{
+			name: "pending replacement of a previous spec deleted",
+			status: expinfrav1.FargateProfileStatus{
+				PendingProfileName: "profile-previous",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "default"))
+				describe(m, "profile-previous", profile("profile-previous", ekstypes.FargateProfileStatusActive, "other"))
+				m.DeleteFargateProfile(gomock.Any(), &eks.DeleteFargateProfileInput{
+					ClusterName:        aws.String(clusterName),
+					FargateProfileName: aws.String("profile-previous"),
+				}).Return(&eks.DeleteFargateProfileOutput{}, nil)
+			},
+			expectRequeue:       true,
+			expectActiveProfile: profileName,
+			expectPending:       "profile-previous",
+		},
+		{
+			name: "pending replacement deleted once the active profile matches the spec again",
+			status: expinfrav1.FargateProfileStatus{
+				PendingProfileName: replacementName,
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "apps"))
+				notFound(m, replacementName)
+			},
+			expectActiveProfile: profileName,
+		},
+		{
+			name: "active replacement profile replaces the previous profile",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "default"))
+				describe(m, replacementName, profile(replacementName, ekstypes.FargateProfileStatusActive, "apps"))
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusActive, "default"))
+				m.DeleteFargateProfile(gomock.Any(), &eks.DeleteFargateProfileInput{
+					ClusterName:        aws.String(clusterName),
+					FargateProfileName: aws.String(profileName),
+				}).Return(&eks.DeleteFargateProfileOutput{}, nil)
+			},
+			expectRequeue:       true,
+			expectActiveProfile: replacementName,
+			expectReplaced:      profileName,
+		},
+		{
+			name: "replaced profile being deleted",
+			status: expinfrav1.FargateProfileStatus{
+				ActiveProfileName:   replacementName,
+				ReplacedProfileName: profileName,
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describe(m, profileName, profile(profileName, ekstypes.FargateProfileStatusDeleting, "default"))
+			},
+			expectRequeue:       true,
+			expectActiveProfile: replacementName,
+			expectReplaced:      profileName,
+		},
+		{
+			name: "replaced profile deleted",
+			status: expinfrav1.FargateProfileStatus{
+				ActiveProfileName:   replacementName,
+				ReplacedProfileName: profileName,
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				notFound(m, profileName)
+				describe(m, replacementName, profile(replacementName, ekstypes.FargateProfileStatusActive, "apps"))
+			},
+			expectActiveProfile: replacementName,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			_ = expinfrav1.AddToScheme(scheme)
+
+			fargateProfile := fargateProfile.DeepCopy()
+			fargateProfile.Status = tc.status
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(fargateProfile).Build()
+
+			scope, err := scope.NewFargateProfileScope(scope.FargateProfileScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: clusterName},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: clusterName},
+					Spec:       ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: clusterName},
+				},
+				FargateProfile: fargateProfile,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			s := NewFargateService(scope)
+			s.EKSClient = eksMock
+
+			requeue, err := s.reconcileFargateProfile(context.TODO())
+			g.Expect(err).To(BeNil())
+			g.Expect(requeue).To(Equal(tc.expectRequeue))
+			g.Expect(fargateProfile.Status.ActiveProfileName).To(Equal(tc.expectActiveProfile))
+			g.Expect(fargateProfile.Status.ReplacedProfileName).To(Equal(tc.expectReplaced))
+			g.Expect(fargateProfile.Status.PendingProfileName).To(Equal(tc.expectPending))
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
@@ -44,10 +45,13 @@ import (
 const (
 	// EKSFargateService is the service to trust for fargate pod execution roles.
 	EKSFargateService = "eks-fargate-pods.amazonaws.com"
+	// EKSPodIdentityService is the service to trust for roles associated with service accounts using EKS Pod Identity.
+	EKSPodIdentityService = "pods.eks.amazonaws.com"
 
 	iamPolicyVersion    = "2012-10-17"
 	iamEffectAllow      = "Allow"
 	iamActionAssumeRole = "sts:AssumeRole"
+	iamActionTagSession = "sts:TagSession"
 )
 
 // IAMService defines the specs for an IAM service.
@@ -346,6 +350,40 @@ func (s *IAMService) DeleteRole(ctx context.Context, name string) error {
 	return nil
 }
 
+// PutRolePolicy creates or replaces an inline policy of a role.
+func (s *IAMService) PutRolePolicy(ctx context.Context, roleName, policyName string, policy *iamv1.PolicyDocument) error {
+	policyJSON, err := converters.IAMPolicyDocumentToJSON(*policy)
+	if err != nil {
+		return errors.Wrap(err, "error converting policy to json")
+	}
+
+	if _, err := s.IAMClient.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(policyName),
+		PolicyDocument: aws.String(policyJSON),
+	}); err != nil {
+		return errors.Wrapf(err, "error putting policy %s on role %s", policyName, roleName)
+	}
+
+	return nil
+}
+
+// DeleteRolePolicy deletes an inline policy of a role. A policy that doesn't exist is ignored.
+func (s *IAMService) DeleteRolePolicy(ctx context.Context, roleName, policyName string) error {
+	if _, err := s.IAMClient.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(policyName),
+	}); err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == (&iamtypes.NoSuchEntityException{}).ErrorCode() {
+			return nil
+		}
+		return errors.Wrapf(err, "error deleting policy %s of role %s", policyName, roleName)
+	}
+
+	return nil
+}
+
 // IsUnmanaged will check if a given role and tag are unmanaged against the IAMService.
 func (s *IAMService) IsUnmanaged(role *iamtypes.Role, key string) bool {
 	keyToFind := infrav1.ClusterAWSCloudProviderTagKey(key)
@@ -382,6 +420,14 @@ func ControlPlaneTrustRelationship(enableFargate bool) *iamv1.PolicyDocument {
 	return policy
 }
 
+// AutoModeControlPlaneTrustRelationship will generate a ControlPlane PolicyDocument for clusters using EKS Auto Mode,
+// which additionally requires the TagSession action.
+func AutoModeControlPlaneTrustRelationship() *iamv1.PolicyDocument {
+	policy := ControlPlaneTrustRelationship(false)
+	policy.Statement[0].Action = append(policy.Statement[0].Action, iamActionTagSession)
+	return policy
+}
+
 // FargateTrustRelationship will generate a Fargate PolicyDocument.
 func FargateTrustRelationship() *iamv1.PolicyDocument {
 	identity := make(iamv1.Principals)
@@ -424,6 +470,28 @@ func NodegroupTrustRelationship() *iamv1.PolicyDocument {
 	return policy
 }
 
+// PodIdentityTrustRelationship will generate a PolicyDocument for the roles of pod identity associations.
+func PodIdentityTrustRelationship() *iamv1.PolicyDocument {
+	identity := make(iamv1.Principals)
+	identity["Service"] = []string{EKSPodIdentityService}
+
+	policy := &iamv1.PolicyDocument{
+		Version: iamPolicyVersion,
+		Statement: []iamv1.StatementEntry{
+			{
+				Effect: iamEffectAllow,
+				Action: []string{
+					iamActionAssumeRole,
+					iamActionTagSession,
+				},
+				Principal: identity,
+			},
+		},
+	}
+
+	return policy
+}
+
 func findStringInSlice(slice []string, toFind string) bool {
 	for _, item := range slice {
 		if item == toFind {
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+// upgradeBlockedByInsights returns whether failing EKS upgrade insights block the upgrade of the control plane
+// to the given version. The insights are skipped when the SkipUpgradeInsightsAnnotation is set to "true".
+func (s *Service) upgradeBlockedByInsights(ctx context.Context, nextVersion string) (bool, error) {
+	failing, err := s.failingUpgradeInsights(ctx, nextVersion)
+	if err != nil {
+		return false, err
+	}
+
+	if len(failing) == 0 {
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition)
+		return false, nil
+	}
+
+	if s.scope.ControlPlane.Annotations[ekscontrolplanev1.SkipUpgradeInsightsAnnotation] == "true" {
+		record.Warnf(s.scope.ControlPlane, "SkippedEKSUpgradeInsights", "Upgrading EKS control plane %s to version %s despite failing upgrade insights: %s",
+			s.scope.KubernetesClusterName(), nextVersion, strings.Join(failing, ", "))
+		v1beta1conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition)
+		return false, nil
+	}
+
+	if !v1beta1conditions.IsFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition) {
+		record.Warnf(s.scope.ControlPlane, "BlockedEKSControlPlaneUpgrade", "Upgrade of EKS control plane %s to version %s is blocked by failing upgrade insights: %s",
+			s.scope.KubernetesClusterName(), nextVersion, strings.Join(failing, ", "))
+	}
+	v1beta1conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpgradeReadyCondition, ekscontrolplanev1.EKSControlPlaneUpgradeBlockedReason,
+		clusterv1beta1.ConditionSeverityWarning, "upgrade to %s blocked by failing upgrade insights: %s", nextVersion, strings.Join(failing, ", "))
+	return true, nil
+}
+
+// failingUpgradeInsights returns the upgrade readiness insights in error for the given Kubernetes version.
+func (s *Service) failingUpgradeInsights(ctx context.Context, nextVersion string) ([]string, error) {
+	var failing []string
+
+	paginator := eks.NewListInsightsPaginator(s.EKSClient, &eks.ListInsightsInput{
+		ClusterName: aws.String(s.scope.KubernetesClusterName()),
+		Filter: &ekstypes.InsightsFilter{
+			Categories:         []ekstypes.Category{ekstypes.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{nextVersion},
+			Statuses:           []ekstypes.InsightStatusValue{ekstypes.InsightStatusValueError},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list EKS upgrade insights")
+		}
+
+		for _, insight := range output.Insights {
+			name := aws.ToString(insight.Name)
+			if insight.InsightStatus != nil && aws.ToString(insight.InsightStatus.Reason) != "" {
+				name = fmt.Sprintf("%s (%s)", name, aws.ToString(insight.InsightStatus.Reason))
+			}
+			failing = append(failing, name)
+		}
+	}
+	return failing, nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms"
+)
+
+// encryptionResourceSecrets is the only resource supported by the EKS envelope encryption.
+const encryptionResourceSecrets = "secrets"
+
+// reconcileKMSKey reconciles the cluster KMS key, granting its use to the control plane IAM role
+// so that it can be used for the envelope encryption of secrets.
+func (s *Service) reconcileKMSKey(ctx context.Context) error {
+	if s.scope.KMSKey() == nil {
+		return nil
+	}
+
+	role, err := s.GetIAMRole(ctx, *s.scope.ControlPlane.Spec.RoleName)
+	if err != nil {
+		return errors.Wrapf(err, "error getting control plane iam role: %s", *s.scope.ControlPlane.Spec.RoleName)
+	}
+
+	return kms.NewService(s.scope).ReconcileKey(ctx, aws.ToString(role.Arn))
+}
+
+// deleteKMSKey schedules the deletion of the cluster KMS key.
+func (s *Service) deleteKMSKey(ctx context.Context) error {
+	if s.scope.KMSKey() == nil {
+		return nil
+	}
+
+	return kms.NewService(s.scope).DeleteKey(ctx)
+}
+
+// encryptionConfig returns the encryption configuration of the cluster. Unless configured otherwise,
+// secrets are encrypted with the cluster KMS key if any.
+func (s *Service) encryptionConfig() *ekscontrolplanev1.EncryptionConfig {
+	if s.scope.ControlPlane.Spec.EncryptionConfig != nil || s.scope.KMSKeyARN() == "" {
+		return s.scope.ControlPlane.Spec.EncryptionConfig
+	}
+
+	return &ekscontrolplanev1.EncryptionConfig{
+		Provider:  aws.String(s.scope.KMSKeyARN()),
+		Resources: []*string{aws.String(encryptionResourceSecrets)},
+	}
+}
@@ -234,6 +234,9 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 	if managedPool.InstanceType != nil {
 		input.InstanceTypes = []string{aws.ToString(managedPool.InstanceType)}
 	}
+	if len(managedPool.InstanceTypes) > 0 {
+		input.InstanceTypes = managedPool.InstanceTypes
+	}
 	if len(managedPool.Taints) > 0 {
 		s.Info("adding taints to nodegroup", "nodegroup", nodegroupName)
 		taints, err := converters.TaintsToSDK(managedPool.Taints)
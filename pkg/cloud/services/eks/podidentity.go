@@ -0,0 +1,236 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+func podIdentityAssociationKey(namespace, serviceAccount string) string {
+	return namespace + "/" + serviceAccount
+}
+
+func (s *Service) reconcilePodIdentityAssociations(ctx context.Context) error {
+	if !s.scope.ControlPlane.Status.Ready {
+		return nil
+	}
+
+	managedAssociations, err := s.getManagedPodIdentityAssociations(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list existing pod identity associations")
+	}
+
+	associations := s.podIdentityAssociations()
+	if len(associations) == 0 && len(managedAssociations) == 0 {
+		s.scope.Debug("no pod identity associations defined, skipping reconcile")
+		s.scope.ControlPlane.Status.PodIdentityAssociations = nil
+		return nil
+	}
+
+	clusterName := s.scope.KubernetesClusterName()
+	statuses := make([]ekscontrolplanev1.PodIdentityAssociationStatus, 0, len(associations))
+
+	for _, association := range associations {
+		roleARN, err := s.reconcilePodIdentityRole(ctx, association)
+		if err != nil {
+			return errors.Wrapf(err, "failed to reconcile role of pod identity association for %s/%s", association.Namespace, association.ServiceAccountName)
+		}
+
+		key := podIdentityAssociationKey(association.Namespace, association.ServiceAccountName)
+		associationID := ""
+		if existing, ok := managedAssociations[key]; ok {
+			associationID = aws.ToString(existing.AssociationId)
+			if aws.ToString(existing.RoleArn) != roleARN {
+				if _, err := s.EKSClient.UpdatePodIdentityAssociation(ctx, &eks.UpdatePodIdentityAssociationInput{
+					ClusterName:   &clusterName,
+					AssociationId: existing.AssociationId,
+					RoleArn:       &roleARN,
+				}); err != nil {
+					return errors.Wrapf(err, "failed to update pod identity association for %s", key)
+				}
+				record.Eventf(s.scope.ControlPlane, "SuccessfulUpdatePodIdentityAssociation", "Updated pod identity association for %s with role %s", key, roleARN)
+			}
+			delete(managedAssociations, key)
+		} else {
+			associationID, err = s.createPodIdentityAssociation(ctx, association, roleARN)
+			if err != nil {
+				record.Warnf(s.scope.ControlPlane, "FailedCreatePodIdentityAssociation", "Failed to create pod identity association for %s: %v", key, err)
+				return errors.Wrapf(err, "failed to create pod identity association for %s", key)
+			}
+			record.Eventf(s.scope.ControlPlane, "SuccessfulCreatePodIdentityAssociation", "Created pod identity association for %s with role %s", key, roleARN)
+		}
+
+		statuses = append(statuses, ekscontrolplanev1.PodIdentityAssociationStatus{
+			Namespace:          association.Namespace,
+			ServiceAccountName: association.ServiceAccountName,
+			AssociationID:      associationID,
+			RoleARN:            roleARN,
+		})
+	}
+
+	for key, existing := range managedAssociations {
+		if _, err := s.EKSClient.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+			ClusterName:   &clusterName,
+			AssociationId: existing.AssociationId,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete pod identity association for %s", key)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulDeletePodIdentityAssociation", "Deleted pod identity association for %s", key)
+
+		if err := s.deletePodIdentityRole(ctx, aws.ToString(existing.Namespace), aws.ToString(existing.ServiceAccount)); err != nil {
+			return err
+		}
+	}
+
+	s.scope.ControlPlane.Status.PodIdentityAssociations = statuses
+	return nil
+}
+
+// podIdentityAssociations returns the pod identity associations of the spec, along with the association of the
+// Karpenter controller once its role has been created.
+func (s *Service) podIdentityAssociations() []ekscontrolplanev1.PodIdentityAssociation {
+	associations := s.scope.ControlPlane.Spec.PodIdentityAssociations
+
+	karpenter := s.scope.ControlPlane.Spec.Karpenter
+	karpenterStatus := s.scope.ControlPlane.Status.Karpenter
+	if karpenter != nil && karpenterStatus != nil && karpenterStatus.ControllerRoleARN != "" {
+		associations = append(slices.Clone(associations), ekscontrolplanev1.PodIdentityAssociation{
+			Namespace:          karpenter.ServiceAccountNamespace,
+			ServiceAccountName: karpenter.ServiceAccountName,
+			RoleARN:            karpenterStatus.ControllerRoleARN,
+		})
+	}
+
+	return associations
+}
+
+// getManagedPodIdentityAssociations returns the pod identity associations created by the provider,
+// indexed by namespace and service account. The associations owned by addons are managed with them.
+func (s *Service) getManagedPodIdentityAssociations(ctx context.Context) (map[string]*ekstypes.PodIdentityAssociation, error) {
+	associations := map[string]*ekstypes.PodIdentityAssociation{}
+	clusterName := s.scope.KubernetesClusterName()
+	managedTag := infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())
+
+	paginator := eks.NewListPodIdentityAssociationsPaginator(s.EKSClient, &eks.ListPodIdentityAssociationsInput{
+		ClusterName: &clusterName,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list pod identity associations")
+		}
+
+		for _, summary := range output.Associations {
+			if summary.OwnerArn != nil {
+				continue
+			}
+
+			describeOutput, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+				ClusterName:   &clusterName,
+				AssociationId: summary.AssociationId,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to describe pod identity association %s", aws.ToString(summary.AssociationId))
+			}
+
+			association := describeOutput.Association
+			if association == nil {
+				continue
+			}
+			if _, managed := association.Tags[managedTag]; managed {
+				associations[podIdentityAssociationKey(aws.ToString(association.Namespace), aws.ToString(association.ServiceAccount))] = association
+			}
+		}
+	}
+
+	return associations, nil
+}
+
+func (s *Service) createPodIdentityAssociation(ctx context.Context, association ekscontrolplanev1.PodIdentityAssociation, roleARN string) (string, error) {
+	clusterName := s.scope.KubernetesClusterName()
+
+	additionalTags := s.scope.AdditionalTags()
+	additionalTags[infrav1.ClusterAWSCloudProviderTagKey(s.scope.Name())] = string(infrav1.ResourceLifecycleOwned)
+
+	output, err := s.EKSClient.CreatePodIdentityAssociation(ctx, &eks.CreatePodIdentityAssociationInput{
+		ClusterName:    &clusterName,
+		Namespace:      aws.String(association.Namespace),
+		ServiceAccount: aws.String(association.ServiceAccountName),
+		RoleArn:        &roleARN,
+		Tags:           additionalTags,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.Association.AssociationId), nil
+}
+
+// describeAddonPodIdentityAssociations returns the service accounts and roles of the given pod identity associations of an addon.
+func (s *Service) describeAddonPodIdentityAssociations(ctx context.Context, eksClusterName string, associationARNs []string) ([]eksaddons.PodIdentityAssociation, error) {
+	associations := make([]eksaddons.PodIdentityAssociation, 0, len(associationARNs))
+	for _, associationARN := range associationARNs {
+		// The association ID is the last element of the ARN: arn:aws:eks:region:account:podidentityassociation/cluster/a-id
+		associationID := associationARN[strings.LastIndex(associationARN, "/")+1:]
+		output, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+			ClusterName:   &eksClusterName,
+			AssociationId: &associationID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describing pod identity association %s: %w", associationID, err)
+		}
+		associations = append(associations, eksaddons.PodIdentityAssociation{
+			ServiceAccount: aws.ToString(output.Association.ServiceAccount),
+			RoleARN:        aws.ToString(output.Association.RoleArn),
+		})
+	}
+	return associations, nil
+}
+
+// podIdentityAgentAddon returns the EKS Pod Identity agent addon to install, if it is enabled and not
+// already part of the addons of the control plane.
+func (s *Service) podIdentityAgentAddon(addons []ekscontrolplanev1.Addon) *ekscontrolplanev1.Addon {
+	agent := s.scope.ControlPlane.Spec.PodIdentityAgent
+	if agent == nil {
+		return nil
+	}
+	for _, addon := range addons {
+		if addon.Name == eksaddons.PodIdentityAgentAddonName {
+			return nil
+		}
+	}
+	return &ekscontrolplanev1.Addon{
+		Name:               eksaddons.PodIdentityAgentAddonName,
+		Version:            agent.Version,
+		Configuration:      agent.Configuration,
+		ConflictResolution: ptr.To(ekscontrolplanev1.AddonResolutionOverwrite),
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	podIdentityRoleARN      = "arn:aws:iam::123456789012:role/pod-role"
+	otherPodIdentityRoleARN = "arn:aws:iam::123456789012:role/other-pod-role"
+)
+
+func TestReconcilePodIdentityAssociations(t *testing.T) {
+	managedTags := map[string]string{"kubernetes.io/cluster/test-cluster": "owned"}
+
+	tests := []struct {
+		name         string
+		associations []ekscontrolplanev1.PodIdentityAssociation
+		enableIAM    bool
+		expect       func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder)
+		expectStatus []ekscontrolplanev1.PodIdentityAssociationStatus
+		expectError  bool
+	}{
+		{
+			name: "no pod identity associations",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+			},
+		},
+		{
+			name: "create pod identity association with a role ARN",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: podIdentityRoleARN},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+				m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+					ClusterName:    aws.String(clusterName),
+					Namespace:      aws.String("default"),
+					ServiceAccount: aws.String("app"),
+					RoleArn:        aws.String(podIdentityRoleARN),
+					Tags:           managedTags,
+				}).Return(&eks.CreatePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{AssociationId: aws.String("a-1")},
+				}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: "default", ServiceAccountName: "app", AssociationID: "a-1", RoleARN: podIdentityRoleARN},
+			},
+		},
+		{
+			name: "update the role of an existing association and delete removed associations, ignoring addon associations",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: otherPodIdentityRoleARN},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{
+					Associations: []ekstypes.PodIdentityAssociationSummary{
+						{AssociationId: aws.String("a-1")},
+						{AssociationId: aws.String("a-2")},
+						{AssociationId: aws.String("a-3"), OwnerArn: aws.String("arn:aws:eks:us-east-1:123456789012:addon/test-cluster/vpc-cni/1")},
+					},
+				}, nil)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("app"),
+						RoleArn:        aws.String(podIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-2"),
+				}).Return(&eks.DescribePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-2"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("removed"),
+						RoleArn:        aws.String(podIdentityRoleARN),
+						Tags:           managedTags,
+					},
+				}, nil)
+				m.UpdatePodIdentityAssociation(gomock.Any(), &eks.UpdatePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+					RoleArn:       aws.String(otherPodIdentityRoleARN),
+				}).Return(&eks.UpdatePodIdentityAssociationOutput{}, nil)
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-2"),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+				i.GetRole(gomock.Any(), &iam.GetRoleInput{
+					RoleName: aws.String("test-cluster-default-removed_pod-identity-role"),
+				}).Return(nil, &iamtypes.NoSuchEntityException{})
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: "default", ServiceAccountName: "app", AssociationID: "a-1", RoleARN: otherPodIdentityRoleARN},
+			},
+		},
+		{
+			name: "create a role from policies",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RolePolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}},
+			},
+			enableIAM: true,
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				roleName := aws.String("test-cluster-default-app_pod-identity-role")
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+				i.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: roleName}).Return(nil, &iamtypes.NoSuchEntityException{})
+				i.CreateRole(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *iam.CreateRoleInput, _ ...func(*iam.Options)) (*iam.CreateRoleOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.RoleName).To(Equal(roleName))
+					g.Expect(*input.AssumeRolePolicyDocument).To(ContainSubstring("pods.eks.amazonaws.com"))
+					g.Expect(*input.AssumeRolePolicyDocument).To(ContainSubstring("sts:TagSession"))
+					return &iam.CreateRoleOutput{Role: &iamtypes.Role{
+						RoleName: roleName,
+						Arn:      aws.String(podIdentityRoleARN),
+						Tags:     []iamtypes.Tag{{Key: aws.String("kubernetes.io/cluster/test-cluster"), Value: aws.String("owned")}},
+					}}, nil
+				})
+				i.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				i.GetPolicy(gomock.Any(), gomock.Any()).Return(&iam.GetPolicyOutput{}, nil)
+				i.AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+					RoleName:  roleName,
+					PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+				m.CreatePodIdentityAssociation(gomock.Any(), gomock.Any()).Return(&eks.CreatePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{AssociationId: aws.String("a-1")},
+				}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{Namespace: "default", ServiceAccountName: "app", AssociationID: "a-1", RoleARN: podIdentityRoleARN},
+			},
+		},
+		{
+			name: "fails to create a role when IAM is disabled",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RolePolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder, i *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.Any(), gomock.Any()).Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+				i.GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      clusterName,
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          clusterName,
+					PodIdentityAssociations: tc.associations,
+				},
+				Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+					Ready: true,
+				},
+			}
+
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      clusterName,
+					},
+				},
+				ControlPlane: controlPlane,
+				EnableIAM:    tc.enableIAM,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT(), iamMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.IAMClient = iamMock
+
+			err = s.reconcilePodIdentityAssociations(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(controlPlane.Status.PodIdentityAssociations).To(Equal(tc.expectStatus))
+		})
+	}
+}
+
+func TestPodIdentityAgentAddon(t *testing.T) {
+	g := NewWithT(t)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			PodIdentityAgent: &ekscontrolplanev1.PodIdentityAgent{Version: "v1.3.4-eksbuild.1"},
+		},
+	}
+	s := &Service{scope: &scope.ManagedControlPlaneScope{ControlPlane: controlPlane}}
+
+	agent := s.podIdentityAgentAddon(nil)
+	g.Expect(agent).NotTo(BeNil())
+	g.Expect(agent.Name).To(Equal(eksaddons.PodIdentityAgentAddonName))
+	g.Expect(agent.Version).To(Equal("v1.3.4-eksbuild.1"))
+	g.Expect(*agent.ConflictResolution).To(Equal(ekscontrolplanev1.AddonResolutionOverwrite))
+
+	g.Expect(s.podIdentityAgentAddon([]ekscontrolplanev1.Addon{{Name: eksaddons.PodIdentityAgentAddonName}})).To(BeNil())
+
+	controlPlane.Spec.PodIdentityAgent = nil
+	g.Expect(s.podIdentityAgentAddon(nil)).To(BeNil())
+}
+
+func TestKarpenterPodIdentityAndAccessEntry(t *testing.T) {
+	g := NewWithT(t)
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			PodIdentityAssociations: []ekscontrolplanev1.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "app", RoleARN: podIdentityRoleARN},
+			},
+			Karpenter: &infrav1.KarpenterSpec{
+				NodeRole:                "karpenter-node",
+				ServiceAccountNamespace: "kube-system",
+				ServiceAccountName:      "karpenter",
+			},
+		},
+	}
+	s := &Service{scope: &scope.ManagedControlPlaneScope{ControlPlane: controlPlane}}
+
+	// Nothing is added until the roles of Karpenter are known.
+	g.Expect(s.podIdentityAssociations()).To(HaveLen(1))
+	g.Expect(s.accessEntries()).To(BeEmpty())
+
+	controlPlane.Status.Karpenter = &infrav1.KarpenterStatus{
+		ControllerRoleARN: otherPodIdentityRoleARN,
+		NodeRoleARN:       "arn:aws:iam::123456789012:role/karpenter-node",
+	}
+	g.Expect(s.podIdentityAssociations()).To(Equal([]ekscontrolplanev1.PodIdentityAssociation{
+		{Namespace: "default", ServiceAccountName: "app", RoleARN: podIdentityRoleARN},
+		{Namespace: "kube-system", ServiceAccountName: "karpenter", RoleARN: otherPodIdentityRoleARN},
+	}))
+	g.Expect(controlPlane.Spec.PodIdentityAssociations).To(HaveLen(1))
+	g.Expect(s.accessEntries()).To(Equal([]ekscontrolplanev1.AccessEntry{
+		{PrincipalARN: "arn:aws:iam::123456789012:role/karpenter-node", Type: ekscontrolplanev1.AccessEntryTypeEC2Linux},
+	}))
+
+	// An access entry of the spec for the node role takes precedence.
+	controlPlane.Spec.AccessEntries = []ekscontrolplanev1.AccessEntry{
+		{PrincipalARN: "arn:aws:iam::123456789012:role/karpenter-node", Type: ekscontrolplanev1.AccessEntryTypeEC2},
+	}
+	g.Expect(s.accessEntries()).To(Equal(controlPlane.Spec.AccessEntries))
+}
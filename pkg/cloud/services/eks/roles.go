@@ -70,6 +70,11 @@ func FargateRolePoliciesUSGov() []string {
 	}
 }
 
+// autoModeEnabled returns whether EKS Auto Mode is enabled for the cluster.
+func (s *Service) autoModeEnabled() bool {
+	return s.scope.ControlPlane.Spec.AutoMode != nil && s.scope.ControlPlane.Spec.AutoMode.Enabled
+}
+
 func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS Control Plane IAM Role")
 
@@ -95,7 +100,11 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 			return fmt.Errorf("getting role %s: %w", *s.scope.ControlPlane.Spec.RoleName, ErrClusterRoleNotFound)
 		}
 
-		role, err = s.CreateRole(ctx, *s.scope.ControlPlane.Spec.RoleName, s.scope.Name(), eksiam.ControlPlaneTrustRelationship(false), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		trustRelationship := eksiam.ControlPlaneTrustRelationship(false)
+		if s.autoModeEnabled() {
+			trustRelationship = eksiam.AutoModeControlPlaneTrustRelationship()
+		}
+		role, err = s.CreateRole(ctx, *s.scope.ControlPlane.Spec.RoleName, s.scope.Name(), trustRelationship, s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
 		if err != nil {
 			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create control plane IAM role %q: %v", *s.scope.ControlPlane.Spec.RoleName, err)
 
@@ -114,6 +123,11 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 	policies := []string{
 		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSClusterPolicy", s.scope.Partition()),
 	}
+	if s.autoModeEnabled() {
+		for _, policy := range []string{"AmazonEKSComputePolicy", "AmazonEKSBlockStoragePolicy", "AmazonEKSLoadBalancingPolicy", "AmazonEKSNetworkingPolicy"} {
+			policies = append(policies, fmt.Sprintf("arn:%s:iam::aws:policy/%s", s.scope.Partition(), policy))
+		}
+	}
 
 	if s.scope.ControlPlane.Spec.RoleAdditionalPolicies != nil {
 		if !s.scope.AllowAdditionalRoles() && len(*s.scope.ControlPlane.Spec.RoleAdditionalPolicies) > 0 {
@@ -170,6 +184,108 @@ func (s *Service) deleteControlPlaneIAMRole(ctx context.Context) error {
 	return nil
 }
 
+// podIdentityRoleName returns the name of the role created for the pod identity association of a service account.
+func (s *Service) podIdentityRoleName(namespace, serviceAccount string) (string, error) {
+	roleName, err := eks.GenerateEKSName(
+		"pod-identity-role",
+		fmt.Sprintf("%s-%s-%s", s.scope.KubernetesClusterName(), namespace, serviceAccount),
+		maxIAMRoleNameLength,
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate IAM role name")
+	}
+	return roleName, nil
+}
+
+// reconcilePodIdentityRole returns the ARN of the role of a pod identity association, creating the role
+// with the policies of the association when no role ARN is given.
+func (s *Service) reconcilePodIdentityRole(ctx context.Context, association ekscontrolplanev1.PodIdentityAssociation) (string, error) {
+	if association.RoleARN != "" {
+		return association.RoleARN, nil
+	}
+
+	roleName, err := s.podIdentityRoleName(association.Namespace, association.ServiceAccountName)
+	if err != nil {
+		return "", err
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", err
+		}
+
+		if !s.scope.EnableIAM() {
+			return "", fmt.Errorf("creating role %s: %w", roleName, ErrPodIdentityRoleCreationDisabled)
+		}
+
+		role, err = s.CreateRole(ctx, roleName, s.scope.Name(), eksiam.PodIdentityTrustRelationship(), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create pod identity IAM role %q: %v", roleName, err)
+			return "", fmt.Errorf("creating role %s: %w", roleName, err)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created pod identity IAM role %q", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, pod identity role policy assignment as role is unmanaged", "role-name", roleName)
+		return aws.ToString(role.Arn), nil
+	}
+
+	if _, err := s.EnsurePoliciesAttached(ctx, role, association.RolePolicies); err != nil {
+		return "", errors.Wrapf(err, "error ensuring policies are attached: %v", association.RolePolicies)
+	}
+
+	return aws.ToString(role.Arn), nil
+}
+
+// deletePodIdentityRole deletes the role created for the pod identity association of a service account, if any.
+func (s *Service) deletePodIdentityRole(ctx context.Context, namespace, serviceAccount string) error {
+	roleName, err := s.podIdentityRoleName(namespace, serviceAccount)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "getting pod identity iam role %s", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.Debug("Skipping, pod identity iam role deletion as role is unmanaged", "role-name", roleName)
+		return nil
+	}
+
+	if err := s.DeleteRole(ctx, roleName); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete pod identity IAM role %q: %v", roleName, err)
+		return err
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted pod identity IAM role %q", roleName)
+	return nil
+}
+
+// deletePodIdentityRoles deletes the roles created for the pod identity associations of the cluster.
+func (s *Service) deletePodIdentityRoles(ctx context.Context) error {
+	if !s.scope.EnableIAM() {
+		return nil
+	}
+
+	for _, association := range s.scope.ControlPlane.Spec.PodIdentityAssociations {
+		if association.RoleARN != "" {
+			continue
+		}
+		if err := s.deletePodIdentityRole(ctx, association.Namespace, association.ServiceAccountName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *NodegroupService) reconcileNodegroupIAMRole(ctx context.Context) error {
 	s.scope.Debug("Reconciling EKS Nodegroup IAM Role")
 
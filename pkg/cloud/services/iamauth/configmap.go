@@ -88,6 +88,10 @@ func (b *configMapBackend) MapUser(mapping ekscontrolplanev1.UserMapping) error
 	return b.saveAuthConfig(authConfig)
 }
 
+func (b *configMapBackend) Mappings() (*ekscontrolplanev1.IAMAuthenticatorConfig, error) {
+	return b.getAuthConfig()
+}
+
 func (b *configMapBackend) getAuthConfig() (*ekscontrolplanev1.IAMAuthenticatorConfig, error) {
 	ctx := context.Background()
 
@@ -19,7 +19,9 @@ package iamauth
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	iamauthv1 "sigs.k8s.io/aws-iam-authenticator/pkg/mapper/crd/apis/iamauthenticator/v1alpha1"
@@ -104,6 +106,32 @@ func (b *crdBackend) MapUser(mapping ekscontrolplanev1.UserMapping) error {
 	return b.client.Create(ctx, iamMapping)
 }
 
+func (b *crdBackend) Mappings() (*ekscontrolplanev1.IAMAuthenticatorConfig, error) {
+	mappingList := iamauthv1.IAMIdentityMappingList{}
+	if err := b.client.List(context.TODO(), &mappingList); err != nil {
+		return nil, fmt.Errorf("getting list of mappings: %w", err)
+	}
+
+	authConfig := &ekscontrolplanev1.IAMAuthenticatorConfig{
+		RoleMappings: []ekscontrolplanev1.RoleMapping{},
+		UserMappings: []ekscontrolplanev1.UserMapping{},
+	}
+	for _, mapping := range mappingList.Items {
+		kubernetesMapping := ekscontrolplanev1.KubernetesMapping{
+			UserName: mapping.Spec.Username,
+			Groups:   mapping.Spec.Groups,
+		}
+		// The ARNs of IAM users have a user resource type, the other mappings are roles.
+		if parsed, err := arn.Parse(mapping.Spec.ARN); err == nil && strings.HasPrefix(parsed.Resource, "user/") {
+			authConfig.UserMappings = append(authConfig.UserMappings, ekscontrolplanev1.UserMapping{UserARN: mapping.Spec.ARN, KubernetesMapping: kubernetesMapping})
+			continue
+		}
+		authConfig.RoleMappings = append(authConfig.RoleMappings, ekscontrolplanev1.RoleMapping{RoleARN: mapping.Spec.ARN, KubernetesMapping: kubernetesMapping})
+	}
+
+	return authConfig, nil
+}
+
 func roleMappingMatchesIAMMap(mapping ekscontrolplanev1.RoleMapping, iamMapping *iamauthv1.IAMIdentityMapping) bool {
 	if mapping.RoleARN != iamMapping.Spec.ARN {
 		return false
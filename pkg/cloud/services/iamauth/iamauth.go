@@ -42,6 +42,8 @@ type AuthenticatorBackend interface {
 	MapRole(mapping ekscontrolplanev1.RoleMapping) error
 	// MapUser is used to map a user ARN to a user and set of groups
 	MapUser(mapping ekscontrolplanev1.UserMapping) error
+	// Mappings returns the role and user mappings of the backend
+	Mappings() (*ekscontrolplanev1.IAMAuthenticatorConfig, error)
 }
 
 // BackendType is a type that represents the different aws-iam-authenticator backends.
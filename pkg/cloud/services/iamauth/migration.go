@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamauth
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+)
+
+const (
+	// fargateNodeUserName is the username mapped by EKS to the pod execution roles of Fargate profiles.
+	fargateNodeUserName = "system:node:{{SessionName}}"
+	// systemMastersGroup is the Kubernetes group granting cluster admin permissions.
+	systemMastersGroup = "system:masters"
+
+	// clusterAdminAccessPolicyFormat is the ARN format of the access policy equivalent to the system:masters group,
+	// which depends on the partition.
+	clusterAdminAccessPolicyFormat = "arn:%s:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"
+
+	systemNodeProxierGroup    = "system:node-proxier"
+	windowsNodeKubeProxyGroup = "eks:kube-proxy-windows"
+	reservedKubernetesPrefix  = "system:"
+)
+
+// configMapReleased returns whether the aws-auth ConfigMap is no longer managed, which requires the authentication
+// mode of the cluster to have been switched to api.
+func (s *Service) configMapReleased() bool {
+	migration := s.scope.ConfigMapMigration()
+	status := s.scope.ConfigMapMigrationStatus()
+	return migration != nil && migration.Step == ekscontrolplanev1.ConfigMapMigrationStepReleaseConfigMap &&
+		status != nil && status.Step.AtLeast(ekscontrolplanev1.ConfigMapMigrationStepAPI)
+}
+
+// reconcileConfigMapMigration records the access entries equivalent to the mappings of the aws-auth ConfigMap, which
+// are created by the EKS service, and whether the ConfigMap is still managed. The mappings of the ConfigMap include
+// the ones which haven't been created by the provider.
+func (s *Service) reconcileConfigMapMigration(authBackend AuthenticatorBackend, mappings *ekscontrolplanev1.IAMAuthenticatorConfig) error {
+	migration := s.scope.ConfigMapMigration()
+	if migration == nil {
+		s.scope.SetConfigMapMigrationStatus(nil)
+		return nil
+	}
+
+	current, err := authBackend.Mappings()
+	if err != nil {
+		return fmt.Errorf("getting aws-iam-authenticator mappings: %w", err)
+	}
+	all := &ekscontrolplanev1.IAMAuthenticatorConfig{
+		RoleMappings: append(slices.Clone(mappings.RoleMappings), current.RoleMappings...),
+		UserMappings: append(slices.Clone(mappings.UserMappings), current.UserMappings...),
+	}
+
+	status := &ekscontrolplanev1.ConfigMapMigrationStatus{}
+	if previous := s.scope.ConfigMapMigrationStatus(); previous != nil {
+		status.Step = previous.Step
+	}
+	status.AccessEntries, status.UnmigratedMappings = accessEntriesForMappings(all, s.scope.AccessEntries(), endpoints.GetPartitionFromRegion(s.scope.Region()))
+
+	switch {
+	case s.configMapReleased():
+		if status.Step != ekscontrolplanev1.ConfigMapMigrationStepReleaseConfigMap {
+			s.scope.Info("Released aws-auth ConfigMap", "cluster", s.scope.Name())
+		}
+		status.Step = ekscontrolplanev1.ConfigMapMigrationStepReleaseConfigMap
+	case status.Step == ekscontrolplanev1.ConfigMapMigrationStepReleaseConfigMap:
+		// Going back to the API step resumes managing the ConfigMap.
+		s.scope.Info("Resumed managing aws-auth ConfigMap", "cluster", s.scope.Name())
+		status.Step = ekscontrolplanev1.ConfigMapMigrationStepAPI
+	}
+
+	s.scope.SetConfigMapMigrationStatus(status)
+	return nil
+}
+
+// accessEntriesForMappings returns the access entries equivalent to role and user mappings, sorted by principal, and
+// the mappings which have no equivalent entry. Node mappings become EC2 or Fargate entries, the system:masters group
+// the cluster admin access policy of the partition, and the other groups Kubernetes groups of standard entries. The
+// other groups and users reserved by Kubernetes can't be given to access entries. The principals which already have
+// one of the given access entries are skipped, as they keep it.
+func accessEntriesForMappings(mappings *ekscontrolplanev1.IAMAuthenticatorConfig, accessEntries []ekscontrolplanev1.AccessEntry, partition string) ([]ekscontrolplanev1.AccessEntry, []string) {
+	kubernetesMappings := map[string][]ekscontrolplanev1.KubernetesMapping{}
+	addMapping := func(principalARN string, mapping ekscontrolplanev1.KubernetesMapping) {
+		for _, existing := range kubernetesMappings[principalARN] {
+			if existing.UserName == mapping.UserName && slices.Equal(existing.Groups, mapping.Groups) {
+				return
+			}
+		}
+		kubernetesMappings[principalARN] = append(kubernetesMappings[principalARN], mapping)
+	}
+	for _, mapping := range mappings.RoleMappings {
+		addMapping(mapping.RoleARN, mapping.KubernetesMapping)
+	}
+	for _, mapping := range mappings.UserMappings {
+		addMapping(mapping.UserARN, mapping.KubernetesMapping)
+	}
+
+	entries := []ekscontrolplanev1.AccessEntry{}
+	unmigrated := []string{}
+	for principalARN, principalMappings := range kubernetesMappings {
+		if slices.ContainsFunc(accessEntries, func(accessEntry ekscontrolplanev1.AccessEntry) bool {
+			return accessEntry.PrincipalARN == principalARN
+		}) {
+			continue
+		}
+		entry, err := accessEntryForMappings(principalARN, principalMappings, partition)
+		if err != nil {
+			unmigrated = append(unmigrated, fmt.Sprintf("%s: %v", principalARN, err))
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].PrincipalARN < entries[j].PrincipalARN })
+	sort.Strings(unmigrated)
+	return entries, unmigrated
+}
+
+func accessEntryForMappings(principalARN string, mappings []ekscontrolplanev1.KubernetesMapping, partition string) (*ekscontrolplanev1.AccessEntry, error) {
+	entry := &ekscontrolplanev1.AccessEntry{
+		PrincipalARN: principalARN,
+		Type:         ekscontrolplanev1.AccessEntryTypeStandard,
+	}
+
+	for _, mapping := range mappings {
+		if entryType := nodeAccessEntryType(mapping); entryType != "" {
+			if len(mappings) > 1 {
+				return nil, errors.New("node mapped with other users or groups")
+			}
+			entry.Type = entryType
+			return entry, nil
+		}
+
+		if mapping.UserName != "" {
+			if strings.HasPrefix(mapping.UserName, reservedKubernetesPrefix) {
+				return nil, fmt.Errorf("reserved user %s", mapping.UserName)
+			}
+			if entry.Username != "" && entry.Username != mapping.UserName {
+				return nil, errors.New("mapped to several users")
+			}
+			entry.Username = mapping.UserName
+		}
+
+		for _, group := range mapping.Groups {
+			switch {
+			case group == systemMastersGroup:
+				if len(entry.AccessPolicies) == 0 {
+					entry.AccessPolicies = append(entry.AccessPolicies, ekscontrolplanev1.AccessPolicyReference{
+						PolicyARN:   fmt.Sprintf(clusterAdminAccessPolicyFormat, partition),
+						AccessScope: ekscontrolplanev1.AccessScope{Type: ekscontrolplanev1.AccessScopeTypeCluster},
+					})
+				}
+			case strings.HasPrefix(group, reservedKubernetesPrefix):
+				return nil, fmt.Errorf("reserved group %s", group)
+			case !slices.Contains(entry.KubernetesGroups, group):
+				entry.KubernetesGroups = append(entry.KubernetesGroups, group)
+			}
+		}
+	}
+
+	return entry, nil
+}
+
+// nodeAccessEntryType returns the type of the access entry of a node mapping, or an empty type for other mappings.
+func nodeAccessEntryType(mapping ekscontrolplanev1.KubernetesMapping) ekscontrolplanev1.AccessEntryType {
+	if !slices.Contains(mapping.Groups, systemNodesGroup) {
+		return ""
+	}
+	for _, group := range mapping.Groups {
+		if group != systemBootstrappersGroup && group != systemNodesGroup && group != systemNodeProxierGroup && group != windowsNodeKubeProxyGroup {
+			return ""
+		}
+	}
+
+	switch {
+	case mapping.UserName == fargateNodeUserName:
+		return ekscontrolplanev1.AccessEntryTypeFargateLinux
+	case mapping.UserName != EC2NodeUserName:
+		return ""
+	case slices.Contains(mapping.Groups, windowsNodeKubeProxyGroup):
+		return ekscontrolplanev1.AccessEntryTypeEC2Windows
+	default:
+		return ekscontrolplanev1.AccessEntryTypeEC2Linux
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamauth
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestAccessEntriesForMappings(t *testing.T) {
+	nodeRoleARN := "arn:aws:iam::000000000000:role/KubernetesNode"
+	adminRoleARN := "arn:aws:iam::000000000000:role/Admin"
+	aliceARN := "arn:aws:iam::000000000000:user/Alice"
+
+	testCases := []struct {
+		name             string
+		mappings         *ekscontrolplanev1.IAMAuthenticatorConfig
+		accessEntries    []ekscontrolplanev1.AccessEntry
+		partition        string
+		expectEntries    []ekscontrolplanev1.AccessEntry
+		expectUnmigrated []string
+	}{
+		{
+			name: "node roles become EC2 entries",
+			mappings: &ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{
+					{RoleARN: nodeRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: EC2NodeUserName, Groups: NodeGroups}},
+					// The same mapping from the aws-auth ConfigMap.
+					{RoleARN: nodeRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: EC2NodeUserName, Groups: NodeGroups}},
+					{RoleARN: "arn:aws:iam::000000000000:role/Windows", KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+						UserName: EC2NodeUserName,
+						Groups:   []string{"eks:kube-proxy-windows", "system:bootstrappers", "system:nodes"},
+					}},
+					{RoleARN: "arn:aws:iam::000000000000:role/Fargate", KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+						UserName: "system:node:{{SessionName}}",
+						Groups:   []string{"system:bootstrappers", "system:nodes", "system:node-proxier"},
+					}},
+				},
+			},
+			expectEntries: []ekscontrolplanev1.AccessEntry{
+				{PrincipalARN: "arn:aws:iam::000000000000:role/Fargate", Type: ekscontrolplanev1.AccessEntryTypeFargateLinux},
+				{PrincipalARN: nodeRoleARN, Type: ekscontrolplanev1.AccessEntryTypeEC2Linux},
+				{PrincipalARN: "arn:aws:iam::000000000000:role/Windows", Type: ekscontrolplanev1.AccessEntryTypeEC2Windows},
+			},
+			expectUnmigrated: []string{},
+		},
+		{
+			name: "system:masters becomes the cluster admin policy",
+			mappings: &ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{
+					{RoleARN: adminRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "admin", Groups: []string{"system:masters", "admins"}}},
+				},
+				UserMappings: []ekscontrolplanev1.UserMapping{
+					{UserARN: aliceARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "alice", Groups: []string{"developers"}}},
+				},
+			},
+			expectEntries: []ekscontrolplanev1.AccessEntry{
+				{
+					PrincipalARN:     adminRoleARN,
+					Type:             ekscontrolplanev1.AccessEntryTypeStandard,
+					Username:         "admin",
+					KubernetesGroups: []string{"admins"},
+					AccessPolicies: []ekscontrolplanev1.AccessPolicyReference{{
+						PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy",
+						AccessScope: ekscontrolplanev1.AccessScope{Type: ekscontrolplanev1.AccessScopeTypeCluster},
+					}},
+				},
+				{PrincipalARN: aliceARN, Type: ekscontrolplanev1.AccessEntryTypeStandard, Username: "alice", KubernetesGroups: []string{"developers"}},
+			},
+			expectUnmigrated: []string{},
+		},
+		{
+			name: "system:masters becomes the cluster admin policy of the partition",
+			mappings: &ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{
+					{RoleARN: "arn:aws-cn:iam::000000000000:role/Admin", KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "admin", Groups: []string{"system:masters"}}},
+				},
+			},
+			partition: "aws-cn",
+			expectEntries: []ekscontrolplanev1.AccessEntry{
+				{
+					PrincipalARN: "arn:aws-cn:iam::000000000000:role/Admin",
+					Type:         ekscontrolplanev1.AccessEntryTypeStandard,
+					Username:     "admin",
+					AccessPolicies: []ekscontrolplanev1.AccessPolicyReference{{
+						PolicyARN:   "arn:aws-cn:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy",
+						AccessScope: ekscontrolplanev1.AccessScope{Type: ekscontrolplanev1.AccessScopeTypeCluster},
+					}},
+				},
+			},
+			expectUnmigrated: []string{},
+		},
+		{
+			name: "reserved groups and users aren't migrated",
+			mappings: &ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{
+					{RoleARN: adminRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "admin", Groups: []string{"system:authenticated"}}},
+					{RoleARN: nodeRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: EC2NodeUserName, Groups: NodeGroups}},
+					{RoleARN: nodeRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "deployer", Groups: []string{"deployers"}}},
+				},
+				UserMappings: []ekscontrolplanev1.UserMapping{
+					{UserARN: aliceARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "system:kube-scheduler"}},
+				},
+			},
+			expectEntries: []ekscontrolplanev1.AccessEntry{},
+			expectUnmigrated: []string{
+				adminRoleARN + ": reserved group system:authenticated",
+				nodeRoleARN + ": node mapped with other users or groups",
+				aliceARN + ": reserved user system:kube-scheduler",
+			},
+		},
+		{
+			name: "principals with an access entry aren't migrated",
+			mappings: &ekscontrolplanev1.IAMAuthenticatorConfig{
+				RoleMappings: []ekscontrolplanev1.RoleMapping{
+					{RoleARN: adminRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "admin", Groups: []string{"system:authenticated"}}},
+					{RoleARN: nodeRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: EC2NodeUserName, Groups: NodeGroups}},
+				},
+				UserMappings: []ekscontrolplanev1.UserMapping{
+					{UserARN: aliceARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "alice", Groups: []string{"developers"}}},
+				},
+			},
+			accessEntries: []ekscontrolplanev1.AccessEntry{
+				{PrincipalARN: adminRoleARN, Type: ekscontrolplanev1.AccessEntryTypeStandard, Username: "admin"},
+				{PrincipalARN: aliceARN, Type: ekscontrolplanev1.AccessEntryTypeStandard, Username: "alice"},
+			},
+			expectEntries: []ekscontrolplanev1.AccessEntry{
+				{PrincipalARN: nodeRoleARN, Type: ekscontrolplanev1.AccessEntryTypeEC2Linux},
+			},
+			expectUnmigrated: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			partition := tc.partition
+			if partition == "" {
+				partition = "aws"
+			}
+			entries, unmigrated := accessEntriesForMappings(tc.mappings, tc.accessEntries, partition)
+			g.Expect(entries).To(Equal(tc.expectEntries))
+			g.Expect(unmigrated).To(Equal(tc.expectUnmigrated))
+		})
+	}
+}
+
+func TestReconcileConfigMapMigrationUnblockedByAccessEntries(t *testing.T) {
+	g := NewWithT(t)
+
+	adminRoleARN := "arn:aws:iam::000000000000:role/Admin"
+	mappings := &ekscontrolplanev1.IAMAuthenticatorConfig{
+		RoleMappings: []ekscontrolplanev1.RoleMapping{
+			{RoleARN: adminRoleARN, KubernetesMapping: ekscontrolplanev1.KubernetesMapping{UserName: "admin", Groups: []string{"system:authenticated"}}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(ekscontrolplanev1.AddToScheme(scheme)).To(Succeed())
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster"},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+			AccessConfig: &ekscontrolplanev1.AccessConfig{
+				AuthenticationMode: ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+				ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigration{Step: ekscontrolplanev1.ConfigMapMigrationStepAPI},
+			},
+		},
+		Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+			ConfigMapMigration: &ekscontrolplanev1.ConfigMapMigrationStatus{Step: ekscontrolplanev1.ConfigMapMigrationStepAccessEntries},
+		},
+	}
+	managedScope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client:       client,
+		Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster"}},
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	s := &Service{scope: managedScope}
+	backend := &configMapBackend{client: fake.NewClientBuilder().Build()}
+
+	g.Expect(s.reconcileConfigMapMigration(backend, mappings)).To(Succeed())
+	g.Expect(controlPlane.Status.ConfigMapMigration.UnmigratedMappings).To(Equal([]string{adminRoleARN + ": reserved group system:authenticated"}))
+
+	// Giving the principal an access entry in the spec unblocks the api step.
+	controlPlane.Spec.AccessEntries = []ekscontrolplanev1.AccessEntry{
+		{PrincipalARN: adminRoleARN, Type: ekscontrolplanev1.AccessEntryTypeStandard, Username: "admin"},
+	}
+	g.Expect(s.reconcileConfigMapMigration(backend, mappings)).To(Succeed())
+	g.Expect(controlPlane.Status.ConfigMapMigration.AccessEntries).To(BeEmpty())
+	g.Expect(controlPlane.Status.ConfigMapMigration.UnmigratedMappings).To(BeEmpty())
+	g.Expect(controlPlane.Status.ConfigMapMigration.Step).To(Equal(ekscontrolplanev1.ConfigMapMigrationStepAccessEntries))
+}
@@ -51,40 +51,47 @@ func (s *Service) ReconcileIAMAuthenticator(ctx context.Context) error {
 		s.scope.Error(err, "getting roles for remote workers")
 		return fmt.Errorf("getting roles for remote workers: %w", err)
 	}
+
+	mappings := &ekscontrolplanev1.IAMAuthenticatorConfig{}
 	for roleName := range nodeRoles {
 		roleARN, err := s.getARNForRole(ctx, roleName)
 		if err != nil {
 			return fmt.Errorf("failed to get ARN for role %s: %w", roleName, err)
 		}
-		nodesRoleMapping := ekscontrolplanev1.RoleMapping{
+		mappings.RoleMappings = append(mappings.RoleMappings, ekscontrolplanev1.RoleMapping{
 			RoleARN: roleARN,
 			KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
 				UserName: EC2NodeUserName,
 				Groups:   NodeGroups,
 			},
-		}
-		s.scope.Debug("Mapping node IAM role", "iam-role", nodesRoleMapping.RoleARN, "user", nodesRoleMapping.UserName)
-		if err := authBackend.MapRole(nodesRoleMapping); err != nil {
-			return fmt.Errorf("mapping iam node role: %w", err)
-		}
+		})
 	}
-
-	s.scope.Debug("Mapping additional IAM roles and users")
 	iamCfg := s.scope.IAMAuthConfig()
-	for _, roleMapping := range iamCfg.RoleMappings {
-		s.scope.Debug("Mapping IAM role", "iam-role", roleMapping.RoleARN, "user", roleMapping.UserName)
-		if err := authBackend.MapRole(roleMapping); err != nil {
-			return fmt.Errorf("mapping iam role: %w", err)
+	mappings.RoleMappings = append(mappings.RoleMappings, iamCfg.RoleMappings...)
+	mappings.UserMappings = append(mappings.UserMappings, iamCfg.UserMappings...)
+
+	if s.configMapReleased() {
+		s.scope.Debug("Skipping mapping IAM roles and users, aws-auth ConfigMap has been released by the migration to access entries")
+	} else {
+		for _, roleMapping := range mappings.RoleMappings {
+			s.scope.Debug("Mapping IAM role", "iam-role", roleMapping.RoleARN, "user", roleMapping.UserName)
+			if err := authBackend.MapRole(roleMapping); err != nil {
+				return fmt.Errorf("mapping iam role: %w", err)
+			}
 		}
-	}
 
-	for _, userMapping := range iamCfg.UserMappings {
-		s.scope.Debug("Mapping IAM user", "iam-user", userMapping.UserARN, "user", userMapping.UserName)
-		if err := authBackend.MapUser(userMapping); err != nil {
-			return fmt.Errorf("mapping iam user: %w", err)
+		for _, userMapping := range mappings.UserMappings {
+			s.scope.Debug("Mapping IAM user", "iam-user", userMapping.UserARN, "user", userMapping.UserName)
+			if err := authBackend.MapUser(userMapping); err != nil {
+				return fmt.Errorf("mapping iam user: %w", err)
+			}
 		}
 	}
 
+	if err := s.reconcileConfigMapMigration(authBackend, mappings); err != nil {
+		return fmt.Errorf("reconciling aws-auth ConfigMap migration: %w", err)
+	}
+
 	s.scope.Info("Reconciled aws-iam-authenticator configuration", "cluster", klog.KRef("", s.scope.Name()))
 
 	return nil
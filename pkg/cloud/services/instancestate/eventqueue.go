@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/pkg/errors"
+
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+)
+
+const (
+	// eventQueueMessageRetentionSeconds is the retention of the messages of an event queue. Events older than
+	// that are not relevant anymore.
+	eventQueueMessageRetentionSeconds = "300"
+)
+
+// EventQueueRule is an EventBridge rule forwarding the events with the given source and detail type to an event queue.
+type EventQueueRule struct {
+	// Name is the name of the rule.
+	Name string
+	// Source is the source of the events, e.g. aws.ec2.
+	Source string
+	// DetailType is the detail type of the events, e.g. EC2 Spot Instance Interruption Warning.
+	DetailType string
+}
+
+// ReconcileEventQueue creates the SQS queue with the given name, encrypted with an SQS managed key, and the
+// EventBridge rules forwarding events to it.
+func (s Service) ReconcileEventQueue(ctx context.Context, queueName string, rules []EventQueueRule, tags map[string]string) error {
+	attrs := map[string]string{
+		string(sqstypes.QueueAttributeNameMessageRetentionPeriod): eventQueueMessageRetentionSeconds,
+		string(sqstypes.QueueAttributeNameSqsManagedSseEnabled):   "true",
+	}
+	if err := s.createQueue(ctx, queueName, attrs, tags); err != nil {
+		return err
+	}
+
+	queueURL, queueArn, hasPolicy, err := s.getQueue(ctx, queueName)
+	if err != nil {
+		return err
+	}
+	if !hasPolicy {
+		// allow EventBridge to send messages to the queue
+		if err := s.setQueuePolicy(ctx, queueURL, eventQueuePolicy(queueArn)); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range rules {
+		if err := s.reconcileEventQueueRule(ctx, rule, tags); err != nil {
+			return err
+		}
+		if err := s.ensureRuleTarget(ctx, rule.Name, queueName, queueArn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileEventQueueRule creates a rule forwarding the events of its source and detail type, unless it exists.
+func (s Service) reconcileEventQueueRule(ctx context.Context, rule EventQueueRule, tags map[string]string) error {
+	_, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{Name: aws.String(rule.Name)})
+	if err == nil {
+		return nil
+	}
+	if !resourceNotFoundError(err) {
+		return errors.Wrapf(err, "unable to describe rule %s", rule.Name)
+	}
+
+	data, err := json.Marshal(eventPattern{
+		Source:     []string{rule.Source},
+		DetailType: []string{rule.DetailType},
+	})
+	if err != nil {
+		return err
+	}
+	// For testing, we need sorted keys
+	eventBridgeTags := make([]eventbridgetypes.Tag, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		eventBridgeTags = append(eventBridgeTags, eventbridgetypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	_, err = s.EventBridgeClient.PutRule(ctx, &eventbridge.PutRuleInput{
+		Name:         aws.String(rule.Name),
+		EventPattern: aws.String(string(data)),
+		State:        eventbridgetypes.RuleStateEnabled,
+		Tags:         eventBridgeTags,
+	})
+	return errors.Wrapf(err, "unable to create rule %s", rule.Name)
+}
+
+// DeleteEventQueue deletes the given EventBridge rules and the SQS queue they forward events to.
+func (s Service) DeleteEventQueue(ctx context.Context, queueName string, rules []EventQueueRule) error {
+	for _, rule := range rules {
+		if err := s.deleteRule(ctx, rule.Name, queueName); err != nil {
+			return err
+		}
+	}
+	return s.deleteQueue(ctx, queueName)
+}
+
+func eventQueuePolicy(queueArn string) iamv1.PolicyDocument {
+	return iamv1.PolicyDocument{
+		Version: iamv1.CurrentVersion,
+		ID:      queueArn,
+		Statement: iamv1.Statements{
+			iamv1.StatementEntry{
+				Sid:       "EventBridgeSendMessage",
+				Effect:    iamv1.EffectAllow,
+				Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com", "sqs.amazonaws.com"}},
+				Action:    iamv1.Actions{"sqs:SendMessage"},
+				Resource:  iamv1.Resources{queueArn},
+			},
+		},
+	}
+}
@@ -34,39 +34,86 @@ import (
 func (s *Service) reconcileSQSQueue(ctx context.Context) error {
 	attrs := make(map[string]string)
 	attrs[string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds)] = "20"
+	return s.createQueue(ctx, GenerateQueueName(s.scope.Name()), attrs, nil)
+}
 
+func (s *Service) deleteSQSQueue(ctx context.Context) error {
+	return s.deleteQueue(ctx, GenerateQueueName(s.scope.Name()))
+}
+
+// createQueue creates a queue with the given name, unless it already exists.
+func (s Service) createQueue(ctx context.Context, queueName string, attrs, tags map[string]string) error {
 	_, err := s.SQSClient.CreateQueue(ctx, &sqs.CreateQueueInput{
-		QueueName:  aws.String(GenerateQueueName(s.scope.Name())),
+		QueueName:  aws.String(queueName),
 		Attributes: attrs,
+		Tags:       tags,
 	})
-
 	smithyErr := awserrors.ParseSmithyError(err)
 	if smithyErr != nil {
 		if smithyErr.ErrorCode() == (&sqstypes.QueueNameExists{}).ErrorCode() {
 			return nil
 		}
 	}
-	return errors.Wrap(err, "unable to create new queue")
+	return errors.Wrapf(err, "unable to create new queue %s", queueName)
 }
 
-func (s *Service) deleteSQSQueue(ctx context.Context) error {
-	resp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(GenerateQueueName(s.scope.Name()))})
+// deleteQueue deletes the queue with the given name, if it exists.
+func (s Service) deleteQueue(ctx context.Context, queueName string) error {
+	resp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(queueName)})
 	if err != nil {
 		if queueNotFoundError(err) {
 			return nil
 		}
-		return errors.Wrap(err, "unable to get queue URL")
+		return errors.Wrapf(err, "unable to get URL of queue %s", queueName)
 	}
+
 	_, err = s.SQSClient.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: resp.QueueUrl})
 	if err != nil && queueNotFoundError(err) {
 		return nil
 	}
+	return errors.Wrapf(err, "unable to delete queue %s", queueName)
+}
+
+// getQueue returns the URL, the ARN and whether a policy is set of the queue with the given name.
+func (s Service) getQueue(ctx context.Context, queueName string) (queueURL, queueArn string, hasPolicy bool, err error) {
+	queueURLResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String(queueName),
+	})
+	if err != nil {
+		return "", "", false, errors.Wrapf(err, "unable to get URL of queue %s", queueName)
+	}
+
+	queueAttrs, err := s.SQSClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn, sqstypes.QueueAttributeNamePolicy},
+		QueueUrl:       queueURLResp.QueueUrl,
+	})
+	if err != nil {
+		return "", "", false, errors.Wrapf(err, "unable to get attributes of queue %s", queueName)
+	}
+
+	queueArn, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
+	if !ok {
+		return "", "", false, errors.New("queue ARN not exist in queue attributes response")
+	}
+	_, hasPolicy = queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)]
+	return aws.ToString(queueURLResp.QueueUrl), queueArn, hasPolicy, nil
+}
+
+// setQueuePolicy sets the access policy of a queue.
+func (s Service) setQueuePolicy(ctx context.Context, queueURL string, policy iamv1.PolicyDocument) error {
+	policyData, err := json.Marshal(policy)
+	if err != nil {
+		return errors.Wrap(err, "unable to JSON marshal policy")
+	}
 
-	return errors.Wrap(err, "unable to delete queue")
+	_, err = s.SQSClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   aws.String(queueURL),
+		Attributes: map[string]string{string(sqstypes.QueueAttributeNamePolicy): string(policyData)},
+	})
+	return errors.Wrap(err, "unable to update queue attributes")
 }
 
 func (s *Service) createPolicyForRule(ctx context.Context, input *createPolicyForRuleInput) error {
-	attrs := make(map[string]string)
 	policy := iamv1.PolicyDocument{
 		Version: iamv1.CurrentVersion,
 		ID:      input.QueueArn,
@@ -83,18 +130,7 @@ func (s *Service) createPolicyForRule(ctx context.Context, input *createPolicyFo
 			},
 		},
 	}
-	policyData, err := json.Marshal(policy)
-	if err != nil {
-		return errors.Wrap(err, "unable to JSON marshal policy")
-	}
-	attrs[string(sqstypes.QueueAttributeNamePolicy)] = string(policyData)
-
-	_, err = s.SQSClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
-		QueueUrl:   aws.String(input.QueueURL),
-		Attributes: attrs,
-	})
-
-	return errors.Wrap(err, "unable to update queue attributes")
+	return s.setQueuePolicy(ctx, input.QueueURL, policy)
 }
 
 // GenerateQueueName will generate a queue name.
@@ -24,8 +24,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
-	"github.com/aws/aws-sdk-go-v2/service/sqs"
-	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/pkg/errors"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -69,69 +67,56 @@ func (s Service) reconcileRules(ctx context.Context) error {
 		}
 	}
 
-	queueURLResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
-		QueueName: aws.String(GenerateQueueName(s.scope.Name())),
-	})
-
+	queueName := GenerateQueueName(s.scope.Name())
+	queueURL, queueArn, hasPolicy, err := s.getQueue(ctx, queueName)
 	if err != nil {
-		return errors.Wrap(err, "unable to get queue URL")
+		return err
 	}
-	queueAttrs, err := s.SQSClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
-		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn, sqstypes.QueueAttributeNamePolicy},
-		QueueUrl:       queueURLResp.QueueUrl,
-	})
 
-	if err != nil {
-		return errors.Wrap(err, "unable to get queue attributes")
+	if err := s.ensureRuleTarget(ctx, s.getEC2RuleName(), queueName, queueArn); err != nil {
+		return err
 	}
 
-	queueArn, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
-	if !ok {
-		return errors.New("queue ARN not exist in queue attributes response")
+	if !hasPolicy {
+		// add a policy for the rule so the rule is authorized to emit messages to the queue
+		err = s.createPolicyForRule(ctx, &createPolicyForRuleInput{
+			QueueArn: queueArn,
+			QueueURL: queueURL,
+			RuleArn:  *ruleResp.Arn,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
+	return nil
+}
+
+// ensureRuleTarget adds a queue as a target of a rule, unless it is already one. The ID of the target is the name
+// of the queue.
+func (s Service) ensureRuleTarget(ctx context.Context, ruleName, queueName, queueArn string) error {
 	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
-		Rule: aws.String(s.getEC2RuleName()),
+		Rule: aws.String(ruleName),
 	})
 	if err != nil {
-		return errors.Wrapf(err, "unable to list targets for rule %s", s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to list targets for rule %s", ruleName)
 	}
 
-	targetFound := false
 	for _, target := range targetsResp.Targets {
 		// check if queue is already added as a target
-		if *target.Id == GenerateQueueName(s.scope.Name()) && *target.Arn == queueArn {
-			targetFound = true
-		}
-	}
-
-	if !targetFound {
-		_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
-			Rule: ruleResp.Name,
-			Targets: []eventbridgetypes.Target{{
-				Arn: aws.String(queueArn),
-				Id:  aws.String(GenerateQueueName(s.scope.Name())),
-			}},
-		})
-
-		if err != nil {
-			return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
-		}
-	}
-
-	if _, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)]; !ok {
-		// add a policy for the rule so the rule is authorized to emit messages to the queue
-		err = s.createPolicyForRule(ctx, &createPolicyForRuleInput{
-			QueueArn: queueArn,
-			QueueURL: *queueURLResp.QueueUrl,
-			RuleArn:  *ruleResp.Arn,
-		})
-		if err != nil {
-			return err
+		if aws.ToString(target.Id) == queueName && aws.ToString(target.Arn) == queueArn {
+			return nil
 		}
 	}
 
-	return nil
+	_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule: aws.String(ruleName),
+		Targets: []eventbridgetypes.Target{{
+			Arn: aws.String(queueArn),
+			Id:  aws.String(queueName),
+		}},
+	})
+	return errors.Wrapf(err, "unable to add SQS target %s to rule %s", queueName, ruleName)
 }
 
 func (s Service) createRule(ctx context.Context) error {
@@ -158,22 +143,26 @@ func (s Service) createRule(ctx context.Context) error {
 }
 
 func (s Service) deleteRules(ctx context.Context) error {
+	return s.deleteRule(ctx, s.getEC2RuleName(), GenerateQueueName(s.scope.Name()))
+}
+
+// deleteRule removes the queue target of a rule and deletes the rule, if it exists.
+func (s Service) deleteRule(ctx context.Context, ruleName, queueName string) error {
 	_, err := s.EventBridgeClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
-		Rule: aws.String(s.getEC2RuleName()),
-		Ids:  []string{GenerateQueueName(s.scope.Name())},
+		Rule: aws.String(ruleName),
+		Ids:  []string{queueName},
 	})
 	if err != nil && !resourceNotFoundError(err) {
-		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), s.getEC2RuleName())
+		return errors.Wrapf(err, "unable to remove target %s for rule %s", queueName, ruleName)
 	}
+
 	_, err = s.EventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
-		Name: aws.String(s.getEC2RuleName()),
+		Name: aws.String(ruleName),
 	})
-
 	if err != nil && resourceNotFoundError(err) {
 		return nil
 	}
-
-	return err
+	return errors.Wrapf(err, "unable to delete rule %s", ruleName)
 }
 
 // AddInstanceToEventPattern will add an instance to an event pattern.
@@ -66,6 +66,8 @@ const (
 	LaunchTemplateNeedsUpdateReasonAdditionalSecurityGroupIDs LaunchTemplateNeedsUpdateReason = "AdditionalSecurityGroupIDs"
 	// LaunchTemplateNeedsUpdateReasonEnclaveOptions means a difference in the enclave options was found.
 	LaunchTemplateNeedsUpdateReasonEnclaveOptions LaunchTemplateNeedsUpdateReason = "EnclaveOptions"
+	// LaunchTemplateNeedsUpdateReasonAdditionalNetworkInterfaces means a difference in the additional network interfaces was found.
+	LaunchTemplateNeedsUpdateReasonAdditionalNetworkInterfaces LaunchTemplateNeedsUpdateReason = "AdditionalNetworkInterfaces"
 )
 
 // ASGInterface encapsulates the methods exposed to the machinepool
@@ -124,6 +126,12 @@ type EC2Interface interface {
 	// ReleaseElasticIP reconciles the elastic IP from a custom Public IPv4 Pool.
 	ReleaseElasticIP(instanceID string) error
 
+	// ReconcileElasticIPClaim associates the instance with a slot of a named Elastic IP claim owned by a MachineDeployment.
+	ReconcileElasticIPClaim(claim *infrav1.ElasticIPClaim, pool *infrav1.ElasticIPPool, instance *infrav1.Instance, owner string) (*infrav1.ElasticIPClaimStatus, error)
+
+	// ReleaseElasticIPClaim returns the Elastic IP held by the instance to its claim.
+	ReleaseElasticIPClaim(claim *infrav1.ElasticIPClaim, instanceID string) error
+
 	// Dedicated Host management
 	AllocateDedicatedHost(ctx context.Context, spec *infrav1.DynamicHostAllocationSpec, instanceType, availabilityZone string, scope *scope.MachineScope) (string, error)
 	ReleaseDedicatedHost(ctx context.Context, hostID string) error
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// controllerPolicyName is the name of the inline policy of the Karpenter controller role.
+	controllerPolicyName = "karpenter-controller"
+
+	// ControllerRoleNamePrefix is the prefix of the names of the Karpenter controller roles, to which the permissions
+	// of the provider to manage them are restricted.
+	ControllerRoleNamePrefix = "karpenter-controller-"
+
+	maxIAMRoleNameLength = 64
+)
+
+// ControllerRoleName returns the name of the Karpenter controller role of the cluster. The names exceeding the length
+// limit of IAM are truncated and suffixed with a hash of the cluster name.
+func (s *Service) ControllerRoleName() (string, error) {
+	clusterName := s.scope.KubernetesClusterName()
+	if len(ControllerRoleNamePrefix)+len(clusterName) <= maxIAMRoleNameLength {
+		return ControllerRoleNamePrefix + clusterName, nil
+	}
+
+	hashed, err := hash.Base36TruncatedHash(clusterName, 16)
+	if err != nil {
+		return "", errors.Wrap(err, "creating hash from cluster name")
+	}
+	return ControllerRoleNamePrefix + clusterName[:maxIAMRoleNameLength-len(ControllerRoleNamePrefix)-len(hashed)-1] + "-" + hashed, nil
+}
+
+func (s *Service) reconcileControllerRole(ctx context.Context, status *infrav1.KarpenterStatus) (string, error) {
+	roleName, err := s.ControllerRoleName()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate Karpenter controller role name")
+	}
+	trustRelationship := s.controllerTrustRelationship()
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", errors.Wrapf(err, "getting Karpenter controller role %q", roleName)
+		}
+
+		role, err = s.CreateRole(ctx, roleName, s.scope.Name(), trustRelationship, s.scope.AdditionalTags(), "", "")
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedIAMRoleCreation", "Failed to create Karpenter controller role %q: %v", roleName, err)
+			return "", err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulIAMRoleCreation", "Created Karpenter controller role %q", roleName)
+
+		// The role is recorded once created, so that it is deleted with the cluster even if its policy can't be set.
+		created := status.DeepCopy()
+		created.ControllerRoleARN = aws.ToString(role.Arn)
+		s.scope.SetKarpenterStatus(created)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, Karpenter controller role policy assignment as role is unmanaged")
+		return aws.ToString(role.Arn), nil
+	}
+
+	if _, err := s.EnsureTagsAndPolicy(ctx, role, s.scope.Name(), trustRelationship, s.scope.AdditionalTags()); err != nil {
+		return "", errors.Wrap(err, "error ensuring tags and policy document are set on Karpenter controller role")
+	}
+
+	if err := s.PutRolePolicy(ctx, roleName, controllerPolicyName, s.controllerPolicy(status)); err != nil {
+		return "", err
+	}
+
+	return aws.ToString(role.Arn), nil
+}
+
+func (s *Service) deleteControllerRole(ctx context.Context, roleName string) error {
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if isNotFound(err) {
+			s.scope.Debug("Karpenter controller role already deleted")
+			return nil
+		}
+		return errors.Wrapf(err, "getting Karpenter controller role %q", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, Karpenter controller role deletion as role is unmanaged")
+		return nil
+	}
+
+	if err := s.DeleteRolePolicy(ctx, roleName, controllerPolicyName); err != nil {
+		return err
+	}
+	if err := s.DeleteRole(ctx, roleName); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedIAMRoleDeletion", "Failed to delete Karpenter controller role %q: %v", roleName, err)
+		return err
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulIAMRoleDeletion", "Deleted Karpenter controller role %q", roleName)
+
+	return nil
+}
+
+// controllerTrustRelationship returns the trust relationship of the controller role. EKS clusters trust EKS Pod
+// Identity, the principals listed in the spec are trusted in addition for Karpenter running elsewhere.
+func (s *Service) controllerTrustRelationship() *iamv1.PolicyDocument {
+	policy := &iamv1.PolicyDocument{Version: iamv1.CurrentVersion}
+	if s.scope.KarpenterPodIdentity() {
+		policy = eksiam.PodIdentityTrustRelationship()
+	}
+
+	if principals := s.scope.Karpenter().TrustedPrincipalARNs; len(principals) > 0 {
+		policy.Statement = append(policy.Statement, iamv1.StatementEntry{
+			Effect:    iamv1.EffectAllow,
+			Action:    iamv1.Actions{"sts:AssumeRole"},
+			Principal: iamv1.Principals{iamv1.PrincipalAWS: iamv1.PrincipalID(principals)},
+		})
+	}
+
+	return policy
+}
+
+// controllerPolicy returns the permissions required by the Karpenter controller. Instances and launch templates
+// can only be deleted when they have been created by Karpenter for the cluster.
+func (s *Service) controllerPolicy(status *infrav1.KarpenterStatus) *iamv1.PolicyDocument {
+	clusterTag := fmt.Sprintf("aws:ResourceTag/kubernetes.io/cluster/%s", s.scope.KubernetesClusterName())
+
+	statements := iamv1.Statements{
+		{
+			Sid:    "AllowScopedEC2Actions",
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"ec2:CreateFleet",
+				"ec2:CreateLaunchTemplate",
+				"ec2:CreateTags",
+				"ec2:RunInstances",
+			},
+			Resource: iamv1.Resources{"*"},
+		},
+		{
+			Sid:    "AllowScopedDeletion",
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"ec2:DeleteLaunchTemplate",
+				"ec2:TerminateInstances",
+			},
+			Resource: iamv1.Resources{"*"},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{clusterTag: "owned"},
+				iamv1.StringLike:   map[string]string{"aws:ResourceTag/karpenter.sh/nodepool": "*"},
+			},
+		},
+		{
+			Sid:    "AllowRegionalReadActions",
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeImages",
+				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceTypeOfferings",
+				"ec2:DescribeInstanceTypes",
+				"ec2:DescribeLaunchTemplates",
+				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSpotPriceHistory",
+				"ec2:DescribeSubnets",
+				"pricing:GetProducts",
+				"ssm:GetParameter",
+			},
+			Resource: iamv1.Resources{"*"},
+		},
+		{
+			Sid:      "AllowPassingInstanceRole",
+			Effect:   iamv1.EffectAllow,
+			Action:   iamv1.Actions{"iam:PassRole"},
+			Resource: iamv1.Resources{status.NodeRoleARN},
+			Condition: iamv1.Conditions{
+				iamv1.StringEquals: map[string]string{"iam:PassedToService": "ec2.amazonaws.com"},
+			},
+		},
+		{
+			Sid:    "AllowInstanceProfileActions",
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"iam:AddRoleToInstanceProfile",
+				"iam:CreateInstanceProfile",
+				"iam:DeleteInstanceProfile",
+				"iam:GetInstanceProfile",
+				"iam:RemoveRoleFromInstanceProfile",
+				"iam:TagInstanceProfile",
+			},
+			Resource: iamv1.Resources{"arn:*:iam::*:instance-profile/*"},
+		},
+		{
+			Sid:      "AllowAPIServerEndpointDiscovery",
+			Effect:   iamv1.EffectAllow,
+			Action:   iamv1.Actions{"eks:DescribeCluster"},
+			Resource: iamv1.Resources{fmt.Sprintf("arn:*:eks:%s:*:cluster/%s", s.scope.Region(), s.scope.KubernetesClusterName())},
+		},
+	}
+
+	if status.InterruptionQueueName != "" {
+		statements = append(statements, iamv1.StatementEntry{
+			Sid:    "AllowInterruptionQueueActions",
+			Effect: iamv1.EffectAllow,
+			Action: iamv1.Actions{
+				"sqs:DeleteMessage",
+				"sqs:GetQueueUrl",
+				"sqs:ReceiveMessage",
+			},
+			Resource: iamv1.Resources{fmt.Sprintf("arn:*:sqs:%s:*:%s", s.scope.Region(), status.InterruptionQueueName)},
+		})
+	}
+
+	return &iamv1.PolicyDocument{
+		Version:   iamv1.CurrentVersion,
+		Statement: statements,
+	}
+}
+
+func isNotFound(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == (&iamtypes.NoSuchEntityException{}).ErrorCode()
+}
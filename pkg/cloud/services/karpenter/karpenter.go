@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// DiscoveryTagKey is the key of the tag used by Karpenter to discover the subnets and security groups of a cluster.
+	DiscoveryTagKey = "karpenter.sh/discovery"
+
+	maxRuleNameLength  = 64
+	maxQueueNameLength = 80
+)
+
+// ReconcileKarpenter creates the interruption queue and the controller role of the cluster if they don't exist yet,
+// and tags the private subnets and the node security group of the cluster for discovery. When Karpenter is removed
+// from the cluster, the resources created for it are deleted.
+func (s *Service) ReconcileKarpenter(ctx context.Context) error {
+	spec := s.scope.Karpenter()
+	if spec == nil {
+		return s.deleteKarpenter(ctx, true)
+	}
+
+	s.scope.Debug("Reconciling Karpenter resources")
+
+	status := &infrav1.KarpenterStatus{}
+	if current := s.scope.KarpenterStatus(); current != nil {
+		status = current.DeepCopy()
+	}
+
+	nodeRole, err := s.GetIAMRole(ctx, roleName(spec.NodeRole))
+	if err != nil {
+		return errors.Wrapf(err, "getting Karpenter node role %q", spec.NodeRole)
+	}
+	status.NodeRoleARN = aws.ToString(nodeRole.Arn)
+
+	if spec.InterruptionHandlingEnabled() {
+		// The queue is recorded before it is created, so that it is deleted with the cluster even if its creation
+		// doesn't complete.
+		status.InterruptionQueueName = s.queueName()
+		s.scope.SetKarpenterStatus(status.DeepCopy())
+		if err := s.EventQueues.ReconcileEventQueue(ctx, status.InterruptionQueueName, interruptionRules(status.InterruptionQueueName), s.tags()); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedReconcileKarpenterInterruptionQueue", "Failed to reconcile Karpenter interruption queue: %v", err)
+			return errors.Wrap(err, "reconciling Karpenter interruption queue")
+		}
+	} else if status.InterruptionQueueName != "" {
+		if err := s.EventQueues.DeleteEventQueue(ctx, status.InterruptionQueueName, interruptionRules(status.InterruptionQueueName)); err != nil {
+			return errors.Wrap(err, "deleting Karpenter interruption queue")
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteKarpenterInterruptionQueue", "Deleted Karpenter interruption queue %q", status.InterruptionQueueName)
+		status.InterruptionQueueName = ""
+	}
+	s.scope.SetKarpenterStatus(status.DeepCopy())
+
+	controllerRoleARN, err := s.reconcileControllerRole(ctx, status)
+	if err != nil {
+		return err
+	}
+	status.ControllerRoleARN = controllerRoleARN
+	s.scope.SetKarpenterStatus(status)
+
+	if err := s.reconcileDiscoveryTags(); err != nil {
+		return err
+	}
+
+	s.scope.Debug("Reconciled Karpenter resources", "controller_role", status.ControllerRoleARN, "interruption_queue", status.InterruptionQueueName)
+	return nil
+}
+
+// DeleteKarpenter deletes the interruption queue and the controller role of the cluster. The discovery tags are
+// removed from the subnets and security groups of an unmanaged VPC, which outlive the cluster.
+func (s *Service) DeleteKarpenter(ctx context.Context) error {
+	return s.deleteKarpenter(ctx, s.scope.VPC().IsUnmanaged(s.scope.Name()))
+}
+
+// deleteKarpenter deletes the resources recorded in the Karpenter status of the cluster, whether or not Karpenter
+// is still in its spec.
+func (s *Service) deleteKarpenter(ctx context.Context, deleteDiscoveryTags bool) error {
+	status := s.scope.KarpenterStatus()
+	if status == nil {
+		s.scope.Trace("No Karpenter resources for the cluster, skipping")
+		return nil
+	}
+
+	s.scope.Debug("Deleting Karpenter resources")
+
+	if status.InterruptionQueueName != "" {
+		if err := s.EventQueues.DeleteEventQueue(ctx, status.InterruptionQueueName, interruptionRules(status.InterruptionQueueName)); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteKarpenterInterruptionQueue", "Failed to delete Karpenter interruption queue: %v", err)
+			return errors.Wrap(err, "deleting Karpenter interruption queue")
+		}
+	}
+
+	if status.ControllerRoleARN != "" {
+		if err := s.deleteControllerRole(ctx, roleName(status.ControllerRoleARN)); err != nil {
+			return err
+		}
+	}
+
+	if deleteDiscoveryTags {
+		if err := s.deleteDiscoveryTags(); err != nil {
+			return err
+		}
+	}
+
+	s.scope.SetKarpenterStatus(nil)
+	return nil
+}
+
+// DiscoveryTagValue returns the value of the discovery tag of the cluster.
+func (s *Service) DiscoveryTagValue() string {
+	if value := s.scope.Karpenter().DiscoveryTagValue; value != "" {
+		return value
+	}
+	return s.scope.KubernetesClusterName()
+}
+
+func (s *Service) reconcileDiscoveryTags() error {
+	params := func(resourceID string) *infrav1.BuildParams {
+		return &infrav1.BuildParams{
+			ResourceID: resourceID,
+			Additional: infrav1.Tags{DiscoveryTagKey: s.DiscoveryTagValue()},
+		}
+	}
+
+	for _, subnet := range s.discoverySubnets() {
+		if err := tags.New(params(subnet.GetResourceID()), tags.WithEC2(s.EC2Client)).Ensure(subnet.Tags); err != nil {
+			return errors.Wrapf(err, "tagging subnet %q for Karpenter discovery", subnet.GetResourceID())
+		}
+	}
+
+	if sg, ok := s.discoverySecurityGroup(); ok {
+		if err := tags.New(params(sg.ID), tags.WithEC2(s.EC2Client)).Ensure(sg.Tags); err != nil {
+			return errors.Wrapf(err, "tagging security group %q for Karpenter discovery", sg.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Service) deleteDiscoveryTags() error {
+	resourceIDs := []string{}
+	for _, subnet := range s.discoverySubnets() {
+		resourceIDs = append(resourceIDs, subnet.GetResourceID())
+	}
+	if sg, ok := s.discoverySecurityGroup(); ok {
+		resourceIDs = append(resourceIDs, sg.ID)
+	}
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+
+	_, err := s.EC2Client.DeleteTags(context.TODO(), &ec2.DeleteTagsInput{
+		Resources: resourceIDs,
+		Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey)}},
+	})
+	if err != nil {
+		if code, ok := awserrors.Code(err); !ok || (code != awserrors.GroupNotFound && code != awserrors.SubnetNotFound) {
+			return errors.Wrap(err, "removing Karpenter discovery tags")
+		}
+	}
+	return nil
+}
+
+// discoverySubnets returns the subnets tagged for Karpenter discovery: the private subnets of the cluster, unless its
+// VPC is unmanaged and tagging unmanaged network resources is disabled.
+func (s *Service) discoverySubnets() infrav1.Subnets {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) && !s.scope.TagUnmanagedNetworkResources() {
+		return nil
+	}
+	subnets := infrav1.Subnets{}
+	for _, subnet := range s.scope.Subnets().FilterPrivate() {
+		if subnet.GetResourceID() != "" {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets
+}
+
+// discoverySecurityGroup returns the security group tagged for Karpenter discovery: the node security group of the
+// cluster, unless it is overridden and tagging unmanaged network resources is disabled. The node security group of EKS
+// clusters is only known once the control plane has been created.
+func (s *Service) discoverySecurityGroup() (infrav1.SecurityGroup, bool) {
+	sg, ok := s.scope.SecurityGroups()[infrav1.SecurityGroupNode]
+	if !ok || sg.ID == "" {
+		return sg, false
+	}
+	if _, overridden := s.scope.SecurityGroupOverrides()[infrav1.SecurityGroupNode]; overridden && !s.scope.TagUnmanagedNetworkResources() {
+		return sg, false
+	}
+	return sg, true
+}
+
+// queueName returns the name of the interruption queue of the cluster. The names exceeding the length limit of SQS
+// are truncated and suffixed with a hash of the cluster name.
+func (s *Service) queueName() string {
+	const suffix = "-karpenter"
+	name := strings.ReplaceAll(s.scope.Name(), ".", "-")
+	if len(name)+len(suffix) <= maxQueueNameLength {
+		return name + suffix
+	}
+
+	// A 16 bytes hash can't fail.
+	hashed, _ := hash.Base36TruncatedHash(name, 16)
+	return name[:maxQueueNameLength-len(suffix)-len(hashed)-1] + "-" + hashed + suffix
+}
+
+// interruptionRules returns the EventBridge rules forwarding the events handled by Karpenter to the interruption queue.
+func interruptionRules(queueName string) []instancestate.EventQueueRule {
+	rules := []instancestate.EventQueueRule{
+		{Name: "scheduled-change", Source: "aws.health", DetailType: "AWS Health Event"},
+		{Name: "spot-interruption", Source: "aws.ec2", DetailType: "EC2 Spot Instance Interruption Warning"},
+		{Name: "rebalance", Source: "aws.ec2", DetailType: "EC2 Instance Rebalance Recommendation"},
+		{Name: "instance-state-change", Source: "aws.ec2", DetailType: "EC2 Instance State-change Notification"},
+	}
+	for i := range rules {
+		// The name is within the limit unless the cluster name is very long, in which case it is hashed.
+		name, _ := eks.GenerateEKSName(rules[i].Name, queueName, maxRuleNameLength)
+		rules[i].Name = name
+	}
+	return rules
+}
+
+func (s *Service) tags() map[string]string {
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+}
+
+// roleName returns the name of a role given its name or ARN.
+func roleName(nameOrARN string) string {
+	return nameOrARN[strings.LastIndex(nameOrARN, "/")+1:]
+}
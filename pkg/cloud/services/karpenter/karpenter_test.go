@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_eventbridgeiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_sqsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/hash"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	nodeRoleARN       = "arn:aws:iam::123456789012:role/karpenter-node"
+	controllerRoleARN = "arn:aws:iam::123456789012:role/karpenter-controller-test-cluster"
+	queueURL          = "https://sqs.us-east-1.amazonaws.com/123456789012/test-cluster-karpenter"
+)
+
+type testMocks struct {
+	ec2         *mocks.MockEC2APIMockRecorder
+	iam         *mock_iamauth.MockIAMAPIMockRecorder
+	sqs         *mock_sqsiface.MockSQSAPIMockRecorder
+	eventBridge *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder
+}
+
+func TestReconcileKarpenter(t *testing.T) {
+	ownedTag := iamtypes.Tag{Key: aws.String(infrav1.ClusterAWSCloudProviderTagKey("test-cluster")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))}
+
+	tests := []struct {
+		name                 string
+		interruptionHandling         *bool
+		status                       *infrav1.KarpenterStatus
+		unmanagedVPC                 bool
+		securityGroupOverride        bool
+		tagUnmanagedNetworkResources bool
+		expect                       func(m testMocks)
+		expectErr                    bool
+		expectStatus                 *infrav1.KarpenterStatus
+	}{
+		{
+			name: "creates the interruption queue and controller role and tags subnets and security groups",
+			expect: func(m testMocks) {
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-node")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(nodeRoleARN)}}, nil)
+				expectEventQueue(m)
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+					Return(nil, &iamtypes.NoSuchEntityException{})
+				m.iam.CreateRole(gomock.Any(), gomock.Any()).
+					Return(&iam.CreateRoleOutput{Role: &iamtypes.Role{
+						RoleName:                 aws.String("karpenter-controller-test-cluster"),
+						Arn:                      aws.String(controllerRoleARN),
+						AssumeRolePolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["sts:AssumeRole"],"Principal":{"AWS":["arn:aws:iam::123456789012:role/management"]}}]}`),
+						Tags:                     []iamtypes.Tag{ownedTag},
+					}}, nil)
+				m.iam.PutRolePolicy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *iam.PutRolePolicyInput, _ ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error) {
+						g := NewWithT(t)
+						g.Expect(aws.ToString(input.PolicyName)).To(Equal(controllerPolicyName))
+						g.Expect(aws.ToString(input.PolicyDocument)).To(ContainSubstring(nodeRoleARN))
+						g.Expect(aws.ToString(input.PolicyDocument)).To(ContainSubstring("sqs:ReceiveMessage"))
+						return &iam.PutRolePolicyOutput{}, nil
+					})
+				m.ec2.CreateTags(gomock.Any(), &ec2.CreateTagsInput{
+					Resources: []string{"subnet-private"},
+					Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey), Value: aws.String("test-cluster")}},
+				}).Return(&ec2.CreateTagsOutput{}, nil)
+				m.ec2.CreateTags(gomock.Any(), &ec2.CreateTagsInput{
+					Resources: []string{"sg-node"},
+					Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey), Value: aws.String("test-cluster")}},
+				}).Return(&ec2.CreateTagsOutput{}, nil)
+			},
+			expectStatus: &infrav1.KarpenterStatus{
+				ControllerRoleARN:     controllerRoleARN,
+				NodeRoleARN:           nodeRoleARN,
+				InterruptionQueueName: "test-cluster-karpenter",
+			},
+		},
+		{
+			name:                 "deletes the interruption queue when interruption handling is disabled",
+			interruptionHandling: aws.Bool(false),
+			status: &infrav1.KarpenterStatus{
+				ControllerRoleARN:     controllerRoleARN,
+				NodeRoleARN:           nodeRoleARN,
+				InterruptionQueueName: "test-cluster-karpenter",
+			},
+			expect: func(m testMocks) {
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-node")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(nodeRoleARN)}}, nil)
+				expectDeleteEventQueue(m)
+				// The role is unmanaged, so its policy is left untouched.
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(controllerRoleARN)}}, nil)
+				m.ec2.CreateTags(gomock.Any(), gomock.Any()).Return(&ec2.CreateTagsOutput{}, nil).Times(2)
+			},
+			expectStatus: &infrav1.KarpenterStatus{
+				ControllerRoleARN: controllerRoleARN,
+				NodeRoleARN:       nodeRoleARN,
+			},
+		},
+		{
+			name: "leaves unmanaged subnets and security groups untagged",
+			status: &infrav1.KarpenterStatus{
+				ControllerRoleARN:     controllerRoleARN,
+				NodeRoleARN:           nodeRoleARN,
+				InterruptionQueueName: "test-cluster-karpenter",
+			},
+			unmanagedVPC:          true,
+			securityGroupOverride: true,
+			expect: func(m testMocks) {
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-node")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(nodeRoleARN)}}, nil)
+				expectEventQueue(m)
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(controllerRoleARN)}}, nil)
+			},
+			expectStatus: &infrav1.KarpenterStatus{
+				ControllerRoleARN:     controllerRoleARN,
+				NodeRoleARN:           nodeRoleARN,
+				InterruptionQueueName: "test-cluster-karpenter",
+			},
+		},
+		{
+			name: "tags unmanaged subnets and security groups when tagging unmanaged network resources is enabled",
+			status: &infrav1.KarpenterStatus{
+				ControllerRoleARN:     controllerRoleARN,
+				NodeRoleARN:           nodeRoleARN,
+				InterruptionQueueName: "test-cluster-karpenter",
+			},
+			unmanagedVPC:                 true,
+			securityGroupOverride:        true,
+			tagUnmanagedNetworkResources: true,
+			expect: func(m testMocks) {
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-node")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(nodeRoleARN)}}, nil)
+				expectEventQueue(m)
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String(controllerRoleARN)}}, nil)
+				m.ec2.CreateTags(gomock.Any(), &ec2.CreateTagsInput{
+					Resources: []string{"subnet-private"},
+					Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey), Value: aws.String("test-cluster")}},
+				}).Return(&ec2.CreateTagsOutput{}, nil)
+				m.ec2.CreateTags(gomock.Any(), &ec2.CreateTagsInput{
+					Resources: []string{"sg-node"},
+					Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey), Value: aws.String("test-cluster")}},
+				}).Return(&ec2.CreateTagsOutput{}, nil)
+			},
+			expectStatus: &infrav1.KarpenterStatus{
+				ControllerRoleARN:     controllerRoleARN,
+				NodeRoleARN:           nodeRoleARN,
+				InterruptionQueueName: "test-cluster-karpenter",
+			},
+		},
+		{
+			name: "fails when the node role does not exist",
+			expect: func(m testMocks) {
+				m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-node")}).
+					Return(nil, &iamtypes.NoSuchEntityException{})
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			clusterScope := newClusterScope(g, &infrav1.KarpenterSpec{
+				NodeRole:             "karpenter-node",
+				TrustedPrincipalARNs: []string{"arn:aws:iam::123456789012:role/management"},
+				InterruptionHandling: tc.interruptionHandling,
+			}, tc.status, tc.tagUnmanagedNetworkResources)
+			if tc.unmanagedVPC {
+				clusterScope.AWSCluster.Spec.NetworkSpec.VPC.ID = "vpc-unmanaged"
+			}
+			if tc.securityGroupOverride {
+				clusterScope.AWSCluster.Spec.NetworkSpec.SecurityGroupOverrides = map[infrav1.SecurityGroupRole]string{infrav1.SecurityGroupNode: "sg-node"}
+			}
+
+			s, m := newTestService(mockCtrl, clusterScope)
+			tc.expect(m)
+
+			err := s.ReconcileKarpenter(context.TODO())
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.KarpenterStatus()).To(Equal(tc.expectStatus))
+		})
+	}
+}
+
+func TestDeleteKarpenter(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusterScope := newClusterScope(g, &infrav1.KarpenterSpec{NodeRole: "karpenter-node"}, &infrav1.KarpenterStatus{
+		ControllerRoleARN:     controllerRoleARN,
+		NodeRoleARN:           nodeRoleARN,
+		InterruptionQueueName: "test-cluster-karpenter",
+	}, true)
+	// The VPC is unmanaged but tagging unmanaged network resources is enabled, so the discovery tags must be removed
+	// from its resources.
+	clusterScope.AWSCluster.Spec.NetworkSpec.VPC.ID = "vpc-unmanaged"
+
+	s, m := newTestService(mockCtrl, clusterScope)
+	expectDeleteEventQueue(m)
+	m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+		Return(&iam.GetRoleOutput{Role: &iamtypes.Role{
+			Arn:  aws.String(controllerRoleARN),
+			Tags: []iamtypes.Tag{{Key: aws.String(infrav1.ClusterAWSCloudProviderTagKey("test-cluster")), Value: aws.String(string(infrav1.ResourceLifecycleOwned))}},
+		}}, nil)
+	m.iam.DeleteRolePolicy(gomock.Any(), &iam.DeleteRolePolicyInput{
+		RoleName:   aws.String("karpenter-controller-test-cluster"),
+		PolicyName: aws.String(controllerPolicyName),
+	}).Return(&iam.DeleteRolePolicyOutput{}, nil)
+	m.iam.ListAttachedRolePolicies(gomock.Any(), gomock.Any()).Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+	m.iam.DeleteRole(gomock.Any(), &iam.DeleteRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+		Return(&iam.DeleteRoleOutput{}, nil)
+	m.ec2.DeleteTags(gomock.Any(), &ec2.DeleteTagsInput{
+		Resources: []string{"subnet-private", "sg-node"},
+		Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey)}},
+	}).Return(&ec2.DeleteTagsOutput{}, nil)
+
+	g.Expect(s.DeleteKarpenter(context.TODO())).To(Succeed())
+	g.Expect(clusterScope.KarpenterStatus()).To(BeNil())
+}
+
+func TestReconcileKarpenterRemoved(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// Karpenter was removed from the spec of the cluster, so the resources recorded in its status are deleted.
+	clusterScope := newClusterScope(g, nil, &infrav1.KarpenterStatus{
+		ControllerRoleARN:     controllerRoleARN,
+		NodeRoleARN:           nodeRoleARN,
+		InterruptionQueueName: "test-cluster-karpenter",
+	}, false)
+
+	s, m := newTestService(mockCtrl, clusterScope)
+	expectDeleteEventQueue(m)
+	m.iam.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("karpenter-controller-test-cluster")}).
+		Return(nil, &iamtypes.NoSuchEntityException{})
+	m.ec2.DeleteTags(gomock.Any(), &ec2.DeleteTagsInput{
+		Resources: []string{"subnet-private", "sg-node"},
+		Tags:      []ec2types.Tag{{Key: aws.String(DiscoveryTagKey)}},
+	}).Return(&ec2.DeleteTagsOutput{}, nil)
+
+	g.Expect(s.ReconcileKarpenter(context.TODO())).To(Succeed())
+	g.Expect(clusterScope.KarpenterStatus()).To(BeNil())
+
+	// Nothing is left to delete on the next reconcile.
+	g.Expect(s.ReconcileKarpenter(context.TODO())).To(Succeed())
+}
+
+func TestQueueName(t *testing.T) {
+	tests := []struct {
+		name        string
+		clusterName string
+		expect      string
+	}{
+		{
+			name:        "suffixes the cluster name",
+			clusterName: "test.cluster",
+			expect:      "test-cluster-karpenter",
+		},
+		{
+			name:        "truncates the names exceeding the length limit of SQS",
+			clusterName: strings.Repeat("a", 80),
+			expect:      strings.Repeat("a", 53) + "-" + mustHash(t, strings.Repeat("a", 80)) + "-karpenter",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := newClusterScope(g, nil, nil, false)
+			clusterScope.Cluster.Name = tc.clusterName
+
+			name := NewService(clusterScope).queueName()
+			g.Expect(name).To(Equal(tc.expect))
+			g.Expect(len(name)).To(BeNumerically("<=", maxQueueNameLength))
+		})
+	}
+}
+
+func mustHash(t *testing.T, s string) string {
+	t.Helper()
+	hashed, err := hash.Base36TruncatedHash(s, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hashed
+}
+
+func expectEventQueue(m testMocks) {
+	m.sqs.CreateQueue(gomock.Any(), gomock.Any()).Return(&sqs.CreateQueueOutput{}, nil)
+	m.sqs.GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("test-cluster-karpenter")}).
+		Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(queueURL)}, nil)
+	m.sqs.GetQueueAttributes(gomock.Any(), gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+		Attributes: map[string]string{"QueueArn": "arn:aws:sqs:us-east-1:123456789012:test-cluster-karpenter", "Policy": "{}"},
+	}, nil)
+	m.eventBridge.DescribeRule(gomock.Any(), gomock.Any()).Return(&eventbridge.DescribeRuleOutput{}, nil).Times(4)
+	m.eventBridge.ListTargetsByRule(gomock.Any(), gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{}, nil).Times(4)
+	m.eventBridge.PutTargets(gomock.Any(), gomock.Any()).Return(&eventbridge.PutTargetsOutput{}, nil).Times(4)
+}
+
+func expectDeleteEventQueue(m testMocks) {
+	m.eventBridge.RemoveTargets(gomock.Any(), gomock.Any()).Return(&eventbridge.RemoveTargetsOutput{}, nil).Times(4)
+	m.eventBridge.DeleteRule(gomock.Any(), gomock.Any()).Return(&eventbridge.DeleteRuleOutput{}, nil).Times(4)
+	m.sqs.GetQueueUrl(gomock.Any(), &sqs.GetQueueUrlInput{QueueName: aws.String("test-cluster-karpenter")}).
+		Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String(queueURL)}, nil)
+	m.sqs.DeleteQueue(gomock.Any(), &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}).Return(&sqs.DeleteQueueOutput{}, nil)
+}
+
+func newTestService(mockCtrl *gomock.Controller, clusterScope *scope.ClusterScope) (*Service, testMocks) {
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	iamMock := mock_iamauth.NewMockIAMAPI(mockCtrl)
+	sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+	eventBridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+	s.IAMClient = iamMock
+	s.EventQueues = &instancestate.Service{SQSClient: sqsMock, EventBridgeClient: eventBridgeMock}
+
+	return s, testMocks{
+		ec2:         ec2Mock.EXPECT(),
+		iam:         iamMock.EXPECT(),
+		sqs:         sqsMock.EXPECT(),
+		eventBridge: eventBridgeMock.EXPECT(),
+	}
+}
+
+func newClusterScope(g *WithT, spec *infrav1.KarpenterSpec, status *infrav1.KarpenterStatus, tagUnmanagedNetworkResources bool) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	awsCluster := &infrav1.AWSCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		Spec: infrav1.AWSClusterSpec{
+			Region:    "us-east-1",
+			Karpenter: spec,
+			NetworkSpec: infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{
+					{ID: "subnet-private", ResourceID: "subnet-private", IsPublic: false},
+					{ID: "subnet-public", ResourceID: "subnet-public", IsPublic: true},
+				},
+			},
+		},
+		Status: infrav1.AWSClusterStatus{
+			Karpenter: status,
+			Network: infrav1.NetworkStatus{
+				SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+					infrav1.SecurityGroupNode: {ID: "sg-node"},
+				},
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsCluster).Build()
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster:                   awsCluster,
+		Client:                       client,
+		TagUnmanagedNetworkResources: tagUnmanagedNetworkResources,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	return clusterScope
+}
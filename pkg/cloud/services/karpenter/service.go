@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package karpenter provides a way to create the AWS resources required to run Karpenter in a cluster.
+package karpenter
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the ec2 client.
+type Service struct {
+	scope     scope.KarpenterScope
+	EC2Client common.EC2API
+	iam.IAMService
+	EventQueues *instancestate.Service
+}
+
+// NewService returns a new service given the api clients.
+func NewService(karpenterScope scope.KarpenterScope) *Service {
+	return &Service{
+		scope:     karpenterScope,
+		EC2Client: scope.NewEC2Client(karpenterScope, karpenterScope, karpenterScope, karpenterScope.InfraCluster()),
+		IAMService: iam.IAMService{
+			Wrapper:   karpenterScope,
+			IAMClient: scope.NewIAMClient(karpenterScope, karpenterScope, karpenterScope, karpenterScope.InfraCluster()),
+			Client:    http.DefaultClient,
+		},
+		EventQueues: instancestate.NewService(karpenterScope),
+	}
+}
@@ -0,0 +1,489 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	iamv2 "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	stsv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	iam "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// AliasPrefix is the prefix of the aliases of the cluster KMS keys.
+	AliasPrefix = "alias/cluster-api-provider-aws/"
+
+	// DefaultRotationPeriodInDays is the default number of days between rotations of the cluster KMS key.
+	DefaultRotationPeriodInDays int32 = 365
+
+	// DefaultPendingWindowInDays is the default waiting period before the cluster KMS key is deleted.
+	DefaultPendingWindowInDays int32 = 30
+
+	// defaultKeyPolicyName is the name of the only policy supported by KMS keys.
+	defaultKeyPolicyName = "default"
+
+	// autoScalingServiceLinkedRoleName is the name of the service linked role used by EC2 Auto Scaling.
+	autoScalingServiceLinkedRoleName = "AWSServiceRoleForAutoScaling"
+
+	// autoScalingServiceLinkedRole is the path and name of the service linked role used by EC2 Auto Scaling.
+	autoScalingServiceLinkedRole = "aws-service-role/autoscaling.amazonaws.com/" + autoScalingServiceLinkedRoleName
+
+	// autoScalingServiceName is the service principal of EC2 Auto Scaling, which its service linked role is created for.
+	autoScalingServiceName = "autoscaling.amazonaws.com"
+)
+
+// keyUsageActions are the actions needed to use the key for the encryption of data and EBS volumes.
+var keyUsageActions = []string{
+	"kms:Decrypt",
+	"kms:DescribeKey",
+	"kms:Encrypt",
+	"kms:GenerateDataKey*",
+	"kms:ReEncrypt*",
+}
+
+// keyGrantActions are the actions needed to let AWS services, e.g. EBS, use the key on behalf of a principal.
+var keyGrantActions = []string{
+	"kms:CreateGrant",
+	"kms:ListGrants",
+	"kms:RevokeGrant",
+}
+
+// AliasName returns the alias of the KMS key of the cluster.
+func (s *Service) AliasName() string {
+	return AliasPrefix + s.scope.KubernetesClusterName()
+}
+
+// ReconcileKey creates the cluster KMS key if it doesn't exist yet, and makes sure its alias,
+// policy and rotation are up to date. The key policy grants the use of the key to the node roles,
+// the EC2 Auto Scaling service linked role and the given service roles, e.g. the EKS cluster role.
+func (s *Service) ReconcileKey(ctx context.Context, serviceRoleARNs ...string) error {
+	spec := s.scope.KMSKey()
+	if spec == nil {
+		s.scope.Trace("No KMS key configured for the cluster, skipping")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling cluster KMS key", "alias", s.AliasName())
+
+	policy, err := s.keyPolicy(ctx, spec, serviceRoleARNs)
+	if err != nil {
+		return errors.Wrap(err, "generating key policy")
+	}
+
+	key, err := s.describeOwnedKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	if key == nil {
+		key, err = s.createKey(ctx, policy)
+		if err != nil {
+			return err
+		}
+	} else if err := s.reconcileKeyPolicy(ctx, key, policy); err != nil {
+		return err
+	}
+
+	// Record the key as soon as it exists, so that it isn't lost if one of the next steps fails.
+	s.scope.SetKMSKeyStatus(&infrav1.KMSKeyStatus{
+		ARN: aws.ToString(key.Arn),
+	})
+
+	if err := s.reconcileAlias(ctx, key); err != nil {
+		return err
+	}
+
+	if err := s.reconcileKeyRotation(ctx, key, spec); err != nil {
+		return err
+	}
+
+	s.scope.SetKMSKeyStatus(&infrav1.KMSKeyStatus{
+		ARN:       aws.ToString(key.Arn),
+		AliasName: s.AliasName(),
+	})
+
+	s.scope.Debug("Reconciled cluster KMS key", "arn", aws.ToString(key.Arn))
+	return nil
+}
+
+// DeleteKey deletes the alias of the cluster KMS key and schedules the deletion of the key after the configured
+// waiting period. Data encrypted with the key can't be decrypted anymore once the key has been deleted.
+func (s *Service) DeleteKey(ctx context.Context) error {
+	spec := s.scope.KMSKey()
+	if spec == nil {
+		s.scope.Trace("No KMS key configured for the cluster, skipping")
+		return nil
+	}
+
+	key, err := s.describeOwnedKey(ctx)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		s.scope.Debug("Cluster KMS key not found, nothing to delete")
+		s.scope.SetKMSKeyStatus(nil)
+		return nil
+	}
+
+	if _, err := s.KMSClient.DeleteAlias(ctx, &kms.DeleteAliasInput{
+		AliasName: aws.String(s.AliasName()),
+	}); err != nil && !isNotFound(err) {
+		return errors.Wrapf(err, "deleting alias %q of cluster KMS key", s.AliasName())
+	}
+
+	if key.KeyState == kmstypes.KeyStatePendingDeletion {
+		s.scope.Debug("Cluster KMS key deletion already scheduled", "arn", aws.ToString(key.Arn), "deletion_date", aws.ToTime(key.DeletionDate))
+		s.scope.SetKMSKeyStatus(nil)
+		return nil
+	}
+
+	pendingWindow := spec.PendingWindowInDays
+	if pendingWindow == 0 {
+		pendingWindow = DefaultPendingWindowInDays
+	}
+
+	out, err := s.KMSClient.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               key.Arn,
+		PendingWindowInDays: aws.Int32(pendingWindow),
+	})
+	if err != nil && !isNotFound(err) {
+		record.Warnf(s.scope.InfraCluster(), "FailedScheduleKMSKeyDeletion", "Failed to schedule deletion of cluster KMS key %q: %v", aws.ToString(key.Arn), err)
+		return errors.Wrapf(err, "scheduling deletion of cluster KMS key %q", aws.ToString(key.Arn))
+	}
+	if out != nil {
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulScheduleKMSKeyDeletion", "Scheduled deletion of cluster KMS key %q on %s", aws.ToString(key.Arn), aws.ToTime(out.DeletionDate))
+	}
+
+	s.scope.SetKMSKeyStatus(nil)
+	return nil
+}
+
+// describeOwnedKey returns the cluster KMS key, looked up by its ARN if known or else by its alias.
+// It returns nil if the key doesn't exist, and an error if the key isn't owned by the cluster.
+func (s *Service) describeOwnedKey(ctx context.Context) (*kmstypes.KeyMetadata, error) {
+	keyID := s.scope.KMSKeyARN()
+	if keyID == "" {
+		keyID = s.AliasName()
+	}
+
+	out, err := s.KMSClient.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "describing cluster KMS key %q", keyID)
+	}
+
+	tags, err := s.keyTags(ctx, out.KeyMetadata.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	if !tags.HasOwned(s.scope.Name()) {
+		return nil, errors.Errorf("KMS key %q referenced by %q is not owned by cluster %q", aws.ToString(out.KeyMetadata.Arn), keyID, s.scope.Name())
+	}
+
+	return out.KeyMetadata, nil
+}
+
+func (s *Service) createKey(ctx context.Context, policy string) (*kmstypes.KeyMetadata, error) {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(s.scope.KubernetesClusterName()),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	out, err := s.KMSClient.CreateKey(ctx, &kms.CreateKeyInput{
+		Description: aws.String(fmt.Sprintf("Key of cluster %s created by Cluster API Provider AWS", s.scope.KubernetesClusterName())),
+		KeySpec:     kmstypes.KeySpecSymmetricDefault,
+		KeyUsage:    kmstypes.KeyUsageTypeEncryptDecrypt,
+		Policy:      aws.String(policy),
+		Tags:        kmsTags(tags),
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateKMSKey", "Failed to create cluster KMS key: %v", err)
+		return nil, errors.Wrap(err, "creating cluster KMS key")
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateKMSKey", "Created cluster KMS key %q", aws.ToString(out.KeyMetadata.Arn))
+	return out.KeyMetadata, nil
+}
+
+func (s *Service) reconcileKeyPolicy(ctx context.Context, key *kmstypes.KeyMetadata, policy string) error {
+	if key.KeyState == kmstypes.KeyStatePendingDeletion {
+		return errors.Errorf("cluster KMS key %q is pending deletion", aws.ToString(key.Arn))
+	}
+
+	out, err := s.KMSClient.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      key.KeyId,
+		PolicyName: aws.String(defaultKeyPolicyName),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "getting policy of cluster KMS key %q", aws.ToString(key.Arn))
+	}
+
+	equal, err := policiesEqual(aws.ToString(out.Policy), policy)
+	if err != nil {
+		return err
+	}
+	if equal {
+		return nil
+	}
+
+	s.scope.Debug("Updating cluster KMS key policy", "arn", aws.ToString(key.Arn))
+	if _, err := s.KMSClient.PutKeyPolicy(ctx, &kms.PutKeyPolicyInput{
+		KeyId:      key.KeyId,
+		PolicyName: aws.String(defaultKeyPolicyName),
+		Policy:     aws.String(policy),
+	}); err != nil {
+		return errors.Wrapf(err, "updating policy of cluster KMS key %q", aws.ToString(key.Arn))
+	}
+
+	return nil
+}
+
+func (s *Service) reconcileAlias(ctx context.Context, key *kmstypes.KeyMetadata) error {
+	out, err := s.KMSClient.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(s.AliasName())})
+	switch {
+	case err == nil && aws.ToString(out.KeyMetadata.KeyId) == aws.ToString(key.KeyId):
+		return nil
+	case err == nil:
+		return errors.Errorf("alias %q already refers to KMS key %q", s.AliasName(), aws.ToString(out.KeyMetadata.Arn))
+	case !isNotFound(err):
+		return errors.Wrapf(err, "describing alias %q", s.AliasName())
+	}
+
+	if _, err := s.KMSClient.CreateAlias(ctx, &kms.CreateAliasInput{
+		AliasName:   aws.String(s.AliasName()),
+		TargetKeyId: key.KeyId,
+	}); err != nil {
+		return errors.Wrapf(err, "creating alias %q for cluster KMS key", s.AliasName())
+	}
+
+	return nil
+}
+
+func (s *Service) reconcileKeyRotation(ctx context.Context, key *kmstypes.KeyMetadata, spec *infrav1.KMSKeySpec) error {
+	rotationPeriod := spec.RotationPeriodInDays
+	if rotationPeriod == 0 {
+		rotationPeriod = DefaultRotationPeriodInDays
+	}
+
+	out, err := s.KMSClient.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: key.KeyId})
+	if err != nil {
+		return errors.Wrapf(err, "getting rotation status of cluster KMS key %q", aws.ToString(key.Arn))
+	}
+	if out.KeyRotationEnabled && aws.ToInt32(out.RotationPeriodInDays) == rotationPeriod {
+		return nil
+	}
+
+	s.scope.Debug("Enabling cluster KMS key rotation", "arn", aws.ToString(key.Arn), "rotation_period_days", rotationPeriod)
+	if _, err := s.KMSClient.EnableKeyRotation(ctx, &kms.EnableKeyRotationInput{
+		KeyId:                key.KeyId,
+		RotationPeriodInDays: aws.Int32(rotationPeriod),
+	}); err != nil {
+		return errors.Wrapf(err, "enabling rotation of cluster KMS key %q", aws.ToString(key.Arn))
+	}
+
+	return nil
+}
+
+func (s *Service) keyTags(ctx context.Context, keyID *string) (infrav1.Tags, error) {
+	tags := infrav1.Tags{}
+	input := &kms.ListResourceTagsInput{KeyId: keyID}
+	for {
+		out, err := s.KMSClient.ListResourceTags(ctx, input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing tags of KMS key %q", aws.ToString(keyID))
+		}
+		for _, tag := range out.Tags {
+			tags[aws.ToString(tag.TagKey)] = aws.ToString(tag.TagValue)
+		}
+		if !out.Truncated {
+			return tags, nil
+		}
+		input.Marker = out.NextMarker
+	}
+}
+
+// keyPolicy returns the policy of the cluster KMS key. The account is allowed to manage the key through IAM policies,
+// while the node roles, the EC2 Auto Scaling service linked role and the service roles are only granted its use.
+func (s *Service) keyPolicy(ctx context.Context, spec *infrav1.KMSKeySpec, serviceRoleARNs []string) (string, error) {
+	identity, err := s.STSClient.GetCallerIdentity(ctx, &stsv2.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "getting account ID")
+	}
+	accountID := aws.ToString(identity.Account)
+	partition := endpoints.GetPartitionFromRegion(s.scope.Region())
+
+	statements := []iam.StatementEntry{
+		{
+			Sid:    "EnableIAMPolicies",
+			Effect: iam.EffectAllow,
+			Principal: iam.Principals{
+				iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:root", partition, accountID)},
+			},
+			Action:   []string{"kms:*"},
+			Resource: []string{"*"},
+		},
+	}
+
+	if len(spec.NodeRoles) > 0 {
+		nodeRoleARNs := make([]string, 0, len(spec.NodeRoles))
+		for _, role := range spec.NodeRoles {
+			nodeRoleARNs = append(nodeRoleARNs, roleARN(partition, accountID, role))
+		}
+		statements = append(statements, keyUsageStatements("NodeRoles", nodeRoleARNs)...)
+	}
+
+	if feature.Gates.Enabled(feature.MachinePool) {
+		// KMS rejects key policies referencing roles which don't exist.
+		if err := s.reconcileAutoScalingServiceLinkedRole(ctx); err != nil {
+			return "", err
+		}
+		statements = append(statements, keyUsageStatements("AutoScaling", []string{roleARN(partition, accountID, autoScalingServiceLinkedRole)})...)
+	}
+
+	if len(serviceRoleARNs) > 0 {
+		statements = append(statements, keyUsageStatements("ServiceRoles", serviceRoleARNs)...)
+	}
+
+	policy := iam.PolicyDocument{
+		Version:   iam.CurrentVersion,
+		Statement: statements,
+	}
+
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return "", errors.Wrap(err, "building key policy")
+	}
+
+	return string(policyJSON), nil
+}
+
+// reconcileAutoScalingServiceLinkedRole creates the service linked role of EC2 Auto Scaling if it doesn't exist yet.
+// AWS only creates it along with the first Auto Scaling group of the account, which needs the key policy to allow the
+// role to launch instances with volumes encrypted with the key.
+func (s *Service) reconcileAutoScalingServiceLinkedRole(ctx context.Context) error {
+	_, err := s.IAMClient.GetRole(ctx, &iamv2.GetRoleInput{RoleName: aws.String(autoScalingServiceLinkedRoleName)})
+	if err == nil {
+		return nil
+	}
+	if !isNoSuchEntity(err) {
+		return errors.Wrapf(err, "getting role %q", autoScalingServiceLinkedRoleName)
+	}
+
+	if _, err := s.IAMClient.CreateServiceLinkedRole(ctx, &iamv2.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String(autoScalingServiceName),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateServiceLinkedRole", "Failed to create service linked role %q: %v", autoScalingServiceLinkedRoleName, err)
+		return errors.Wrapf(err, "creating service linked role %q", autoScalingServiceLinkedRoleName)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateServiceLinkedRole", "Created service linked role %q", autoScalingServiceLinkedRoleName)
+	return nil
+}
+
+// keyUsageStatements returns the statements allowing the principals to use the key, and to let AWS services use it
+// on their behalf.
+func keyUsageStatements(sid string, principals []string) []iam.StatementEntry {
+	return []iam.StatementEntry{
+		{
+			Sid:    "Allow" + sid,
+			Effect: iam.EffectAllow,
+			Principal: iam.Principals{
+				iam.PrincipalAWS: principals,
+			},
+			Action:   keyUsageActions,
+			Resource: []string{"*"},
+		},
+		{
+			Sid:    "Allow" + sid + "GrantsForAWSResources",
+			Effect: iam.EffectAllow,
+			Principal: iam.Principals{
+				iam.PrincipalAWS: principals,
+			},
+			Action:   keyGrantActions,
+			Resource: []string{"*"},
+			Condition: iam.Conditions{
+				"Bool": map[string]interface{}{
+					"kms:GrantIsForAWSResource": true,
+				},
+			},
+		},
+	}
+}
+
+// roleARN returns the ARN of the given IAM role, which may already be an ARN.
+func roleARN(partition, accountID, role string) string {
+	if strings.HasPrefix(role, "arn:") {
+		return role
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, accountID, role)
+}
+
+// policiesEqual compares two JSON policy documents, ignoring formatting.
+func policiesEqual(a, b string) (bool, error) {
+	var x, y interface{}
+	if err := json.Unmarshal([]byte(a), &x); err != nil {
+		return false, errors.Wrap(err, "parsing key policy")
+	}
+	if err := json.Unmarshal([]byte(b), &y); err != nil {
+		return false, errors.Wrap(err, "parsing key policy")
+	}
+	return reflect.DeepEqual(x, y), nil
+}
+
+func kmsTags(tags infrav1.Tags) []kmstypes.Tag {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]kmstypes.Tag, 0, len(tags))
+	for _, k := range keys {
+		out = append(out, kmstypes.Tag{TagKey: aws.String(k), TagValue: aws.String(tags[k])})
+	}
+	return out
+}
+
+func isNotFound(err error) bool {
+	return awserrors.ParseSmithyError(err).ErrorCode() == (&kmstypes.NotFoundException{}).ErrorCode()
+}
+
+func isNoSuchEntity(err error) bool {
+	return awserrors.ParseSmithyError(err).ErrorCode() == (&iamtypes.NoSuchEntityException{}).ErrorCode()
+}
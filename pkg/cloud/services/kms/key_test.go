@@ -0,0 +1,328 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/kms/mock_kmsiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	testClusterName = "test-cluster"
+	testKeyID       = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	testKeyARN      = "arn:aws:kms:us-west-2:123456789012:key/" + testKeyID
+	testAliasName   = AliasPrefix + testClusterName
+)
+
+var testOwnedTags = []kmstypes.Tag{
+	{TagKey: aws.String(infrav1.ClusterTagKey(testClusterName)), TagValue: aws.String(string(infrav1.ResourceLifecycleOwned))},
+}
+
+func TestReconcileKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       *infrav1.KMSKeySpec
+		status     *infrav1.KMSKeyStatus
+		expect     func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		wantStatus *infrav1.KMSKeyStatus
+		wantErr    bool
+	}{
+		{
+			name:   "does nothing without a KMS key configured",
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {},
+		},
+		{
+			name: "creates the key with its alias and rotation",
+			spec: &infrav1.KMSKeySpec{NodeRoles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String(testAliasName)}).
+					Return(nil, &kmstypes.NotFoundException{}).Times(2)
+				m.CreateKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *kms.CreateKeyInput, _ ...func(*kms.Options)) (*kms.CreateKeyOutput, error) {
+					g := NewWithT(t)
+					g.Expect(aws.ToString(input.Policy)).To(ContainSubstring(`"arn:aws:iam::123456789012:root"`))
+					g.Expect(aws.ToString(input.Policy)).To(ContainSubstring(`"arn:aws:iam::123456789012:role/nodes.cluster-api-provider-aws.sigs.k8s.io"`))
+					g.Expect(input.Tags).To(ContainElement(testOwnedTags[0]))
+					return &kms.CreateKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStateEnabled)}, nil
+				})
+				m.CreateAlias(gomock.Any(), &kms.CreateAliasInput{
+					AliasName:   aws.String(testAliasName),
+					TargetKeyId: aws.String(testKeyID),
+				}).Return(&kms.CreateAliasOutput{}, nil)
+				m.GetKeyRotationStatus(gomock.Any(), gomock.Any()).Return(&kms.GetKeyRotationStatusOutput{}, nil)
+				m.EnableKeyRotation(gomock.Any(), &kms.EnableKeyRotationInput{
+					KeyId:                aws.String(testKeyID),
+					RotationPeriodInDays: aws.Int32(DefaultRotationPeriodInDays),
+				}).Return(&kms.EnableKeyRotationOutput{}, nil)
+			},
+			wantStatus: &infrav1.KMSKeyStatus{ARN: testKeyARN, AliasName: testAliasName},
+		},
+		{
+			name:   "updates the policy and rotation period of an existing key",
+			spec:   &infrav1.KMSKeySpec{RotationPeriodInDays: 90},
+			status: &infrav1.KMSKeyStatus{ARN: testKeyARN},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String(testKeyARN)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStateEnabled)}, nil)
+				m.ListResourceTags(gomock.Any(), gomock.Any()).Return(&kms.ListResourceTagsOutput{Tags: testOwnedTags}, nil)
+				m.GetKeyPolicy(gomock.Any(), gomock.Any()).Return(&kms.GetKeyPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`)}, nil)
+				m.PutKeyPolicy(gomock.Any(), gomock.Any()).Return(&kms.PutKeyPolicyOutput{}, nil)
+				m.DescribeKey(gomock.Any(), &kms.DescribeKeyInput{KeyId: aws.String(testAliasName)}).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStateEnabled)}, nil)
+				m.GetKeyRotationStatus(gomock.Any(), gomock.Any()).Return(&kms.GetKeyRotationStatusOutput{
+					KeyRotationEnabled:   true,
+					RotationPeriodInDays: aws.Int32(DefaultRotationPeriodInDays),
+				}, nil)
+				m.EnableKeyRotation(gomock.Any(), &kms.EnableKeyRotationInput{
+					KeyId:                aws.String(testKeyID),
+					RotationPeriodInDays: aws.Int32(90),
+				}).Return(&kms.EnableKeyRotationOutput{}, nil)
+			},
+			wantStatus: &infrav1.KMSKeyStatus{ARN: testKeyARN, AliasName: testAliasName},
+		},
+		{
+			name: "fails if the key referenced by the alias isn't owned by the cluster",
+			spec: &infrav1.KMSKeySpec{},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), gomock.Any()).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStateEnabled)}, nil)
+				m.ListResourceTags(gomock.Any(), gomock.Any()).Return(&kms.ListResourceTagsOutput{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "fails if the key is pending deletion",
+			spec:   &infrav1.KMSKeySpec{},
+			status: &infrav1.KMSKeyStatus{ARN: testKeyARN},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), gomock.Any()).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStatePendingDeletion)}, nil)
+				m.ListResourceTags(gomock.Any(), gomock.Any()).Return(&kms.ListResourceTagsOutput{Tags: testOwnedTags}, nil)
+			},
+			wantStatus: &infrav1.KMSKeyStatus{ARN: testKeyARN},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			svc, kmsMock, clusterScope := testService(t, tt.spec, tt.status)
+			tt.expect(kmsMock.EXPECT())
+
+			err := svc.ReconcileKey(context.TODO())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(clusterScope.AWSCluster.Status.KMSKey).To(Equal(tt.wantStatus))
+		})
+	}
+}
+
+func TestDeleteKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *infrav1.KMSKeySpec
+		expect  func(m *mock_kmsiface.MockKMSAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "deletes the alias and schedules the deletion of the key",
+			spec: &infrav1.KMSKeySpec{PendingWindowInDays: 7},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), gomock.Any()).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStateEnabled)}, nil)
+				m.ListResourceTags(gomock.Any(), gomock.Any()).Return(&kms.ListResourceTagsOutput{Tags: testOwnedTags}, nil)
+				m.DeleteAlias(gomock.Any(), &kms.DeleteAliasInput{AliasName: aws.String(testAliasName)}).Return(&kms.DeleteAliasOutput{}, nil)
+				m.ScheduleKeyDeletion(gomock.Any(), &kms.ScheduleKeyDeletionInput{
+					KeyId:               aws.String(testKeyARN),
+					PendingWindowInDays: aws.Int32(7),
+				}).Return(&kms.ScheduleKeyDeletionOutput{DeletionDate: aws.Time(time.Now())}, nil)
+			},
+		},
+		{
+			name: "doesn't schedule the deletion of a key pending deletion again",
+			spec: &infrav1.KMSKeySpec{},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), gomock.Any()).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStatePendingDeletion)}, nil)
+				m.ListResourceTags(gomock.Any(), gomock.Any()).Return(&kms.ListResourceTagsOutput{Tags: testOwnedTags}, nil)
+				m.DeleteAlias(gomock.Any(), gomock.Any()).Return(nil, &kmstypes.NotFoundException{})
+			},
+		},
+		{
+			name: "does nothing if the key doesn't exist",
+			spec: &infrav1.KMSKeySpec{},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), gomock.Any()).Return(nil, &kmstypes.NotFoundException{})
+			},
+		},
+		{
+			name: "fails if the deletion can't be scheduled",
+			spec: &infrav1.KMSKeySpec{},
+			expect: func(m *mock_kmsiface.MockKMSAPIMockRecorder) {
+				m.DescribeKey(gomock.Any(), gomock.Any()).
+					Return(&kms.DescribeKeyOutput{KeyMetadata: testKeyMetadata(kmstypes.KeyStateEnabled)}, nil)
+				m.ListResourceTags(gomock.Any(), gomock.Any()).Return(&kms.ListResourceTagsOutput{Tags: testOwnedTags}, nil)
+				m.DeleteAlias(gomock.Any(), gomock.Any()).Return(&kms.DeleteAliasOutput{}, nil)
+				m.ScheduleKeyDeletion(gomock.Any(), gomock.Any()).Return(nil, &kmstypes.KMSInvalidStateException{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			svc, kmsMock, clusterScope := testService(t, tt.spec, &infrav1.KMSKeyStatus{ARN: testKeyARN, AliasName: testAliasName})
+			tt.expect(kmsMock.EXPECT())
+
+			err := svc.DeleteKey(context.TODO())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.AWSCluster.Status.KMSKey).To(BeNil())
+		})
+	}
+}
+
+func TestKeyPolicyAutoScalingServiceLinkedRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		expect  func(m *mock_kmsiface.MockIAMAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "uses the existing service linked role",
+			expect: func(m *mock_kmsiface.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("AWSServiceRoleForAutoScaling")}).Return(&iam.GetRoleOutput{}, nil)
+			},
+		},
+		{
+			name: "creates the service linked role if it doesn't exist",
+			expect: func(m *mock_kmsiface.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+				m.CreateServiceLinkedRole(gomock.Any(), &iam.CreateServiceLinkedRoleInput{AWSServiceName: aws.String("autoscaling.amazonaws.com")}).
+					Return(&iam.CreateServiceLinkedRoleOutput{}, nil)
+			},
+		},
+		{
+			name: "fails if the service linked role can't be created",
+			expect: func(m *mock_kmsiface.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.NoSuchEntityException{})
+				m.CreateServiceLinkedRole(gomock.Any(), gomock.Any()).Return(nil, &iamtypes.UnmodifiableEntityException{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+			spec := &infrav1.KMSKeySpec{NodeRoles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}}
+			svc, _, _ := testService(t, spec, nil)
+			iamMock := mock_kmsiface.NewMockIAMAPI(gomock.NewController(t))
+			tt.expect(iamMock.EXPECT())
+			svc.IAMClient = iamMock
+
+			policy, err := svc.keyPolicy(context.TODO(), spec, nil)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(policy).To(ContainSubstring(`"arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"`))
+		})
+	}
+}
+
+func testKeyMetadata(state kmstypes.KeyState) *kmstypes.KeyMetadata {
+	return &kmstypes.KeyMetadata{
+		Arn:      aws.String(testKeyARN),
+		KeyId:    aws.String(testKeyID),
+		KeyState: state,
+	}
+}
+
+func testService(t *testing.T, spec *infrav1.KMSKeySpec, status *infrav1.KMSKeyStatus) (*Service, *mock_kmsiface.MockKMSAPI, *scope.ClusterScope) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	kmsMock := mock_kmsiface.NewMockKMSAPI(mockCtrl)
+	stsMock := mock_stsiface.NewMockSTSClient(mockCtrl)
+	stsMock.EXPECT().GetCallerIdentity(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil).AnyTimes()
+	iamMock := mock_kmsiface.NewMockIAMAPI(mockCtrl)
+	iamMock.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(&iam.GetRoleOutput{}, nil).AnyTimes()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testClusterName,
+				Namespace: "test-namespace",
+			},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				Region: "us-west-2",
+				KMSKey: spec,
+			},
+			Status: infrav1.AWSClusterStatus{
+				KMSKey: status,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	svc := NewService(clusterScope)
+	svc.KMSClient = kmsMock
+	svc.IAMClient = iamMock
+	svc.STSClient = stsMock
+
+	return svc, kmsMock, clusterScope
+}
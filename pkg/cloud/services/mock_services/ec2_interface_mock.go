@@ -369,6 +369,21 @@ func (mr *MockEC2InterfaceMockRecorder) ReconcileBastion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileBastion", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileBastion))
 }
 
+// ReconcileElasticIPClaim mocks base method.
+func (m *MockEC2Interface) ReconcileElasticIPClaim(arg0 *v1beta2.ElasticIPClaim, arg1 *v1beta2.ElasticIPPool, arg2 *v1beta2.Instance, arg3 string) (*v1beta2.ElasticIPClaimStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileElasticIPClaim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1beta2.ElasticIPClaimStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileElasticIPClaim indicates an expected call of ReconcileElasticIPClaim.
+func (mr *MockEC2InterfaceMockRecorder) ReconcileElasticIPClaim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileElasticIPClaim", reflect.TypeOf((*MockEC2Interface)(nil).ReconcileElasticIPClaim), arg0, arg1, arg2, arg3)
+}
+
 // ReconcileElasticIPFromPublicPool mocks base method.
 func (m *MockEC2Interface) ReconcileElasticIPFromPublicPool(arg0 *v1beta2.ElasticIPPool, arg1 *v1beta2.Instance) (bool, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +427,20 @@ func (mr *MockEC2InterfaceMockRecorder) ReleaseElasticIP(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseElasticIP", reflect.TypeOf((*MockEC2Interface)(nil).ReleaseElasticIP), arg0)
 }
 
+// ReleaseElasticIPClaim mocks base method.
+func (m *MockEC2Interface) ReleaseElasticIPClaim(arg0 *v1beta2.ElasticIPClaim, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseElasticIPClaim", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseElasticIPClaim indicates an expected call of ReleaseElasticIPClaim.
+func (mr *MockEC2InterfaceMockRecorder) ReleaseElasticIPClaim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseElasticIPClaim", reflect.TypeOf((*MockEC2Interface)(nil).ReleaseElasticIPClaim), arg0, arg1)
+}
+
 // TerminateInstance mocks base method.
 func (m *MockEC2Interface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
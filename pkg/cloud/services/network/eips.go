@@ -19,6 +19,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -207,6 +208,60 @@ func (s *Service) ReleaseAddressByRole(role string) error {
 	})
 }
 
+// AllocateAddressForSlot allocates an address for the given role and tags it with the slot
+// of the Elastic IP claim it belongs to, and the given additional tags.
+func (s *Service) AllocateAddressForSlot(pool *infrav1.ElasticIPPool, role string, slot int32, additionalTags infrav1.Tags) (types.Address, error) {
+	params := s.getEIPTagParams(role)
+	params.Name = aws.String(fmt.Sprintf("%s-%d", aws.ToString(params.Name), slot))
+	params.Additional = infrav1.Tags{}
+	params.Additional.Merge(s.scope.AdditionalTags())
+	params.Additional.Merge(additionalTags)
+	params.Additional[infrav1.NameAWSElasticIPClaimSlot] = strconv.Itoa(int(slot))
+
+	allocInput := &ec2.AllocateAddressInput{
+		Domain: types.DomainTypeVpc,
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeElasticIp, params),
+		},
+	}
+	if err := s.setByoPublicIpv4(pool, allocInput); err != nil {
+		return types.Address{}, err
+	}
+
+	out, err := s.EC2Client.AllocateAddress(context.TODO(), allocInput)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAllocateAddress", "Failed to allocate Elastic IP for %q: %v", role, err)
+		return types.Address{}, fmt.Errorf("failed to allocate Elastic IP for %q: %w", role, err)
+	}
+	return types.Address{
+		AllocationId: out.AllocationId,
+		PublicIp:     out.PublicIp,
+	}, nil
+}
+
+// ReleaseAddress releases an address back to the pool.
+func (s *Service) ReleaseAddress(ip types.Address) error {
+	return s.releaseAddress(ip)
+}
+
+// DisassociateInstanceAddresses disassociates the addresses with a given role from an instance,
+// keeping them allocated to the cluster.
+func (s *Service) DisassociateInstanceAddresses(role string, instanceID string) error {
+	out, err := s.describeAddresses(role)
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe elastic IPs for role %q", role)
+	}
+	for i := range out.Addresses {
+		if out.Addresses[i].AssociationId == nil || aws.ToString(out.Addresses[i].InstanceId) != instanceID {
+			continue
+		}
+		if err := s.disassociateAddress(out.Addresses[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // setByoPublicIpv4 check if the config has Public IPv4 Pool defined, then
 // check if there are IPs available to consume from allocation, otherwise
 // fallback to Amazon pool when explicty failure isn't defined.
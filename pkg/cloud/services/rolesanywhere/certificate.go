@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolesanywhere
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/util/certs"
+)
+
+// DefaultCertificateValidity is the default validity of the node certificates.
+const DefaultCertificateValidity = 7 * 24 * time.Hour
+
+// clockSkew is subtracted from the start of the validity of the node certificates, to tolerate clock drift.
+const clockSkew = 5 * time.Minute
+
+// Certificate is a node certificate signed by the IAM Roles Anywhere CA of the cluster, used to obtain credentials through IAM Roles Anywhere.
+type Certificate struct {
+	// CertificatePEM is the PEM encoded certificate.
+	CertificatePEM []byte
+	// PrivateKeyPEM is the PEM encoded private key of the certificate.
+	PrivateKeyPEM []byte
+	// SerialNumber is the hexadecimal serial number of the certificate.
+	SerialNumber string
+	// NotAfter is the expiration time of the certificate.
+	NotAfter time.Time
+}
+
+// IssueCertificate creates a client certificate with the given common name, signed by the given CA.
+func IssueCertificate(caCertPEM, caKeyPEM []byte, commonName string, validity time.Duration) (*Certificate, error) {
+	caCert, err := certs.DecodeCertPEM(caCertPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding CA certificate")
+	}
+	if caCert == nil {
+		return nil, errors.New("CA certificate not found")
+	}
+	caKey, err := certs.DecodePrivateKeyPEM(caKeyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding CA private key")
+	}
+	if caKey == nil {
+		return nil, errors.New("CA private key not found")
+	}
+
+	if validity <= 0 {
+		validity = DefaultCertificateValidity
+	}
+
+	key, err := certs.NewPrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "generating private key")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	if err != nil {
+		return nil, errors.Wrap(err, "generating serial number")
+	}
+
+	now := time.Now().UTC()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "signing certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing signed certificate")
+	}
+
+	return &Certificate{
+		CertificatePEM: certs.EncodeCertPEM(cert),
+		PrivateKeyPEM:  certs.EncodePrivateKeyPEM(key),
+		SerialNumber:   cert.SerialNumber.Text(16),
+		NotAfter:       cert.NotAfter,
+	}, nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolesanywhere
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestIssueCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	ca := secret.NewCertificatesForInitialControlPlane(nil).GetByPurpose(secret.ClusterCA)
+	g.Expect(ca.Generate()).To(Succeed())
+
+	cert, err := IssueCertificate(ca.KeyPair.Cert, ca.KeyPair.Key, "test-machine", time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	parsed, err := certs.DecodeCertPEM(cert.CertificatePEM)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(parsed.Subject.CommonName).To(Equal("test-machine"))
+	g.Expect(parsed.IsCA).To(BeFalse())
+	g.Expect(parsed.ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageClientAuth))
+	g.Expect(parsed.SerialNumber.Text(16)).To(Equal(cert.SerialNumber))
+	g.Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+	caCert, err := certs.DecodeCertPEM(ca.KeyPair.Cert)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(parsed.CheckSignatureFrom(caCert)).To(Succeed())
+
+	key, err := certs.DecodePrivateKeyPEM(cert.PrivateKeyPEM)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(key.Public()).To(Equal(parsed.PublicKey))
+
+	_, err = IssueCertificate([]byte("invalid"), ca.KeyPair.Key, "test-machine", time.Hour)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolesanywhere
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	rolesanywheretypes "github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	stsv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/endpoints"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// DefaultSessionDurationSeconds is the default duration of the sessions vended through the cluster profile.
+const DefaultSessionDurationSeconds int32 = 3600
+
+// invalidNameCharacters matches the characters not allowed in the names of trust anchors and profiles.
+var invalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9-_ ]`)
+
+// Name returns the name of the trust anchor and profile of the cluster.
+func (s *Service) Name() string {
+	return invalidNameCharacters.ReplaceAllString(s.scope.KubernetesClusterName(), "-")
+}
+
+// ReconcileRolesAnywhere creates the trust anchor and the profile of the cluster if they don't exist yet, and makes
+// sure the trust anchor trusts the given CA bundle and the profile allows the configured roles.
+func (s *Service) ReconcileRolesAnywhere(ctx context.Context, caBundle []byte) error {
+	spec := s.scope.RolesAnywhere()
+	if spec == nil {
+		s.scope.Trace("No IAM Roles Anywhere configured for the cluster, skipping")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling IAM Roles Anywhere", "name", s.Name())
+
+	roleARNs, err := s.roleARNs(ctx, spec.Roles)
+	if err != nil {
+		return err
+	}
+
+	status := &infrav1.RolesAnywhereStatus{}
+	if current := s.scope.RolesAnywhereStatus(); current != nil {
+		status = current.DeepCopy()
+	}
+
+	trustAnchor, err := s.reconcileTrustAnchor(ctx, status.TrustAnchorARN, strings.TrimSpace(string(caBundle)))
+	if err != nil {
+		return err
+	}
+	// Record the trust anchor as soon as it exists, so that it isn't lost if the profile can't be reconciled.
+	status.TrustAnchorARN = aws.ToString(trustAnchor.TrustAnchorArn)
+	s.scope.SetRolesAnywhereStatus(status.DeepCopy())
+
+	profile, err := s.reconcileProfile(ctx, status.ProfileARN, roleARNs, sessionDuration(spec))
+	if err != nil {
+		return err
+	}
+	status.ProfileARN = aws.ToString(profile.ProfileArn)
+	status.RoleARNs = roleARNs
+	s.scope.SetRolesAnywhereStatus(status)
+
+	s.scope.Debug("Reconciled IAM Roles Anywhere", "trust_anchor", status.TrustAnchorARN, "profile", status.ProfileARN)
+	return nil
+}
+
+// DeleteRolesAnywhere deletes the profile and the trust anchor of the cluster.
+func (s *Service) DeleteRolesAnywhere(ctx context.Context) error {
+	if s.scope.RolesAnywhere() == nil {
+		s.scope.Trace("No IAM Roles Anywhere configured for the cluster, skipping")
+		return nil
+	}
+
+	status := &infrav1.RolesAnywhereStatus{}
+	if current := s.scope.RolesAnywhereStatus(); current != nil {
+		status = current.DeepCopy()
+	}
+
+	profile, err := s.describeOwnedProfile(ctx, status.ProfileARN)
+	if err != nil {
+		return err
+	}
+	if profile != nil {
+		if _, err := s.RolesAnywhereClient.DeleteProfile(ctx, &rolesanywhere.DeleteProfileInput{ProfileId: profile.ProfileId}); err != nil && !isNotFound(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteRolesAnywhereProfile", "Failed to delete IAM Roles Anywhere profile %q: %v", aws.ToString(profile.ProfileArn), err)
+			return errors.Wrapf(err, "deleting IAM Roles Anywhere profile %q", aws.ToString(profile.ProfileArn))
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRolesAnywhereProfile", "Deleted IAM Roles Anywhere profile %q", aws.ToString(profile.ProfileArn))
+	}
+	status.ProfileARN = ""
+	status.RoleARNs = nil
+	s.scope.SetRolesAnywhereStatus(status.DeepCopy())
+
+	trustAnchor, err := s.describeOwnedTrustAnchor(ctx, status.TrustAnchorARN)
+	if err != nil {
+		return err
+	}
+	if trustAnchor != nil {
+		if _, err := s.RolesAnywhereClient.DeleteTrustAnchor(ctx, &rolesanywhere.DeleteTrustAnchorInput{TrustAnchorId: trustAnchor.TrustAnchorId}); err != nil && !isNotFound(err) {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteRolesAnywhereTrustAnchor", "Failed to delete IAM Roles Anywhere trust anchor %q: %v", aws.ToString(trustAnchor.TrustAnchorArn), err)
+			return errors.Wrapf(err, "deleting IAM Roles Anywhere trust anchor %q", aws.ToString(trustAnchor.TrustAnchorArn))
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRolesAnywhereTrustAnchor", "Deleted IAM Roles Anywhere trust anchor %q", aws.ToString(trustAnchor.TrustAnchorArn))
+	}
+
+	s.scope.SetRolesAnywhereStatus(nil)
+	return nil
+}
+
+func (s *Service) reconcileTrustAnchor(ctx context.Context, trustAnchorARN, caBundle string) (*rolesanywheretypes.TrustAnchorDetail, error) {
+	trustAnchor, err := s.describeOwnedTrustAnchor(ctx, trustAnchorARN)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &rolesanywheretypes.Source{
+		SourceType: rolesanywheretypes.TrustAnchorTypeCertificateBundle,
+		SourceData: &rolesanywheretypes.SourceDataMemberX509CertificateData{Value: caBundle},
+	}
+
+	if trustAnchor == nil {
+		out, err := s.RolesAnywhereClient.CreateTrustAnchor(ctx, &rolesanywhere.CreateTrustAnchorInput{
+			Name:    aws.String(s.Name()),
+			Source:  source,
+			Enabled: aws.Bool(true),
+			Tags:    s.tags(),
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateRolesAnywhereTrustAnchor", "Failed to create IAM Roles Anywhere trust anchor: %v", err)
+			return nil, errors.Wrap(err, "creating IAM Roles Anywhere trust anchor")
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRolesAnywhereTrustAnchor", "Created IAM Roles Anywhere trust anchor %q", aws.ToString(out.TrustAnchor.TrustAnchorArn))
+		return out.TrustAnchor, nil
+	}
+
+	if trustAnchorCABundle(trustAnchor) == caBundle {
+		return trustAnchor, nil
+	}
+
+	// The CA has been rotated.
+	s.scope.Debug("Updating IAM Roles Anywhere trust anchor", "arn", aws.ToString(trustAnchor.TrustAnchorArn))
+	out, err := s.RolesAnywhereClient.UpdateTrustAnchor(ctx, &rolesanywhere.UpdateTrustAnchorInput{
+		TrustAnchorId: trustAnchor.TrustAnchorId,
+		Source:        source,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "updating IAM Roles Anywhere trust anchor %q", aws.ToString(trustAnchor.TrustAnchorArn))
+	}
+	return out.TrustAnchor, nil
+}
+
+func (s *Service) reconcileProfile(ctx context.Context, profileARN string, roleARNs []string, duration int32) (*rolesanywheretypes.ProfileDetail, error) {
+	profile, err := s.describeOwnedProfile(ctx, profileARN)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == nil {
+		out, err := s.RolesAnywhereClient.CreateProfile(ctx, &rolesanywhere.CreateProfileInput{
+			Name:            aws.String(s.Name()),
+			RoleArns:        roleARNs,
+			DurationSeconds: aws.Int32(duration),
+			Enabled:         aws.Bool(true),
+			Tags:            s.tags(),
+		})
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateRolesAnywhereProfile", "Failed to create IAM Roles Anywhere profile: %v", err)
+			return nil, errors.Wrap(err, "creating IAM Roles Anywhere profile")
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRolesAnywhereProfile", "Created IAM Roles Anywhere profile %q", aws.ToString(out.Profile.ProfileArn))
+		return out.Profile, nil
+	}
+
+	if sameStrings(profile.RoleArns, roleARNs) && aws.ToInt32(profile.DurationSeconds) == duration {
+		return profile, nil
+	}
+
+	s.scope.Debug("Updating IAM Roles Anywhere profile", "arn", aws.ToString(profile.ProfileArn))
+	out, err := s.RolesAnywhereClient.UpdateProfile(ctx, &rolesanywhere.UpdateProfileInput{
+		ProfileId:       profile.ProfileId,
+		RoleArns:        roleARNs,
+		DurationSeconds: aws.Int32(duration),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "updating IAM Roles Anywhere profile %q", aws.ToString(profile.ProfileArn))
+	}
+	return out.Profile, nil
+}
+
+// describeOwnedTrustAnchor returns the trust anchor of the cluster, looked up by its ARN if known or else by its name.
+// It returns nil if the trust anchor doesn't exist.
+func (s *Service) describeOwnedTrustAnchor(ctx context.Context, trustAnchorARN string) (*rolesanywheretypes.TrustAnchorDetail, error) {
+	if trustAnchorARN != "" {
+		id, err := resourceID(trustAnchorARN)
+		if err != nil {
+			return nil, err
+		}
+		out, err := s.RolesAnywhereClient.GetTrustAnchor(ctx, &rolesanywhere.GetTrustAnchorInput{TrustAnchorId: aws.String(id)})
+		switch {
+		case isNotFound(err):
+			return nil, nil
+		case err != nil:
+			return nil, errors.Wrapf(err, "getting IAM Roles Anywhere trust anchor %q", trustAnchorARN)
+		}
+		return out.TrustAnchor, nil
+	}
+
+	input := &rolesanywhere.ListTrustAnchorsInput{}
+	for {
+		out, err := s.RolesAnywhereClient.ListTrustAnchors(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing IAM Roles Anywhere trust anchors")
+		}
+		for i := range out.TrustAnchors {
+			trustAnchor := &out.TrustAnchors[i]
+			if aws.ToString(trustAnchor.Name) != s.Name() {
+				continue
+			}
+			owned, err := s.isOwned(ctx, trustAnchor.TrustAnchorArn)
+			if err != nil {
+				return nil, err
+			}
+			if owned {
+				return trustAnchor, nil
+			}
+		}
+		if out.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// describeOwnedProfile returns the profile of the cluster, looked up by its ARN if known or else by its name.
+// It returns nil if the profile doesn't exist.
+func (s *Service) describeOwnedProfile(ctx context.Context, profileARN string) (*rolesanywheretypes.ProfileDetail, error) {
+	if profileARN != "" {
+		id, err := resourceID(profileARN)
+		if err != nil {
+			return nil, err
+		}
+		out, err := s.RolesAnywhereClient.GetProfile(ctx, &rolesanywhere.GetProfileInput{ProfileId: aws.String(id)})
+		switch {
+		case isNotFound(err):
+			return nil, nil
+		case err != nil:
+			return nil, errors.Wrapf(err, "getting IAM Roles Anywhere profile %q", profileARN)
+		}
+		return out.Profile, nil
+	}
+
+	input := &rolesanywhere.ListProfilesInput{}
+	for {
+		out, err := s.RolesAnywhereClient.ListProfiles(ctx, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing IAM Roles Anywhere profiles")
+		}
+		for i := range out.Profiles {
+			profile := &out.Profiles[i]
+			if aws.ToString(profile.Name) != s.Name() {
+				continue
+			}
+			owned, err := s.isOwned(ctx, profile.ProfileArn)
+			if err != nil {
+				return nil, err
+			}
+			if owned {
+				return profile, nil
+			}
+		}
+		if out.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func (s *Service) isOwned(ctx context.Context, resourceARN *string) (bool, error) {
+	out, err := s.RolesAnywhereClient.ListTagsForResource(ctx, &rolesanywhere.ListTagsForResourceInput{ResourceArn: resourceARN})
+	if err != nil {
+		return false, errors.Wrapf(err, "listing tags of %q", aws.ToString(resourceARN))
+	}
+	tags := infrav1.Tags{}
+	for _, tag := range out.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags.HasOwned(s.scope.Name()), nil
+}
+
+func (s *Service) tags() []rolesanywheretypes.Tag {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(s.Name()),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	})
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]rolesanywheretypes.Tag, 0, len(tags))
+	for _, k := range keys {
+		out = append(out, rolesanywheretypes.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return out
+}
+
+// roleARNs returns the ARNs of the given IAM roles, which may already be ARNs.
+func (s *Service) roleARNs(ctx context.Context, roles []string) ([]string, error) {
+	var accountID string
+	out := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if strings.HasPrefix(role, "arn:") {
+			out = append(out, role)
+			continue
+		}
+		if accountID == "" {
+			identity, err := s.STSClient.GetCallerIdentity(ctx, &stsv2.GetCallerIdentityInput{})
+			if err != nil {
+				return nil, errors.Wrap(err, "getting account ID")
+			}
+			accountID = aws.ToString(identity.Account)
+		}
+		out = append(out, fmt.Sprintf("arn:%s:iam::%s:role/%s", endpoints.GetPartitionFromRegion(s.scope.Region()), accountID, role))
+	}
+	return out, nil
+}
+
+func sessionDuration(spec *infrav1.RolesAnywhereSpec) int32 {
+	if spec.SessionDurationSeconds == 0 {
+		return DefaultSessionDurationSeconds
+	}
+	return spec.SessionDurationSeconds
+}
+
+func trustAnchorCABundle(trustAnchor *rolesanywheretypes.TrustAnchorDetail) string {
+	if trustAnchor.Source == nil {
+		return ""
+	}
+	data, ok := trustAnchor.Source.SourceData.(*rolesanywheretypes.SourceDataMemberX509CertificateData)
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(data.Value)
+}
+
+// resourceID returns the ID of a trust anchor or profile given its ARN, e.g. arn:aws:rolesanywhere:us-east-1:123456789012:profile/<id>.
+func resourceID(resourceARN string) (string, error) {
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing ARN %q", resourceARN)
+	}
+	_, id, found := strings.Cut(parsed.Resource, "/")
+	if !found || id == "" {
+		return "", errors.Errorf("invalid IAM Roles Anywhere resource ARN %q", resourceARN)
+	}
+	return id, nil
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return awserrors.ParseSmithyError(err).ErrorCode() == (&rolesanywheretypes.ResourceNotFoundException{}).ErrorCode()
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rolesanywhere
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	rolesanywheretypes "github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/rolesanywhere/mock_rolesanywhereiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/sts/mock_stsiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+const (
+	testClusterName    = "test-cluster"
+	testCABundle       = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----"
+	testTrustAnchorID  = "11111111-2222-3333-4444-555555555555"
+	testTrustAnchorARN = "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/" + testTrustAnchorID
+	testProfileID      = "66666666-7777-8888-9999-000000000000"
+	testProfileARN     = "arn:aws:rolesanywhere:us-west-2:123456789012:profile/" + testProfileID
+	testRoleARN        = "arn:aws:iam::123456789012:role/nodes.cluster-api-provider-aws.sigs.k8s.io"
+)
+
+var testOwnedTags = []rolesanywheretypes.Tag{
+	{Key: aws.String(infrav1.ClusterTagKey(testClusterName)), Value: aws.String(string(infrav1.ResourceLifecycleOwned))},
+}
+
+func TestReconcileRolesAnywhere(t *testing.T) {
+	spec := &infrav1.RolesAnywhereSpec{Roles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}}
+
+	tests := []struct {
+		name       string
+		spec       *infrav1.RolesAnywhereSpec
+		status     *infrav1.RolesAnywhereStatus
+		expect     func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder)
+		wantStatus *infrav1.RolesAnywhereStatus
+		wantErr    bool
+	}{
+		{
+			name:   "does nothing without IAM Roles Anywhere configured",
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {},
+		},
+		{
+			name: "creates the trust anchor and the profile",
+			spec: spec,
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				m.ListTrustAnchors(gomock.Any(), gomock.Any()).Return(&rolesanywhere.ListTrustAnchorsOutput{}, nil)
+				m.CreateTrustAnchor(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *rolesanywhere.CreateTrustAnchorInput, _ ...func(*rolesanywhere.Options)) (*rolesanywhere.CreateTrustAnchorOutput, error) {
+					g := NewWithT(t)
+					g.Expect(aws.ToString(input.Name)).To(Equal(testClusterName))
+					g.Expect(input.Source.SourceType).To(Equal(rolesanywheretypes.TrustAnchorTypeCertificateBundle))
+					g.Expect(input.Source.SourceData).To(Equal(&rolesanywheretypes.SourceDataMemberX509CertificateData{Value: testCABundle}))
+					g.Expect(input.Tags).To(ContainElement(testOwnedTags[0]))
+					return &rolesanywhere.CreateTrustAnchorOutput{TrustAnchor: testTrustAnchor(testCABundle)}, nil
+				})
+				m.ListProfiles(gomock.Any(), gomock.Any()).Return(&rolesanywhere.ListProfilesOutput{}, nil)
+				m.CreateProfile(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *rolesanywhere.CreateProfileInput, _ ...func(*rolesanywhere.Options)) (*rolesanywhere.CreateProfileOutput, error) {
+					g := NewWithT(t)
+					g.Expect(input.RoleArns).To(Equal([]string{testRoleARN}))
+					g.Expect(aws.ToInt32(input.DurationSeconds)).To(Equal(DefaultSessionDurationSeconds))
+					g.Expect(input.Tags).To(ContainElement(testOwnedTags[0]))
+					return &rolesanywhere.CreateProfileOutput{Profile: testProfile([]string{testRoleARN}, DefaultSessionDurationSeconds)}, nil
+				})
+			},
+			wantStatus: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+				RoleARNs:       []string{testRoleARN},
+			},
+		},
+		{
+			name: "adopts the owned trust anchor and profile found by name",
+			spec: spec,
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				m.ListTrustAnchors(gomock.Any(), gomock.Any()).Return(&rolesanywhere.ListTrustAnchorsOutput{
+					TrustAnchors: []rolesanywheretypes.TrustAnchorDetail{*testTrustAnchor(testCABundle)},
+				}, nil)
+				m.ListTagsForResource(gomock.Any(), &rolesanywhere.ListTagsForResourceInput{ResourceArn: aws.String(testTrustAnchorARN)}).
+					Return(&rolesanywhere.ListTagsForResourceOutput{Tags: testOwnedTags}, nil)
+				m.ListProfiles(gomock.Any(), gomock.Any()).Return(&rolesanywhere.ListProfilesOutput{
+					Profiles: []rolesanywheretypes.ProfileDetail{*testProfile([]string{testRoleARN}, DefaultSessionDurationSeconds)},
+				}, nil)
+				m.ListTagsForResource(gomock.Any(), &rolesanywhere.ListTagsForResourceInput{ResourceArn: aws.String(testProfileARN)}).
+					Return(&rolesanywhere.ListTagsForResourceOutput{Tags: testOwnedTags}, nil)
+			},
+			wantStatus: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+				RoleARNs:       []string{testRoleARN},
+			},
+		},
+		{
+			name: "updates the trust anchor after a CA rotation and the profile after a role change",
+			spec: &infrav1.RolesAnywhereSpec{Roles: []string{testRoleARN}, SessionDurationSeconds: 900},
+			status: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+			},
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				m.GetTrustAnchor(gomock.Any(), &rolesanywhere.GetTrustAnchorInput{TrustAnchorId: aws.String(testTrustAnchorID)}).
+					Return(&rolesanywhere.GetTrustAnchorOutput{TrustAnchor: testTrustAnchor("old")}, nil)
+				m.UpdateTrustAnchor(gomock.Any(), &rolesanywhere.UpdateTrustAnchorInput{
+					TrustAnchorId: aws.String(testTrustAnchorID),
+					Source: &rolesanywheretypes.Source{
+						SourceType: rolesanywheretypes.TrustAnchorTypeCertificateBundle,
+						SourceData: &rolesanywheretypes.SourceDataMemberX509CertificateData{Value: testCABundle},
+					},
+				}).Return(&rolesanywhere.UpdateTrustAnchorOutput{TrustAnchor: testTrustAnchor(testCABundle)}, nil)
+				m.GetProfile(gomock.Any(), &rolesanywhere.GetProfileInput{ProfileId: aws.String(testProfileID)}).
+					Return(&rolesanywhere.GetProfileOutput{Profile: testProfile(nil, DefaultSessionDurationSeconds)}, nil)
+				m.UpdateProfile(gomock.Any(), &rolesanywhere.UpdateProfileInput{
+					ProfileId:       aws.String(testProfileID),
+					RoleArns:        []string{testRoleARN},
+					DurationSeconds: aws.Int32(900),
+				}).Return(&rolesanywhere.UpdateProfileOutput{Profile: testProfile([]string{testRoleARN}, 900)}, nil)
+			},
+			wantStatus: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+				RoleARNs:       []string{testRoleARN},
+			},
+		},
+		{
+			name: "records the trust anchor when the profile can't be created",
+			spec: spec,
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				m.ListTrustAnchors(gomock.Any(), gomock.Any()).Return(&rolesanywhere.ListTrustAnchorsOutput{}, nil)
+				m.CreateTrustAnchor(gomock.Any(), gomock.Any()).Return(&rolesanywhere.CreateTrustAnchorOutput{TrustAnchor: testTrustAnchor(testCABundle)}, nil)
+				m.ListProfiles(gomock.Any(), gomock.Any()).Return(&rolesanywhere.ListProfilesOutput{}, nil)
+				m.CreateProfile(gomock.Any(), gomock.Any()).Return(nil, &rolesanywheretypes.AccessDeniedException{})
+			},
+			wantStatus: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			svc, rolesAnywhereMock, clusterScope := testService(t, tt.spec, tt.status)
+			tt.expect(rolesAnywhereMock.EXPECT())
+
+			err := svc.ReconcileRolesAnywhere(context.TODO(), []byte(testCABundle+"\n"))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(clusterScope.RolesAnywhereStatus()).To(Equal(tt.wantStatus))
+		})
+	}
+}
+
+func TestDeleteRolesAnywhere(t *testing.T) {
+	spec := &infrav1.RolesAnywhereSpec{Roles: []string{testRoleARN}}
+
+	tests := []struct {
+		name    string
+		spec    *infrav1.RolesAnywhereSpec
+		status  *infrav1.RolesAnywhereStatus
+		expect  func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name:   "does nothing without IAM Roles Anywhere configured",
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {},
+		},
+		{
+			name: "deletes the profile then the trust anchor",
+			spec: spec,
+			status: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+			},
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				gomock.InOrder(
+					m.GetProfile(gomock.Any(), gomock.Any()).Return(&rolesanywhere.GetProfileOutput{Profile: testProfile([]string{testRoleARN}, DefaultSessionDurationSeconds)}, nil),
+					m.DeleteProfile(gomock.Any(), &rolesanywhere.DeleteProfileInput{ProfileId: aws.String(testProfileID)}).Return(&rolesanywhere.DeleteProfileOutput{}, nil),
+					m.GetTrustAnchor(gomock.Any(), gomock.Any()).Return(&rolesanywhere.GetTrustAnchorOutput{TrustAnchor: testTrustAnchor(testCABundle)}, nil),
+					m.DeleteTrustAnchor(gomock.Any(), &rolesanywhere.DeleteTrustAnchorInput{TrustAnchorId: aws.String(testTrustAnchorID)}).Return(&rolesanywhere.DeleteTrustAnchorOutput{}, nil),
+				)
+			},
+		},
+		{
+			name: "ignores resources already deleted",
+			spec: spec,
+			status: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+			},
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				m.GetProfile(gomock.Any(), gomock.Any()).Return(nil, &rolesanywheretypes.ResourceNotFoundException{})
+				m.GetTrustAnchor(gomock.Any(), gomock.Any()).Return(nil, &rolesanywheretypes.ResourceNotFoundException{})
+			},
+		},
+		{
+			name: "returns an error when the profile can't be deleted",
+			spec: spec,
+			status: &infrav1.RolesAnywhereStatus{
+				TrustAnchorARN: testTrustAnchorARN,
+				ProfileARN:     testProfileARN,
+			},
+			expect: func(m *mock_rolesanywhereiface.MockRolesAnywhereAPIMockRecorder) {
+				m.GetProfile(gomock.Any(), gomock.Any()).Return(&rolesanywhere.GetProfileOutput{Profile: testProfile([]string{testRoleARN}, DefaultSessionDurationSeconds)}, nil)
+				m.DeleteProfile(gomock.Any(), gomock.Any()).Return(nil, &rolesanywheretypes.AccessDeniedException{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			svc, rolesAnywhereMock, clusterScope := testService(t, tt.spec, tt.status)
+			tt.expect(rolesAnywhereMock.EXPECT())
+
+			err := svc.DeleteRolesAnywhere(context.TODO())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.RolesAnywhereStatus()).To(BeNil())
+		})
+	}
+}
+
+func testTrustAnchor(caBundle string) *rolesanywheretypes.TrustAnchorDetail {
+	return &rolesanywheretypes.TrustAnchorDetail{
+		Name:           aws.String(testClusterName),
+		TrustAnchorId:  aws.String(testTrustAnchorID),
+		TrustAnchorArn: aws.String(testTrustAnchorARN),
+		Enabled:        aws.Bool(true),
+		Source: &rolesanywheretypes.Source{
+			SourceType: rolesanywheretypes.TrustAnchorTypeCertificateBundle,
+			SourceData: &rolesanywheretypes.SourceDataMemberX509CertificateData{Value: caBundle},
+		},
+	}
+}
+
+func testProfile(roleARNs []string, duration int32) *rolesanywheretypes.ProfileDetail {
+	return &rolesanywheretypes.ProfileDetail{
+		Name:            aws.String(testClusterName),
+		ProfileId:       aws.String(testProfileID),
+		ProfileArn:      aws.String(testProfileARN),
+		RoleArns:        roleARNs,
+		DurationSeconds: aws.Int32(duration),
+		Enabled:         aws.Bool(true),
+	}
+}
+
+func testService(t *testing.T, spec *infrav1.RolesAnywhereSpec, status *infrav1.RolesAnywhereStatus) (*Service, *mock_rolesanywhereiface.MockRolesAnywhereAPI, *scope.ClusterScope) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	rolesAnywhereMock := mock_rolesanywhereiface.NewMockRolesAnywhereAPI(mockCtrl)
+	stsMock := mock_stsiface.NewMockSTSClient(mockCtrl)
+	stsMock.EXPECT().GetCallerIdentity(gomock.Any(), gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil).AnyTimes()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testClusterName,
+				Namespace: "test-namespace",
+			},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				Region:        "us-west-2",
+				RolesAnywhere: spec,
+			},
+			Status: infrav1.AWSClusterStatus{
+				RolesAnywhere: status,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	svc := NewService(clusterScope)
+	svc.RolesAnywhereClient = rolesAnywhereMock
+	svc.STSClient = stsMock
+
+	return svc, rolesAnywhereMock, clusterScope
+}
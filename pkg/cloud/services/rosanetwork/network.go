@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosanetwork
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+)
+
+// ReconcileNetwork reconciles the VPC, subnets, gateways, route tables and VPC endpoints of the network,
+// and reports the created subnets in status.subnets.
+// Per-resource conditions are set on the ROSANetwork by the network service.
+func (s *Service) ReconcileNetwork(ctx context.Context) error {
+	s.scope.Debug("Reconciling ROSANetwork network infrastructure")
+
+	if s.scope.VPC().ID == "" {
+		if err := s.recoverNetwork(ctx); err != nil {
+			return errors.Wrap(err, "failed to recover network from tags")
+		}
+	}
+	if s.scope.VPC().ID == "" {
+		s.scope.VPC().CidrBlock = s.scope.ROSANetwork.Spec.CIDRBlock
+	}
+
+	if err := s.reconcileSubnetsSpec(ctx); err != nil {
+		return errors.Wrap(err, "failed to reconcile subnets spec")
+	}
+
+	if err := s.NetworkService.ReconcileNetwork(); err != nil {
+		return err
+	}
+
+	s.scope.ROSANetwork.Status.Subnets = rosaNetworkSubnets(s.scope.Subnets())
+	return nil
+}
+
+// recoverNetwork records in status.ec2 the VPC and the subnets tagged as owned by the network, when they are missing
+// from the status, e.g. after the ROSANetwork has been restored from a backup. Created and adopted VPCs and subnets
+// are both tagged as owned by the network, and the subnets with their role, so that they aren't created again.
+func (s *Service) recoverNetwork(ctx context.Context) error {
+	vpcs, err := s.EC2Client.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{
+		Filters: []types.Filter{
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.VPCStates(types.VpcStateAvailable, types.VpcStatePending),
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe owned vpcs")
+	}
+	switch len(vpcs.Vpcs) {
+	case 0:
+		return nil
+	case 1:
+	default:
+		return errors.Errorf("found %d vpcs owned by network %s, expected at most one", len(vpcs.Vpcs), s.scope.Name())
+	}
+
+	vpcID := aws.ToString(vpcs.Vpcs[0].VpcId)
+	s.scope.Info("Recovered VPC from its tags", "vpc-id", vpcID)
+	s.scope.VPC().ID = vpcID
+	s.scope.VPC().CidrBlock = aws.ToString(vpcs.Vpcs[0].CidrBlock)
+	if len(s.scope.Subnets()) > 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(vpcID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe owned subnets of vpc %q", vpcID)
+	}
+	subnets := make(infrav1.Subnets, 0, len(out.Subnets))
+	for _, subnet := range out.Subnets {
+		subnetID := aws.ToString(subnet.SubnetId)
+		role := ""
+		for _, tag := range subnet.Tags {
+			if aws.ToString(tag.Key) == infrav1.NameAWSClusterAPIRole {
+				role = aws.ToString(tag.Value)
+			}
+		}
+		subnets = append(subnets, infrav1.SubnetSpec{
+			ID:               subnetID,
+			ResourceID:       subnetID,
+			CidrBlock:        aws.ToString(subnet.CidrBlock),
+			AvailabilityZone: aws.ToString(subnet.AvailabilityZone),
+			IsPublic:         role == infrav1.PublicRoleTagValue,
+		})
+	}
+	s.scope.SetSubnets(subnets)
+	return nil
+}
+
+// DeleteNetwork deletes the network infrastructure, including the security groups of an adopted CloudFormation stack.
+func (s *Service) DeleteNetwork(ctx context.Context) error {
+	s.scope.Debug("Deleting ROSANetwork network infrastructure")
+
+	if err := s.deleteOwnedSecurityGroups(ctx); err != nil {
+		return err
+	}
+
+	if err := s.NetworkService.DeleteNetwork(ctx); err != nil {
+		return err
+	}
+
+	s.scope.ROSANetwork.Status.Subnets = nil
+	return nil
+}
+
+// deleteOwnedSecurityGroups deletes the security groups of the VPC owned by the network. The network service
+// doesn't create security groups for a ROSANetwork, so these are the security groups of an adopted stack, which
+// would otherwise prevent the deletion of the VPC.
+func (s *Service) deleteOwnedSecurityGroups(ctx context.Context) error {
+	if s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe security groups of vpc %q", s.scope.VPC().ID)
+	}
+
+	for _, sg := range out.SecurityGroups {
+		if aws.ToString(sg.GroupName) == "default" {
+			continue
+		}
+		if _, err := s.EC2Client.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: sg.GroupId}); err != nil {
+			if code, _ := awserrors.Code(err); code != awserrors.GroupNotFound {
+				return errors.Wrapf(err, "failed to delete security group %q", aws.ToString(sg.GroupId))
+			}
+		}
+		s.scope.Info("Deleted security group", "security-group-id", aws.ToString(sg.GroupId))
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rosanetwork provides a service to reconcile the network infrastructure of a ROSANetwork
+// through the EC2 API, and to adopt the resources of a ROSANetwork CloudFormation stack.
+package rosanetwork
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/common"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+)
+
+// CloudFormationAPI defines the CloudFormation API used to adopt the resources of a stack.
+type CloudFormationAPI interface {
+	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
+	DescribeStackResources(ctx context.Context, params *cloudformation.DescribeStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourcesOutput, error)
+	GetTemplate(ctx context.Context, params *cloudformation.GetTemplateInput, optFns ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error)
+	UpdateStack(ctx context.Context, params *cloudformation.UpdateStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error)
+	DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error)
+}
+
+// Service reconciles the network infrastructure of a ROSANetwork with the EC2 provisioner.
+// The VPC, subnets, gateways, route tables and VPC endpoints are reconciled by the network service,
+// with the subnets laid out like the ROSA CloudFormation template.
+type Service struct {
+	scope          *scope.ROSANetworkScope
+	EC2Client      common.EC2API
+	CFNClient      CloudFormationAPI
+	NetworkService *network.Service
+}
+
+// NewService returns a new service for the given ROSANetwork scope.
+func NewService(rosaNetworkScope *scope.ROSANetworkScope) *Service {
+	networkService := network.NewService(rosaNetworkScope)
+	return &Service{
+		scope:          rosaNetworkScope,
+		EC2Client:      networkService.EC2Client,
+		CFNClient:      scope.NewCloudFormationClient(rosaNetworkScope, rosaNetworkScope, rosaNetworkScope, rosaNetworkScope.ROSANetwork),
+		NetworkService: networkService,
+	}
+}
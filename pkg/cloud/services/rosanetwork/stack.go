@@ -0,0 +1,434 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosanetwork
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cloudformationtypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"go.yaml.in/yaml/v3"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"
+)
+
+const (
+	cfnResourceTypeVPC             = "AWS::EC2::VPC"
+	cfnResourceTypeSubnet          = "AWS::EC2::Subnet"
+	cfnResourceTypeInternetGateway = "AWS::EC2::InternetGateway"
+	cfnResourceTypeNatGateway      = "AWS::EC2::NatGateway"
+	cfnResourceTypeEIP             = "AWS::EC2::EIP"
+	cfnResourceTypeRouteTable      = "AWS::EC2::RouteTable"
+	cfnResourceTypeVPCEndpoint     = "AWS::EC2::VPCEndpoint"
+	cfnResourceTypeSecurityGroup   = "AWS::EC2::SecurityGroup"
+
+	// cfnPrivateSubnetPrefix is the prefix of the logical IDs of the private subnets of the ROSA CloudFormation template.
+	cfnPrivateSubnetPrefix = "SubnetPrivate"
+
+	cfnValidationError   = "ValidationError"
+	cfnNoUpdatesMessage  = "No updates are to be performed"
+	cfnDeletionPolicy    = "DeletionPolicy"
+	cfnRetainPolicy      = "Retain"
+	cfnResourcesTemplate = "Resources"
+)
+
+// AdoptStack adopts the resources of the CloudFormation stack of the network, and deletes the stack keeping its
+// resources, so that they are reconciled through the EC2 API from then on. The resources are tagged as owned by
+// the network and recorded in status.ec2, the stack is updated to retain all its resources, and then deleted.
+// It returns true once there is no stack left, and false while the stack is being updated or deleted.
+func (s *Service) AdoptStack(ctx context.Context) (bool, error) {
+	stack, err := s.describeStack(ctx)
+	if err != nil {
+		return false, err
+	}
+	rosaNet := s.scope.ROSANetwork
+
+	if stack == nil {
+		if v1beta1conditions.Has(rosaNet, expinfrav1.ROSANetworkStackAdoptedCondition) && !v1beta1conditions.IsTrue(rosaNet, expinfrav1.ROSANetworkStackAdoptedCondition) {
+			s.scope.Info("Adopted the resources of CloudFormation stack", "stack", rosaNet.Spec.StackName)
+			record.Eventf(rosaNet, "SuccessfulAdoptStack", "Adopted the resources of CloudFormation stack %s", rosaNet.Spec.StackName)
+			v1beta1conditions.MarkTrue(rosaNet, expinfrav1.ROSANetworkStackAdoptedCondition)
+			rosaNet.Status.Resources = nil
+		}
+		return true, nil
+	}
+
+	switch stack.StackStatus {
+	case cloudformationtypes.StackStatusCreateComplete,
+		cloudformationtypes.StackStatusUpdateComplete,
+		cloudformationtypes.StackStatusUpdateRollbackComplete:
+	default:
+		if strings.HasSuffix(string(stack.StackStatus), "_IN_PROGRESS") {
+			return false, nil
+		}
+		return false, errors.Errorf("can't adopt the resources of CloudFormation stack %s in %s state", rosaNet.Spec.StackName, stack.StackStatus)
+	}
+
+	resources, err := s.describeStackResources(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := s.adoptStackResources(ctx, resources); err != nil {
+		record.Warnf(rosaNet, "FailedAdoptStack", "Failed to adopt the resources of CloudFormation stack %s: %v", rosaNet.Spec.StackName, err)
+		return false, errors.Wrap(err, "failed to adopt stack resources")
+	}
+
+	retained, err := s.retainStackResources(ctx, stack)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to update stack to retain its resources")
+	}
+	if !retained {
+		v1beta1conditions.MarkFalse(rosaNet, expinfrav1.ROSANetworkStackAdoptedCondition, expinfrav1.ROSANetworkRetainingStackResourcesReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return false, nil
+	}
+
+	s.scope.Info("Deleting CloudFormation stack, retaining its resources", "stack", rosaNet.Spec.StackName)
+	if _, err := s.CFNClient.DeleteStack(ctx, &cloudformation.DeleteStackInput{StackName: aws.String(rosaNet.Spec.StackName)}); err != nil {
+		return false, errors.Wrap(err, "failed to delete stack")
+	}
+	record.Eventf(rosaNet, "InitiatedDeleteStack", "Initiated deletion of CloudFormation stack %s, retaining its resources", rosaNet.Spec.StackName)
+	v1beta1conditions.MarkFalse(rosaNet, expinfrav1.ROSANetworkStackAdoptedCondition, expinfrav1.ROSANetworkDeletingStackReason, clusterv1beta1.ConditionSeverityInfo, "")
+	return false, nil
+}
+
+// DeleteStack deletes the CloudFormation stack of the network if it hasn't been adopted yet, along with the resources
+// it doesn't retain. It returns true once there is no stack left.
+func (s *Service) DeleteStack(ctx context.Context) (bool, error) {
+	stack, err := s.describeStack(ctx)
+	if err != nil {
+		return false, err
+	}
+	if stack == nil {
+		return true, nil
+	}
+
+	switch stack.StackStatus {
+	case cloudformationtypes.StackStatusDeleteInProgress:
+		return false, nil
+	case cloudformationtypes.StackStatusDeleteFailed:
+		return false, errors.Errorf("CloudFormation stack %s deletion failed", s.scope.ROSANetwork.Spec.StackName)
+	}
+	if strings.HasSuffix(string(stack.StackStatus), "_IN_PROGRESS") {
+		return false, nil
+	}
+
+	if _, err := s.CFNClient.DeleteStack(ctx, &cloudformation.DeleteStackInput{StackName: aws.String(s.scope.ROSANetwork.Spec.StackName)}); err != nil {
+		return false, errors.Wrap(err, "failed to delete stack")
+	}
+	return false, nil
+}
+
+// describeStack returns the CloudFormation stack of the network, or nil if it doesn't exist.
+func (s *Service) describeStack(ctx context.Context) (*cloudformationtypes.Stack, error) {
+	out, err := s.CFNClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(s.scope.ROSANetwork.Spec.StackName),
+	})
+	if err != nil {
+		// In case the stack does not exist, AWS returns ValidationError.
+		if code, _ := awserrors.Code(err); code == cfnValidationError {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to describe stack %s", s.scope.ROSANetwork.Spec.StackName)
+	}
+	if len(out.Stacks) == 0 || out.Stacks[0].StackStatus == cloudformationtypes.StackStatusDeleteComplete {
+		return nil, nil
+	}
+	return &out.Stacks[0], nil
+}
+
+func (s *Service) describeStackResources(ctx context.Context) ([]cloudformationtypes.StackResource, error) {
+	out, err := s.CFNClient.DescribeStackResources(ctx, &cloudformation.DescribeStackResourcesInput{
+		StackName: aws.String(s.scope.ROSANetwork.Spec.StackName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe resources of stack %s", s.scope.ROSANetwork.Spec.StackName)
+	}
+	return out.StackResources, nil
+}
+
+// adoptStackResources tags the taggable resources of the stack as owned by the network, so that the network service
+// manages them, and records the VPC and the subnets in status.ec2. The subnets are also tagged with their role, so that
+// the network can be recovered from the tags when status.ec2 is lost.
+func (s *Service) adoptStackResources(ctx context.Context, resources []cloudformationtypes.StackResource) error {
+	var (
+		vpcID            string
+		subnetIDs        []string
+		privateSubnetIDs = map[string]bool{}
+		routeTableIDs    []string
+		resourceIDs      []string
+	)
+	for _, resource := range resources {
+		physicalID := aws.ToString(resource.PhysicalResourceId)
+		if physicalID == "" {
+			continue
+		}
+		switch aws.ToString(resource.ResourceType) {
+		case cfnResourceTypeVPC:
+			vpcID = physicalID
+		case cfnResourceTypeSubnet:
+			subnetIDs = append(subnetIDs, physicalID)
+			privateSubnetIDs[physicalID] = strings.HasPrefix(aws.ToString(resource.LogicalResourceId), cfnPrivateSubnetPrefix)
+		case cfnResourceTypeRouteTable:
+			routeTableIDs = append(routeTableIDs, physicalID)
+		case cfnResourceTypeEIP:
+			allocationID, err := s.eipAllocationID(ctx, physicalID)
+			if err != nil {
+				return err
+			}
+			physicalID = allocationID
+		case cfnResourceTypeInternetGateway, cfnResourceTypeNatGateway, cfnResourceTypeVPCEndpoint, cfnResourceTypeSecurityGroup:
+		default:
+			continue
+		}
+		resourceIDs = append(resourceIDs, physicalID)
+	}
+	if vpcID == "" {
+		return errors.Errorf("stack %s has no VPC", s.scope.ROSANetwork.Spec.StackName)
+	}
+
+	if _, err := s.EC2Client.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: resourceIDs,
+		Tags: []ec2types.Tag{{
+			Key:   aws.String(infrav1.ClusterTagKey(s.scope.Name())),
+			Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+		}},
+	}); err != nil {
+		return errors.Wrap(err, "failed to tag stack resources")
+	}
+	subnetIDsByRole := map[string][]string{}
+	for _, subnetID := range subnetIDs {
+		role := infrav1.PublicRoleTagValue
+		if privateSubnetIDs[subnetID] {
+			role = infrav1.PrivateRoleTagValue
+		}
+		subnetIDsByRole[role] = append(subnetIDsByRole[role], subnetID)
+	}
+	for role, roleSubnetIDs := range subnetIDsByRole {
+		if _, err := s.EC2Client.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: roleSubnetIDs,
+			Tags: []ec2types.Tag{{
+				Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+				Value: aws.String(role),
+			}},
+		}); err != nil {
+			return errors.Wrap(err, "failed to tag stack subnets")
+		}
+	}
+
+	s.scope.VPC().ID = vpcID
+	if len(s.scope.Subnets()) == 0 && len(subnetIDs) > 0 {
+		out, err := s.EC2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{SubnetIds: subnetIDs})
+		if err != nil {
+			return errors.Wrap(err, "failed to describe stack subnets")
+		}
+		subnets := make(infrav1.Subnets, 0, len(out.Subnets))
+		for _, subnet := range out.Subnets {
+			subnetID := aws.ToString(subnet.SubnetId)
+			subnets = append(subnets, infrav1.SubnetSpec{
+				ID:               subnetID,
+				ResourceID:       subnetID,
+				CidrBlock:        aws.ToString(subnet.CidrBlock),
+				AvailabilityZone: aws.ToString(subnet.AvailabilityZone),
+				IsPublic:         !privateSubnetIDs[subnetID],
+			})
+		}
+		s.scope.SetSubnets(subnets)
+	}
+
+	return s.splitPrivateRouteTables(ctx, routeTableIDs)
+}
+
+// splitPrivateRouteTables disassociates the private subnets from the private route table of the stack when they are
+// in another availability zone than the NAT gateway it routes to. The ROSA CloudFormation template routes all the
+// private subnets through the NAT gateway of the first availability zone, while the network service routes each
+// private subnet through the NAT gateway of its availability zone, creating a route table for each disassociated subnet.
+func (s *Service) splitPrivateRouteTables(ctx context.Context, routeTableIDs []string) error {
+	if len(routeTableIDs) == 0 {
+		return nil
+	}
+
+	zones := map[string]string{}
+	for _, subnet := range s.scope.Subnets() {
+		zones[subnet.GetResourceID()] = subnet.AvailabilityZone
+	}
+
+	out, err := s.EC2Client.DescribeRouteTables(ctx, &ec2.DescribeRouteTablesInput{RouteTableIds: routeTableIDs})
+	if err != nil {
+		return errors.Wrap(err, "failed to describe stack route tables")
+	}
+	for _, routeTable := range out.RouteTables {
+		natGatewayID := ""
+		for _, route := range routeTable.Routes {
+			if aws.ToString(route.DestinationCidrBlock) == "0.0.0.0/0" && route.NatGatewayId != nil {
+				natGatewayID = aws.ToString(route.NatGatewayId)
+			}
+		}
+		if natGatewayID == "" {
+			continue
+		}
+
+		natGateways, err := s.EC2Client.DescribeNatGateways(ctx, &ec2.DescribeNatGatewaysInput{NatGatewayIds: []string{natGatewayID}})
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe NAT gateway %s", natGatewayID)
+		}
+		if len(natGateways.NatGateways) == 0 {
+			continue
+		}
+		natGatewayZone := zones[aws.ToString(natGateways.NatGateways[0].SubnetId)]
+
+		for _, association := range routeTable.Associations {
+			subnetID := aws.ToString(association.SubnetId)
+			if subnetID == "" || zones[subnetID] == natGatewayZone {
+				continue
+			}
+			if _, err := s.EC2Client.DisassociateRouteTable(ctx, &ec2.DisassociateRouteTableInput{
+				AssociationId: association.RouteTableAssociationId,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to disassociate subnet %s from route table %s", subnetID, aws.ToString(routeTable.RouteTableId))
+			}
+			s.scope.Info("Disassociated subnet from shared private route table", "subnet-id", subnetID, "route-table-id", aws.ToString(routeTable.RouteTableId))
+		}
+	}
+	return nil
+}
+
+// eipAllocationID returns the allocation ID of an elastic IP, whose physical ID is its public IP in older stacks.
+func (s *Service) eipAllocationID(ctx context.Context, physicalID string) (string, error) {
+	if strings.HasPrefix(physicalID, "eipalloc-") {
+		return physicalID, nil
+	}
+	out, err := s.EC2Client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{PublicIps: []string{physicalID}})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to describe elastic IP %s", physicalID)
+	}
+	if len(out.Addresses) == 0 {
+		return "", errors.Errorf("elastic IP %s not found", physicalID)
+	}
+	return aws.ToString(out.Addresses[0].AllocationId), nil
+}
+
+// retainStackResources updates the stack so that its resources are retained when it is deleted.
+// It returns true once all the resources of the stack are retained.
+func (s *Service) retainStackResources(ctx context.Context, stack *cloudformationtypes.Stack) (bool, error) {
+	template, err := s.CFNClient.GetTemplate(ctx, &cloudformation.GetTemplateInput{
+		StackName:     stack.StackName,
+		TemplateStage: cloudformationtypes.TemplateStageOriginal,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get stack template")
+	}
+
+	body, changed, err := retainTemplateResources(aws.ToString(template.TemplateBody))
+	if err != nil {
+		return false, err
+	}
+	if !changed {
+		return true, nil
+	}
+
+	parameters := make([]cloudformationtypes.Parameter, 0, len(stack.Parameters))
+	for _, parameter := range stack.Parameters {
+		parameters = append(parameters, cloudformationtypes.Parameter{
+			ParameterKey:     parameter.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+
+	s.scope.Info("Updating CloudFormation stack to retain its resources", "stack", aws.ToString(stack.StackName))
+	if _, err := s.CFNClient.UpdateStack(ctx, &cloudformation.UpdateStackInput{
+		StackName:    stack.StackName,
+		TemplateBody: aws.String(body),
+		Parameters:   parameters,
+		Capabilities: stack.Capabilities,
+		Tags:         stack.Tags,
+	}); err != nil {
+		if strings.Contains(err.Error(), cfnNoUpdatesMessage) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// retainTemplateResources sets the deletion policy of all the resources of a CloudFormation template to Retain.
+// It returns the updated template, and whether any deletion policy was changed.
+func retainTemplateResources(template string) (string, bool, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(template), &document); err != nil {
+		return "", false, errors.Wrap(err, "failed to parse stack template")
+	}
+	if len(document.Content) == 0 {
+		return "", false, errors.New("stack template is empty")
+	}
+	resources := mappingValue(document.Content[0], cfnResourcesTemplate)
+	if resources == nil || resources.Kind != yaml.MappingNode {
+		return "", false, errors.New("stack template has no resources")
+	}
+
+	changed := false
+	for i := 1; i < len(resources.Content); i += 2 {
+		resource := resources.Content[i]
+		if resource.Kind != yaml.MappingNode {
+			return "", false, fmt.Errorf("resource %s of stack template isn't a mapping", resources.Content[i-1].Value)
+		}
+		policy := mappingValue(resource, cfnDeletionPolicy)
+		switch {
+		case policy == nil:
+			resource.Content = append(resource.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: cfnDeletionPolicy},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: cfnRetainPolicy},
+			)
+			changed = true
+		case policy.Value != cfnRetainPolicy:
+			*policy = yaml.Node{Kind: yaml.ScalarNode, Value: cfnRetainPolicy}
+			changed = true
+		}
+	}
+	if !changed {
+		return template, false, nil
+	}
+
+	body, err := yaml.Marshal(&document)
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to render stack template")
+	}
+	return string(body), true, nil
+}
+
+// mappingValue returns the value of key in a YAML mapping node, or nil if it isn't set.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
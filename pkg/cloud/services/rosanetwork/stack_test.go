@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosanetwork
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.yaml.in/yaml/v3"
+)
+
+func TestRetainTemplateResources(t *testing.T) {
+	testCases := []struct {
+		name            string
+		template        string
+		expectChanged   bool
+		expectErr       bool
+		expectResources map[string]map[string]any
+	}{
+		{
+			name: "retains all the resources",
+			template: `AWSTemplateFormatVersion: '2010-09-09'
+Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: !Ref VpcCidr
+  SubnetPublic1:
+    Type: AWS::EC2::Subnet
+    DeletionPolicy: Delete
+    Properties:
+      VpcId: !Ref VPC
+`,
+			expectChanged: true,
+			expectResources: map[string]map[string]any{
+				"VPC":           {"Type": "AWS::EC2::VPC", "DeletionPolicy": "Retain"},
+				"SubnetPublic1": {"Type": "AWS::EC2::Subnet", "DeletionPolicy": "Retain"},
+			},
+		},
+		{
+			name: "leaves a template retaining all its resources unchanged",
+			template: `Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+    DeletionPolicy: Retain
+`,
+		},
+		{
+			name:      "fails on a template without resources",
+			template:  "AWSTemplateFormatVersion: '2010-09-09'\n",
+			expectErr: true,
+		},
+		{
+			name:      "fails on an invalid template",
+			template:  "Resources: [",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			body, changed, err := retainTemplateResources(tc.template)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(changed).To(Equal(tc.expectChanged))
+			if !tc.expectChanged {
+				g.Expect(body).To(Equal(tc.template))
+				return
+			}
+
+			// Short form intrinsic functions must be kept.
+			g.Expect(body).To(ContainSubstring("!Ref VPC"))
+
+			var template struct {
+				Resources map[string]map[string]any `yaml:"Resources"`
+			}
+			g.Expect(yaml.Unmarshal([]byte(body), &template)).To(Succeed())
+			for name, expected := range tc.expectResources {
+				g.Expect(template.Resources).To(HaveKey(name))
+				for key, value := range expected {
+					g.Expect(template.Resources[name]).To(HaveKeyWithValue(key, value))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosanetwork
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cidr"
+)
+
+const (
+	// subnetSlotPrefixLength is the prefix length of the subnets. Like the ROSA CloudFormation template,
+	// the first eight /24 blocks of the VPC CIDR block are used, the public subnet of the n-th availability zone
+	// taking the block 2n and its private subnet the block 2n+1, so that adopted stacks keep their layout.
+	subnetSlotPrefixLength = 24
+
+	// maxAvailabilityZones is the maximum number of availability zones of a network.
+	maxAvailabilityZones = 4
+)
+
+// reconcileSubnetsSpec adds the subnets of the availability zones added to the spec to the subnets of the network.
+// The subnets of the availability zones already in use are kept as they are.
+func (s *Service) reconcileSubnetsSpec(ctx context.Context) error {
+	subnets := s.scope.Subnets()
+	zones, err := s.availabilityZones(ctx, subnets)
+	if err != nil {
+		return err
+	}
+
+	subnets, err = desiredSubnets(s.scope.Name(), s.scope.ROSANetwork.Spec.CIDRBlock, zones, subnets)
+	if err != nil {
+		return err
+	}
+	s.scope.SetSubnets(subnets)
+	return nil
+}
+
+// availabilityZones returns the availability zones of the network. When only the number of availability zones is set,
+// the zones already in use are kept, and the next available zones of the region in alphabetical order are added.
+func (s *Service) availabilityZones(ctx context.Context, subnets infrav1.Subnets) ([]string, error) {
+	spec := s.scope.ROSANetwork.Spec
+	if len(spec.AvailabilityZones) > 0 {
+		return spec.AvailabilityZones, nil
+	}
+
+	zones := subnets.GetUniqueZones()
+	sort.Strings(zones)
+	count := max(spec.AvailabilityZoneCount, 1)
+	if len(zones) >= count {
+		return zones, nil
+	}
+
+	out, err := s.EC2Client.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		Filters: []types.Filter{
+			filter.EC2.Available(),
+			filter.EC2.IgnoreLocalZones(),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe availability zones")
+	}
+	available := make([]string, 0, len(out.AvailabilityZones))
+	for _, zone := range out.AvailabilityZones {
+		available = append(available, *zone.ZoneName)
+	}
+	sort.Strings(available)
+
+	for _, zone := range available {
+		if len(zones) == count {
+			break
+		}
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+	if len(zones) < count {
+		return nil, errors.Errorf("region %s has only %d availability zones available, %d requested", s.scope.Region(), len(available), count)
+	}
+	return zones, nil
+}
+
+// desiredSubnets returns the existing subnets, plus a public and a private subnet for each of the zones without subnets.
+// The subnets of a new zone take the first pair of subnet slots of the VPC CIDR block that is still free.
+func desiredSubnets(name, vpcCidrBlock string, zones []string, existing infrav1.Subnets) (infrav1.Subnets, error) {
+	slots, err := subnetSlots(vpcCidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	subnets := existing.DeepCopy()
+	usedZones := sets.New(subnets.GetUniqueZones()...)
+	usedPairs := sets.New[int]()
+	for i, slot := range slots {
+		for _, subnet := range subnets {
+			if subnet.CidrBlock == slot {
+				usedPairs.Insert(i / 2)
+			}
+		}
+	}
+
+	for _, zone := range zones {
+		if usedZones.Has(zone) {
+			continue
+		}
+		pair := -1
+		for i := range maxAvailabilityZones {
+			if !usedPairs.Has(i) {
+				pair = i
+				break
+			}
+		}
+		if pair < 0 {
+			return nil, errors.Errorf("can't add availability zone %s, all the subnet CIDR blocks of the VPC are in use", zone)
+		}
+		usedPairs.Insert(pair)
+		usedZones.Insert(zone)
+
+		subnets = append(subnets,
+			infrav1.SubnetSpec{
+				ID:               fmt.Sprintf("%s-subnet-%s-%s", name, infrav1.PublicRoleTagValue, zone),
+				CidrBlock:        slots[2*pair],
+				AvailabilityZone: zone,
+				IsPublic:         true,
+			},
+			infrav1.SubnetSpec{
+				ID:               fmt.Sprintf("%s-subnet-%s-%s", name, infrav1.PrivateRoleTagValue, zone),
+				CidrBlock:        slots[2*pair+1],
+				AvailabilityZone: zone,
+				IsPublic:         false,
+			},
+		)
+	}
+	return subnets, nil
+}
+
+// subnetSlots returns the CIDR blocks the subnets of the network can use, which are the first /24 blocks of the VPC.
+func subnetSlots(vpcCidrBlock string) ([]string, error) {
+	ip, ipNet, err := net.ParseCIDR(vpcCidrBlock)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse VPC CIDR block %q", vpcCidrBlock)
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+	slotCount := 2 * maxAvailabilityZones
+	if ip.To4() == nil || prefixLength > subnetSlotPrefixLength-3 {
+		return nil, errors.Errorf("VPC CIDR block %q must be an IPv4 block of at least %d /%d blocks", vpcCidrBlock, slotCount, subnetSlotPrefixLength)
+	}
+
+	first := &net.IPNet{IP: ipNet.IP, Mask: net.CIDRMask(subnetSlotPrefixLength-3, 32)}
+	blocks, err := cidr.SplitIntoSubnetsIPv4(first.String(), slotCount)
+	if err != nil {
+		return nil, err
+	}
+	slots := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		slots = append(slots, block.String())
+	}
+	return slots, nil
+}
+
+// rosaNetworkSubnets groups the public and private subnets of the network by availability zone.
+func rosaNetworkSubnets(subnets infrav1.Subnets) []expinfrav1.ROSANetworkSubnet {
+	byZone := map[string]*expinfrav1.ROSANetworkSubnet{}
+	zones := []string{}
+	for _, subnet := range subnets {
+		if subnet.ResourceID == "" {
+			continue
+		}
+		rosaSubnet, ok := byZone[subnet.AvailabilityZone]
+		if !ok {
+			rosaSubnet = &expinfrav1.ROSANetworkSubnet{AvailabilityZone: subnet.AvailabilityZone}
+			byZone[subnet.AvailabilityZone] = rosaSubnet
+			zones = append(zones, subnet.AvailabilityZone)
+		}
+		if subnet.IsPublic {
+			rosaSubnet.PublicSubnet = subnet.ResourceID
+		} else {
+			rosaSubnet.PrivateSubnet = subnet.ResourceID
+		}
+	}
+
+	sort.Strings(zones)
+	rosaSubnets := make([]expinfrav1.ROSANetworkSubnet, 0, len(zones))
+	for _, zone := range zones {
+		rosaSubnets = append(rosaSubnets, *byZone[zone])
+	}
+	return rosaSubnets
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosanetwork
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestSubnetSlots(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cidrBlock string
+		expected  []string
+		expectErr bool
+	}{
+		{
+			name:      "uses the first /24 blocks of the VPC",
+			cidrBlock: "10.0.0.0/16",
+			expected: []string{
+				"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24", "10.0.3.0/24",
+				"10.0.4.0/24", "10.0.5.0/24", "10.0.6.0/24", "10.0.7.0/24",
+			},
+		},
+		{
+			name:      "accepts a /21 VPC",
+			cidrBlock: "10.10.8.0/21",
+			expected: []string{
+				"10.10.8.0/24", "10.10.9.0/24", "10.10.10.0/24", "10.10.11.0/24",
+				"10.10.12.0/24", "10.10.13.0/24", "10.10.14.0/24", "10.10.15.0/24",
+			},
+		},
+		{
+			name:      "rejects a VPC smaller than /21",
+			cidrBlock: "10.0.0.0/22",
+			expectErr: true,
+		},
+		{
+			name:      "rejects an IPv6 VPC",
+			cidrBlock: "2001:db8::/56",
+			expectErr: true,
+		},
+		{
+			name:      "rejects an invalid CIDR block",
+			cidrBlock: "10.0.0.0",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			slots, err := subnetSlots(tc.cidrBlock)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(slots).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestDesiredSubnets(t *testing.T) {
+	existing := infrav1.Subnets{
+		{ID: "subnet-1", ResourceID: "subnet-1", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "subnet-2", ResourceID: "subnet-2", CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1a"},
+	}
+
+	testCases := []struct {
+		name      string
+		zones     []string
+		existing  infrav1.Subnets
+		expected  infrav1.Subnets
+		expectErr bool
+	}{
+		{
+			name:  "creates a subnet pair per zone",
+			zones: []string{"us-east-1a", "us-east-1b"},
+			expected: infrav1.Subnets{
+				{ID: "net-subnet-public-us-east-1a", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1a", IsPublic: true},
+				{ID: "net-subnet-private-us-east-1a", CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1a"},
+				{ID: "net-subnet-public-us-east-1b", CidrBlock: "10.0.2.0/24", AvailabilityZone: "us-east-1b", IsPublic: true},
+				{ID: "net-subnet-private-us-east-1b", CidrBlock: "10.0.3.0/24", AvailabilityZone: "us-east-1b"},
+			},
+		},
+		{
+			name:     "keeps the subnets of the existing zones",
+			zones:    []string{"us-east-1a"},
+			existing: existing,
+			expected: existing,
+		},
+		{
+			name:     "adds a zone in the next free slots",
+			zones:    []string{"us-east-1a", "us-east-1c"},
+			existing: existing,
+			expected: append(existing.DeepCopy(),
+				infrav1.SubnetSpec{ID: "net-subnet-public-us-east-1c", CidrBlock: "10.0.2.0/24", AvailabilityZone: "us-east-1c", IsPublic: true},
+				infrav1.SubnetSpec{ID: "net-subnet-private-us-east-1c", CidrBlock: "10.0.3.0/24", AvailabilityZone: "us-east-1c"},
+			),
+		},
+		{
+			name:  "skips the slots used by adopted subnets",
+			zones: []string{"us-east-1b", "us-east-1c"},
+			existing: infrav1.Subnets{
+				{ID: "subnet-3", ResourceID: "subnet-3", CidrBlock: "10.0.2.0/24", AvailabilityZone: "us-east-1b", IsPublic: true},
+				{ID: "subnet-4", ResourceID: "subnet-4", CidrBlock: "10.0.3.0/24", AvailabilityZone: "us-east-1b"},
+			},
+			expected: infrav1.Subnets{
+				{ID: "subnet-3", ResourceID: "subnet-3", CidrBlock: "10.0.2.0/24", AvailabilityZone: "us-east-1b", IsPublic: true},
+				{ID: "subnet-4", ResourceID: "subnet-4", CidrBlock: "10.0.3.0/24", AvailabilityZone: "us-east-1b"},
+				{ID: "net-subnet-public-us-east-1c", CidrBlock: "10.0.0.0/24", AvailabilityZone: "us-east-1c", IsPublic: true},
+				{ID: "net-subnet-private-us-east-1c", CidrBlock: "10.0.1.0/24", AvailabilityZone: "us-east-1c"},
+			},
+		},
+		{
+			name:      "fails when all the slots are in use",
+			zones:     []string{"us-east-1a", "us-east-1b", "us-east-1c", "us-east-1d", "us-east-1e"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			subnets, err := desiredSubnets("net", "10.0.0.0/16", tc.zones, tc.existing)
+			if tc.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnets).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestROSANetworkSubnets(t *testing.T) {
+	g := NewWithT(t)
+
+	subnets := infrav1.Subnets{
+		{ResourceID: "subnet-b-public", AvailabilityZone: "us-east-1b", IsPublic: true},
+		{ResourceID: "subnet-b-private", AvailabilityZone: "us-east-1b"},
+		{ResourceID: "subnet-a-private", AvailabilityZone: "us-east-1a"},
+		{ResourceID: "subnet-a-public", AvailabilityZone: "us-east-1a", IsPublic: true},
+		{ID: "net-subnet-public-us-east-1c", AvailabilityZone: "us-east-1c", IsPublic: true},
+	}
+
+	g.Expect(rosaNetworkSubnets(subnets)).To(Equal([]expinfrav1.ROSANetworkSubnet{
+		{AvailabilityZone: "us-east-1a", PublicSubnet: "subnet-a-public", PrivateSubnet: "subnet-a-private"},
+		{AvailabilityZone: "us-east-1b", PublicSubnet: "subnet-b-public", PrivateSubnet: "subnet-b-private"},
+	}))
+}
@@ -49,6 +49,9 @@ const (
 
 	// s3ActionGetObject is the S3 IAM action for retrieving objects.
 	s3ActionGetObject = "s3:GetObject"
+
+	// s3ActionDeleteObject is the S3 IAM action for deleting objects.
+	s3ActionDeleteObject = "s3:DeleteObject"
 )
 
 // Service holds a collection of interfaces.
@@ -542,6 +545,24 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 		},
 	}
 
+	// Secrets stored by the cluster-object-store secret backend must be encrypted with the cluster key.
+	if secretsKeyARN := secretsKMSKeyARN(s.scope); secretsKeyARN != "" {
+		statements = append(statements, iam.StatementEntry{
+			Sid:    "ForceSecretsKMSKey",
+			Effect: iam.EffectDeny,
+			Principal: map[iam.PrincipalType]iam.PrincipalID{
+				iam.PrincipalAWS: []string{"*"},
+			},
+			Action:   []string{"s3:PutObject"},
+			Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/%s/*", partition, bucketName, secretsKeyPrefix)},
+			Condition: iam.Conditions{
+				"StringNotEquals": map[string]interface{}{
+					"s3:x-amz-server-side-encryption-aws-kms-key-id": secretsKeyARN,
+				},
+			},
+		})
+	}
+
 	if bucket.PresignedURLDuration == nil {
 		if bucket.ControlPlaneIAMInstanceProfile != "" {
 			statements = append(statements, iam.StatementEntry{
@@ -552,6 +573,14 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 				},
 				Action:   []string{s3ActionGetObject},
 				Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/control-plane/*", partition, bucketName)},
+			}, iam.StatementEntry{
+				Sid:    "control-plane-secrets",
+				Effect: iam.EffectAllow,
+				Principal: map[iam.PrincipalType]iam.PrincipalID{
+					iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, *accountID.Account, bucket.ControlPlaneIAMInstanceProfile)},
+				},
+				Action:   []string{s3ActionGetObject, s3ActionDeleteObject},
+				Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/%s/control-plane/*", partition, bucketName, secretsKeyPrefix)},
 			})
 		}
 
@@ -575,6 +604,15 @@ func (s *Service) bucketPolicy(bucketName string) (string, error) {
 					},
 					Action:   []string{s3ActionGetObject},
 					Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/machine-pool/*", partition, bucketName)},
+				},
+				iam.StatementEntry{
+					Sid:    iamInstanceProfile,
+					Effect: iam.EffectAllow,
+					Principal: map[iam.PrincipalType]iam.PrincipalID{
+						iam.PrincipalAWS: []string{fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, *accountID.Account, iamInstanceProfile)},
+					},
+					Action:   []string{s3ActionGetObject, s3ActionDeleteObject},
+					Resource: []string{fmt.Sprintf("arn:%s:s3:::%s/%s/node/*", partition, bucketName, secretsKeyPrefix)},
 				})
 		}
 
@@ -224,6 +224,51 @@ func TestReconcileBucket(t *testing.T) {
 		}
 	})
 
+	t.Run("creates_bucket_with_policy_restricting_secrets_to_node_roles_and_kms_key", func(t *testing.T) {
+		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
+
+		bucketName := "bar"
+		kmsKeyARN := "arn:aws:kms:us-west-2:123456789012:key/test-key"
+
+		svc, s3Mock := testService(t, &testServiceInput{
+			Bucket: &infrav1.S3Bucket{
+				Name:                           bucketName,
+				ControlPlaneIAMInstanceProfile: fmt.Sprintf("control-plane%s", iamv1.DefaultNameSuffix),
+				NodesIAMInstanceProfiles: []string{
+					fmt.Sprintf("nodes%s", iamv1.DefaultNameSuffix),
+				},
+				SecretsKMSKeyARN: kmsKeyARN,
+			},
+		})
+
+		s3Mock.EXPECT().CreateBucket(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketTagging(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketPolicy(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, input *s3svc.PutBucketPolicyInput, optFns ...func(*s3svc.Options)) {
+			policy := *input.Policy
+
+			if !strings.Contains(policy, fmt.Sprintf("%s/secrets/control-plane/*", bucketName)) {
+				t.Errorf("At least one policy should apply for all secret objects with %q prefix, got: %v", "control-plane", policy)
+			}
+
+			if !strings.Contains(policy, fmt.Sprintf("%s/secrets/node/*", bucketName)) {
+				t.Errorf("At least one policy should apply for all secret objects with %q prefix, got: %v", "node", policy)
+			}
+
+			if !strings.Contains(policy, "s3:DeleteObject") {
+				t.Errorf("Expected nodes to be allowed to delete their secrets; got: %v", policy)
+			}
+
+			if !strings.Contains(policy, kmsKeyARN) {
+				t.Errorf("Expected deny when not using the secrets KMS key; got: %v", policy)
+			}
+		}).Return(nil, nil).Times(1)
+		s3Mock.EXPECT().PutBucketLifecycleConfiguration(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		if err := svc.ReconcileBucket(context.TODO()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
 	t.Run("is_idempotent", func(t *testing.T) {
 		utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)
 
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/mime"
+)
+
+const (
+	// secretsKeyPrefix is the prefix of the objects holding bootstrap data secrets.
+	secretsKeyPrefix = "secrets"
+)
+
+// SecretService stores bootstrap data secrets as single SSE-KMS encrypted objects in the
+// cluster S3 bucket, implementing the cluster-object-store secret backend.
+type SecretService struct {
+	scope    scope.S3Scope
+	S3Client S3API
+}
+
+// NewSecretService returns a new secret service given the api clients.
+func NewSecretService(s3Scope scope.S3Scope) *SecretService {
+	return &SecretService{
+		scope:    s3Scope,
+		S3Client: scope.NewS3Client(s3Scope, s3Scope, s3Scope, s3Scope.InfraCluster()),
+	}
+}
+
+// Create stores data as a single object in the cluster S3 bucket for a given machine. The URL of the
+// object is returned as secret prefix, together with a secret count of 1.
+func (s *SecretService) Create(m *scope.MachineScope, data []byte) (string, int32, error) {
+	bucket := s.scope.Bucket()
+	if bucket == nil {
+		return "", 0, errors.New("the cluster-object-store secret backend requires a cluster wide object storage configured at `AWSCluster.spec.s3Bucket`")
+	}
+
+	if m == nil {
+		return "", 0, errors.New("machine scope can't be nil")
+	}
+
+	if len(data) == 0 {
+		return "", 0, errors.New("got empty data")
+	}
+
+	key := path.Join(secretsKeyPrefix, m.Role(), m.Name())
+
+	input := &s3.PutObjectInput{
+		Body:                 bytes.NewReader(data),
+		Bucket:               aws.String(bucket.Name),
+		Key:                  aws.String(key),
+		ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+	}
+	if keyARN := secretsKMSKeyARN(s.scope); keyARN != "" {
+		input.SSEKMSKeyId = aws.String(keyARN)
+	}
+
+	s.scope.Info("Creating secret object", "bucket_name", bucket.Name, "key", key)
+
+	if _, err := s.S3Client.PutObject(context.TODO(), input); err != nil {
+		return "", 0, errors.Wrap(err, "putting secret object")
+	}
+
+	objectURL := &url.URL{
+		Scheme: "s3",
+		Host:   bucket.Name,
+		Path:   key,
+	}
+
+	return objectURL.String(), 1, nil
+}
+
+// Delete the secret object belonging to a machine from the cluster S3 bucket. The object is usually
+// deleted by the node itself after fetching it, so absent objects are ignored.
+func (s *SecretService) Delete(m *scope.MachineScope) error {
+	objectURL, err := url.Parse(m.GetSecretPrefix())
+	if err != nil || objectURL.Scheme != "s3" {
+		return errors.Errorf("invalid secret prefix %q, expected an S3 object URL", m.GetSecretPrefix())
+	}
+
+	bucket := objectURL.Host
+	key := strings.TrimPrefix(objectURL.Path, "/")
+
+	if _, err := s.S3Client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		smithyErr := awserrors.ParseSmithyError(err)
+		switch smithyErr.ErrorCode() {
+		case (&s3types.NoSuchKey{}).ErrorCode(), (&s3types.NoSuchBucket{}).ErrorCode():
+			s.scope.Debug("Secret object already deleted", "bucket", bucket, "key", key)
+			return nil
+		}
+		return errors.Wrap(err, "deleting secret object")
+	}
+
+	return nil
+}
+
+// secretsKMSKeyARN returns the ARN of the KMS key encrypting the secrets stored in the cluster bucket, which
+// defaults to the cluster KMS key. It returns an empty string if the AWS managed key for S3 is used.
+func secretsKMSKeyARN(s3Scope scope.S3Scope) string {
+	if bucket := s3Scope.Bucket(); bucket != nil && bucket.SecretsKMSKeyARN != "" {
+		return bucket.SecretsKMSKeyARN
+	}
+	if kmsScope, ok := s3Scope.(scope.KMSScope); ok {
+		return kmsScope.KMSKeyARN()
+	}
+	return ""
+}
+
+// UserData creates a multi-part MIME document including a script boothook to
+// download userdata from the cluster S3 bucket and then restart cloud-init, and an include part
+// specifying the on disk location of the new userdata.
+func (s *SecretService) UserData(secretPrefix string, chunks int32, region string) ([]byte, error) {
+	userData, err := mime.GenerateInitDocument(secretPrefix, chunks, region, secretFetchScript)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return userData, nil
+}
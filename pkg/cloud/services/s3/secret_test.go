@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3svc "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3/mock_s3iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestSecretServiceCreate(t *testing.T) {
+	const kmsKeyARN = "arn:aws:kms:us-west-2:123456789012:key/test-key"
+
+	tests := []struct {
+		name       string
+		bucket     *infrav1.S3Bucket
+		expect     func(m *mock_s3iface.MockS3APIMockRecorder)
+		wantPrefix string
+		wantErr    bool
+	}{
+		{
+			name:    "fails without a cluster bucket",
+			expect:  func(m *mock_s3iface.MockS3APIMockRecorder) {},
+			wantErr: true,
+		},
+		{
+			name:   "puts the secret as a single object using the default KMS key",
+			bucket: &infrav1.S3Bucket{Name: "bar"},
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.PutObject(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *s3svc.PutObjectInput, _ ...func(*s3svc.Options)) (*s3svc.PutObjectOutput, error) {
+					g := NewWithT(t)
+					g.Expect(aws.ToString(input.Bucket)).To(Equal("bar"))
+					g.Expect(aws.ToString(input.Key)).To(Equal("secrets/node/aws-test1"))
+					g.Expect(input.ServerSideEncryption).To(Equal(types.ServerSideEncryptionAwsKms))
+					g.Expect(input.SSEKMSKeyId).To(BeNil())
+					data, err := io.ReadAll(input.Body)
+					g.Expect(err).NotTo(HaveOccurred())
+					g.Expect(data).To(Equal([]byte("foobar")))
+					return &s3svc.PutObjectOutput{}, nil
+				})
+			},
+			wantPrefix: "s3://bar/secrets/node/aws-test1",
+		},
+		{
+			name:   "puts the secret using the configured KMS key",
+			bucket: &infrav1.S3Bucket{Name: "bar", SecretsKMSKeyARN: kmsKeyARN},
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.PutObject(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, input *s3svc.PutObjectInput, _ ...func(*s3svc.Options)) (*s3svc.PutObjectOutput, error) {
+					NewWithT(t).Expect(aws.ToString(input.SSEKMSKeyId)).To(Equal(kmsKeyARN))
+					return &s3svc.PutObjectOutput{}, nil
+				})
+			},
+			wantPrefix: "s3://bar/secrets/node/aws-test1",
+		},
+		{
+			name:   "fails when the object can't be put",
+			bucket: &infrav1.S3Bucket{Name: "bar"},
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.PutObject(gomock.Any(), gomock.Any()).Return(nil, errors.New("AccessDenied"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			svc, s3Mock := testSecretService(t, tt.bucket)
+			tt.expect(s3Mock.EXPECT())
+
+			prefix, count, err := svc.Create(testSecretMachineScope(""), []byte("foobar"))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(prefix).To(Equal(tt.wantPrefix))
+			g.Expect(count).To(BeEquivalentTo(1))
+		})
+	}
+}
+
+func TestSecretServiceDelete(t *testing.T) {
+	tests := []struct {
+		name         string
+		secretPrefix string
+		expect       func(m *mock_s3iface.MockS3APIMockRecorder)
+		wantErr      bool
+	}{
+		{
+			name:         "deletes the object referenced by the secret prefix",
+			secretPrefix: "s3://bar/secrets/node/aws-test1",
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.DeleteObject(gomock.Any(), &s3svc.DeleteObjectInput{
+					Bucket: aws.String("bar"),
+					Key:    aws.String("secrets/node/aws-test1"),
+				}).Return(&s3svc.DeleteObjectOutput{}, nil)
+			},
+		},
+		{
+			name:         "ignores objects already deleted by the node",
+			secretPrefix: "s3://bar/secrets/node/aws-test1",
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.DeleteObject(gomock.Any(), gomock.Any()).Return(nil, &types.NoSuchKey{})
+			},
+		},
+		{
+			name:         "fails on other errors",
+			secretPrefix: "s3://bar/secrets/node/aws-test1",
+			expect: func(m *mock_s3iface.MockS3APIMockRecorder) {
+				m.DeleteObject(gomock.Any(), gomock.Any()).Return(nil, errors.New("AccessDenied"))
+			},
+			wantErr: true,
+		},
+		{
+			name:         "fails on a secret prefix of another backend",
+			secretPrefix: "aws.cluster.x-k8s.io/aws-test1",
+			expect:       func(m *mock_s3iface.MockS3APIMockRecorder) {},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			svc, s3Mock := testSecretService(t, &infrav1.S3Bucket{Name: "bar"})
+			tt.expect(s3Mock.EXPECT())
+
+			err := svc.Delete(testSecretMachineScope(tt.secretPrefix))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestSecretServiceUserData(t *testing.T) {
+	g := NewWithT(t)
+	svc, _ := testSecretService(t, &infrav1.S3Bucket{Name: "bar"})
+
+	userData, err := svc.UserData("s3://bar/secrets/node/aws-test1", 1, testAWSRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(userData)).To(ContainSubstring("s3://bar/secrets/node/aws-test1"))
+	g.Expect(string(userData)).To(ContainSubstring("aws s3"))
+}
+
+func testSecretService(t *testing.T, bucket *infrav1.S3Bucket) (*s3.SecretService, *mock_s3iface.MockS3API) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	s3Mock := mock_s3iface.NewMockS3API(mockCtrl)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testClusterName,
+				Namespace: testClusterNamespace,
+			},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				S3Bucket: bucket,
+				Region:   testAWSRegion,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	svc := s3.NewSecretService(clusterScope)
+	svc.S3Client = s3Mock
+
+	return svc, s3Mock
+}
+
+func testSecretMachineScope(secretPrefix string) *scope.MachineScope {
+	return &scope.MachineScope{
+		Machine: &clusterv1.Machine{},
+		AWSMachine: &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "aws-test1",
+			},
+			Spec: infrav1.AWSMachineSpec{
+				CloudInit: infrav1.CloudInit{
+					SecretPrefix: secretPrefix,
+				},
+			},
+		},
+	}
+}
@@ -91,6 +91,7 @@ func (s *Service) retryableCreateSecret(name string, chunk []byte, tags infrav1.
 	_, err := s.SecretsManagerClient.CreateSecret(context.TODO(), &secretsmanager.CreateSecretInput{
 		Name:         aws.String(name),
 		SecretBinary: chunk,
+		KmsKeyId:     s.kmsKeyID(),
 		Tags:         converters.MapToSecretsManagerTags(tags),
 	})
 	// If the secret already exists, delete it, return request to retry, as deletes are eventually consistent
@@ -104,6 +105,14 @@ func (s *Service) retryableCreateSecret(name string, chunk []byte, tags infrav1.
 	return true, err
 }
 
+// kmsKeyID returns the cluster KMS key encrypting the secrets, or nil to use the AWS managed key for Secrets Manager.
+func (s *Service) kmsKeyID() *string {
+	if kmsScope, ok := s.scope.(scope.KMSScope); ok && kmsScope.KMSKeyARN() != "" {
+		return aws.String(kmsScope.KMSKeyARN())
+	}
+	return nil
+}
+
 // forceDeleteSecretEntry deletes a single secret, ignoring if it is absent.
 func (s *Service) forceDeleteSecretEntry(name string) error {
 	_, err := s.SecretsManagerClient.DeleteSecret(context.TODO(), &secretsmanager.DeleteSecretInput{
@@ -99,6 +99,7 @@ func (s *Service) retryableCreateSecret(name string, chunk []byte, tags infrav1.
 	_, err := s.SSMClient.PutParameter(context.TODO(), &ssm.PutParameterInput{
 		Name:  aws.String(name),
 		Value: aws.String(string(chunk)),
+		KeyId: s.kmsKeyID(),
 		Tags:  converters.MapToSSMTags(tags),
 		Type:  types.ParameterTypeSecureString,
 	})
@@ -108,6 +109,14 @@ func (s *Service) retryableCreateSecret(name string, chunk []byte, tags infrav1.
 	return true, err
 }
 
+// kmsKeyID returns the cluster KMS key encrypting the parameters, or nil to use the AWS managed key for SSM.
+func (s *Service) kmsKeyID() *string {
+	if kmsScope, ok := s.scope.(scope.KMSScope); ok && kmsScope.KMSKeyARN() != "" {
+		return aws.String(kmsScope.KMSKeyARN())
+	}
+	return nil
+}
+
 // forceDeleteSecretEntry deletes a single secret, ignoring if it is absent.
 func (s *Service) forceDeleteSecretEntry(name string) error {
 	_, err := s.SSMClient.DeleteParameter(context.TODO(), &ssm.DeleteParameterInput{
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// RolesAnywhereSigningHelperPath is the path of the IAM Roles Anywhere credential helper expected on the AMI.
+	RolesAnywhereSigningHelperPath = "/usr/local/bin/aws_signing_helper"
+
+	rolesAnywhereCertificatePath = "/etc/aws/rolesanywhere/node.crt"
+	rolesAnywhereKeyPath         = "/etc/aws/rolesanywhere/node.key"
+	awsConfigPath                = "/root/.aws/config"
+
+	cloudConfigHeader = "#cloud-config"
+)
+
+// RolesAnywhereInput defines the context to generate the IAM Roles Anywhere files of a node.
+type RolesAnywhereInput struct {
+	TrustAnchorARN string
+	ProfileARN     string
+	RoleARN        string
+	Region         string
+	Certificate    []byte
+	PrivateKey     []byte
+}
+
+// RolesAnywhereFiles returns the files that configure the AWS SDKs and CLI of a node to obtain credentials through
+// IAM Roles Anywhere using the node certificate.
+func RolesAnywhereFiles(input *RolesAnywhereInput) []Files {
+	credentialProcess := strings.Join([]string{
+		RolesAnywhereSigningHelperPath, "credential-process",
+		"--certificate", rolesAnywhereCertificatePath,
+		"--private-key", rolesAnywhereKeyPath,
+		"--trust-anchor-arn", input.TrustAnchorARN,
+		"--profile-arn", input.ProfileARN,
+		"--role-arn", input.RoleARN,
+	}, " ")
+
+	return []Files{
+		{
+			Path:        rolesAnywhereCertificatePath,
+			Owner:       "root:root",
+			Permissions: "0644",
+			Content:     string(input.Certificate),
+		},
+		{
+			Path:        rolesAnywhereKeyPath,
+			Owner:       "root:root",
+			Permissions: "0600",
+			Content:     string(input.PrivateKey),
+		},
+		{
+			Path:        awsConfigPath,
+			Owner:       "root:root",
+			Permissions: "0600",
+			Content:     fmt.Sprintf("[default]\nregion = %s\ncredential_process = %s\n", input.Region, credentialProcess),
+		},
+	}
+}
+
+// InjectCloudInitFiles adds the given files to the write_files of cloud-config user data.
+// The comment lines preceding the document, such as the cloud-config and jinja template headers, are preserved.
+func InjectCloudInitFiles(userData []byte, files []Files) ([]byte, error) {
+	lines := strings.Split(string(userData), "\n")
+	var header []string
+	for len(lines) > 0 && strings.HasPrefix(lines[0], "#") {
+		header = append(header, lines[0])
+		lines = lines[1:]
+	}
+	if len(header) == 0 {
+		header = []string{cloudConfigHeader}
+	}
+
+	config := yaml.MapSlice{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &config); err != nil {
+		return nil, errors.Wrap(err, "parsing cloud-config user data")
+	}
+
+	writeFiles := make([]interface{}, 0, len(files))
+	for _, f := range files {
+		writeFiles = append(writeFiles, yaml.MapSlice{
+			{Key: "path", Value: f.Path},
+			{Key: "encoding", Value: "b64"},
+			{Key: "owner", Value: f.Owner},
+			{Key: "permissions", Value: f.Permissions},
+			{Key: "content", Value: base64.StdEncoding.EncodeToString([]byte(f.Content))},
+		})
+	}
+
+	found := false
+	for i := range config {
+		if config[i].Key != "write_files" {
+			continue
+		}
+		existing, ok := config[i].Value.([]interface{})
+		if !ok && config[i].Value != nil {
+			return nil, errors.New("unexpected write_files in cloud-config user data")
+		}
+		config[i].Value = append(existing, writeFiles...)
+		found = true
+	}
+	if !found {
+		config = append(config, yaml.MapItem{Key: "write_files", Value: writeFiles})
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling cloud-config user data")
+	}
+	return []byte(strings.Join(header, "\n") + "\n" + string(out)), nil
+}
+
+// InjectIgnitionFiles adds the given files to the storage of an ignition config. Both ignition v2 and v3 are supported.
+func InjectIgnitionFiles(userData []byte, files []Files) ([]byte, error) {
+	config := map[string]interface{}{}
+	if err := json.Unmarshal(userData, &config); err != nil {
+		return nil, errors.Wrap(err, "parsing ignition user data")
+	}
+
+	ignition, _ := config["ignition"].(map[string]interface{})
+	version, _ := ignition["version"].(string)
+	v2 := strings.HasPrefix(version, "2.")
+	if !v2 && !strings.HasPrefix(version, "3.") {
+		return nil, errors.Errorf("unsupported ignition version %q", version)
+	}
+
+	storage, _ := config["storage"].(map[string]interface{})
+	if storage == nil {
+		storage = map[string]interface{}{}
+	}
+	ignitionFiles, _ := storage["files"].([]interface{})
+
+	for _, f := range files {
+		mode, err := strconv.ParseInt(f.Permissions, 8, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing permissions of %q", f.Path)
+		}
+		file := map[string]interface{}{
+			"path": f.Path,
+			"mode": mode,
+			"contents": map[string]interface{}{
+				"source": "data:;base64," + base64.StdEncoding.EncodeToString([]byte(f.Content)),
+			},
+		}
+		if v2 {
+			file["filesystem"] = "root"
+			file["user"] = map[string]interface{}{"name": "root"}
+		} else {
+			file["overwrite"] = true
+		}
+		ignitionFiles = append(ignitionFiles, file)
+	}
+
+	storage["files"] = ignitionFiles
+	config["storage"] = storage
+
+	out, err := json.Marshal(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling ignition user data")
+	}
+	return out, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var testFiles = []Files{
+	{Path: "/etc/test", Owner: "root:root", Permissions: "0600", Content: "test"},
+}
+
+func TestRolesAnywhereFiles(t *testing.T) {
+	g := NewWithT(t)
+
+	files := RolesAnywhereFiles(&RolesAnywhereInput{
+		TrustAnchorARN: "arn:aws:rolesanywhere:us-east-1:123456789012:trust-anchor/ta",
+		ProfileARN:     "arn:aws:rolesanywhere:us-east-1:123456789012:profile/p",
+		RoleARN:        "arn:aws:iam::123456789012:role/nodes",
+		Region:         "us-east-1",
+		Certificate:    []byte("cert"),
+		PrivateKey:     []byte("key"),
+	})
+	g.Expect(files).To(HaveLen(3))
+	g.Expect(files[1].Permissions).To(Equal("0600"))
+	g.Expect(files[2].Content).To(ContainSubstring("region = us-east-1\n"))
+	g.Expect(files[2].Content).To(ContainSubstring("credential_process = /usr/local/bin/aws_signing_helper credential-process --certificate /etc/aws/rolesanywhere/node.crt --private-key /etc/aws/rolesanywhere/node.key " +
+		"--trust-anchor-arn arn:aws:rolesanywhere:us-east-1:123456789012:trust-anchor/ta --profile-arn arn:aws:rolesanywhere:us-east-1:123456789012:profile/p --role-arn arn:aws:iam::123456789012:role/nodes"))
+}
+
+func TestInjectCloudInitFiles(t *testing.T) {
+	tests := []struct {
+		name       string
+		userData   string
+		wantHeader string
+		wantFiles  int
+	}{
+		{
+			name:       "appends to existing write_files and keeps the headers",
+			userData:   "## template: jinja\n#cloud-config\n\nwrite_files:\n- path: /etc/existing\n  content: existing\nruncmd:\n- kubeadm init\n",
+			wantHeader: "## template: jinja\n#cloud-config\n",
+			wantFiles:  2,
+		},
+		{
+			name:       "adds write_files",
+			userData:   "#cloud-config\nruncmd:\n- kubeadm join\n",
+			wantHeader: "#cloud-config\n",
+			wantFiles:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			out, err := InjectCloudInitFiles([]byte(tt.userData), testFiles)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(strings.HasPrefix(string(out), tt.wantHeader)).To(BeTrue())
+
+			config := map[string]interface{}{}
+			g.Expect(yaml.Unmarshal(out, &config)).To(Succeed())
+			g.Expect(config).To(HaveKey("runcmd"))
+			g.Expect(config["write_files"]).To(HaveLen(tt.wantFiles))
+			g.Expect(string(out)).To(ContainSubstring("content: dGVzdA=="))
+		})
+	}
+}
+
+func TestInjectIgnitionFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		userData string
+		wantFile map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "adds files to an ignition v2 config",
+			userData: `{"ignition":{"version":"2.3.0"}}`,
+			wantFile: map[string]interface{}{
+				"filesystem": "root",
+				"path":       "/etc/test",
+				"mode":       float64(0o600),
+				"user":       map[string]interface{}{"name": "root"},
+				"contents":   map[string]interface{}{"source": "data:;base64,dGVzdA=="},
+			},
+		},
+		{
+			name:     "adds files to an ignition v3 config",
+			userData: `{"ignition":{"version":"3.4.0"},"storage":{"files":[]}}`,
+			wantFile: map[string]interface{}{
+				"path":      "/etc/test",
+				"mode":      float64(0o600),
+				"overwrite": true,
+				"contents":  map[string]interface{}{"source": "data:;base64,dGVzdA=="},
+			},
+		},
+		{
+			name:     "rejects an unknown ignition version",
+			userData: `{"ignition":{"version":"1.0.0"}}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			out, err := InjectIgnitionFiles([]byte(tt.userData), testFiles)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			config := map[string]interface{}{}
+			g.Expect(json.Unmarshal(out, &config)).To(Succeed())
+			g.Expect(config["storage"].(map[string]interface{})["files"]).To(ConsistOf(tt.wantFile))
+		})
+	}
+}
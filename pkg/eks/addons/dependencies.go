@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const (
+	vpcCNIAddonName = "vpc-cni"
+
+	// PodIdentityAgentAddonName is the name of the EKS Pod Identity agent addon.
+	PodIdentityAgentAddonName = "eks-pod-identity-agent"
+)
+
+// ErrDependencyCycle is returned when the dependencies of addons form a cycle.
+var ErrDependencyCycle = errors.New("addon dependency cycle")
+
+// DefaultDependencies are the dependencies of well-known addons which don't declare their own.
+// Dependencies which are not part of the desired addons are ignored.
+var DefaultDependencies = map[string][]string{
+	"coredns":                         {vpcCNIAddonName},
+	"aws-ebs-csi-driver":              {vpcCNIAddonName},
+	"aws-efs-csi-driver":              {vpcCNIAddonName},
+	"aws-mountpoint-s3-csi-driver":    {vpcCNIAddonName},
+	"snapshot-controller":             {vpcCNIAddonName},
+	"amazon-cloudwatch-observability": {vpcCNIAddonName},
+	"adot":                            {vpcCNIAddonName},
+	"metrics-server":                  {vpcCNIAddonName},
+}
+
+// Dependencies returns the names of the addons the EKSAddon depends on: the declared ones or the
+// default ones, and the EKS Pod Identity agent when the addon uses EKS Pod Identity.
+func (e *EKSAddon) Dependencies() []string {
+	dependencies := e.DependsOn
+	if len(dependencies) == 0 {
+		dependencies = DefaultDependencies[aws.ToString(e.Name)]
+	}
+	if len(e.PodIdentityAssociations) > 0 && aws.ToString(e.Name) != PodIdentityAgentAddonName && !slices.Contains(dependencies, PodIdentityAgentAddonName) {
+		dependencies = append(slices.Clone(dependencies), PodIdentityAgentAddonName)
+	}
+	return dependencies
+}
+
+// OrderByDependencies returns the addons ordered so that each addon comes after its dependencies,
+// keeping the given order otherwise. An error is returned if the dependencies form a cycle.
+func OrderByDependencies(addons []*EKSAddon) ([]*EKSAddon, error) {
+	names := make(map[string]bool, len(addons))
+	for _, addon := range addons {
+		names[aws.ToString(addon.Name)] = true
+	}
+
+	ordered := make([]*EKSAddon, 0, len(addons))
+	done := make(map[string]bool, len(addons))
+	for len(ordered) < len(addons) {
+		progressed := false
+		for _, addon := range addons {
+			name := aws.ToString(addon.Name)
+			if done[name] {
+				continue
+			}
+			ready := true
+			for _, dependency := range addon.Dependencies() {
+				if names[dependency] && !done[dependency] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				ordered = append(ordered, addon)
+				done[name] = true
+				progressed = true
+			}
+		}
+
+		if !progressed {
+			remaining := []string{}
+			for _, addon := range addons {
+				if !done[aws.ToString(addon.Name)] {
+					remaining = append(remaining, aws.ToString(addon.Name))
+				}
+			}
+			return nil, fmt.Errorf("%w between %s", ErrDependencyCycle, strings.Join(remaining, ", "))
+		}
+	}
+
+	return ordered, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrderByDependencies(t *testing.T) {
+	podIdentity := []PodIdentityAssociation{{ServiceAccount: "ebs-csi-controller-sa", RoleARN: "arn:aws:iam::123456789012:role/ebs"}}
+
+	testCases := []struct {
+		name        string
+		addons      []*EKSAddon
+		expect      []string
+		expectError bool
+	}{
+		{
+			name: "no dependencies keeps the order",
+			addons: []*EKSAddon{
+				{Name: aws.String("addon1")},
+				{Name: aws.String("addon2")},
+			},
+			expect: []string{"addon1", "addon2"},
+		},
+		{
+			name: "default dependencies of well-known addons",
+			addons: []*EKSAddon{
+				{Name: aws.String("coredns")},
+				{Name: aws.String("kube-proxy")},
+				{Name: aws.String("vpc-cni")},
+			},
+			expect: []string{"kube-proxy", "vpc-cni", "coredns"},
+		},
+		{
+			name: "declared dependencies replace the default ones",
+			addons: []*EKSAddon{
+				{Name: aws.String("coredns"), DependsOn: []string{"kube-proxy"}},
+				{Name: aws.String("vpc-cni")},
+				{Name: aws.String("kube-proxy")},
+			},
+			expect: []string{"vpc-cni", "kube-proxy", "coredns"},
+		},
+		{
+			name: "addons using pod identity depend on the pod identity agent",
+			addons: []*EKSAddon{
+				{Name: aws.String("aws-ebs-csi-driver"), PodIdentityAssociations: podIdentity},
+				{Name: aws.String("eks-pod-identity-agent")},
+				{Name: aws.String("vpc-cni")},
+			},
+			expect: []string{"eks-pod-identity-agent", "vpc-cni", "aws-ebs-csi-driver"},
+		},
+		{
+			name: "dependencies which are not desired are ignored",
+			addons: []*EKSAddon{
+				{Name: aws.String("coredns")},
+				{Name: aws.String("addon1"), DependsOn: []string{"addon2"}},
+			},
+			expect: []string{"coredns", "addon1"},
+		},
+		{
+			name: "dependency cycle",
+			addons: []*EKSAddon{
+				{Name: aws.String("addon1"), DependsOn: []string{"addon3"}},
+				{Name: aws.String("addon2")},
+				{Name: aws.String("addon3"), DependsOn: []string{"addon1"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ordered, err := OrderByDependencies(tc.addons)
+			if tc.expectError {
+				g.Expect(err).To(MatchError(ErrDependencyCycle))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			names := []string{}
+			for _, addon := range ordered {
+				names = append(names, aws.ToString(addon.Name))
+			}
+			g.Expect(names).To(Equal(tc.expect))
+		})
+	}
+}
@@ -28,8 +28,8 @@ import (
 )
 
 // NewPlan creates a new Plan to manage EKS addons.
-func NewPlan(clusterName string, desiredAddons, installedAddons []*EKSAddon, client eks.Client, maxWait time.Duration) planner.Plan {
-	return &plan{
+func NewPlan(clusterName string, desiredAddons, installedAddons []*EKSAddon, client eks.Client, maxWait time.Duration) *Plan {
+	return &Plan{
 		installedAddons:           installedAddons,
 		desiredAddons:             desiredAddons,
 		eksClient:                 client,
@@ -39,22 +39,41 @@ func NewPlan(clusterName string, desiredAddons, installedAddons []*EKSAddon, cli
 }
 
 // Plan is a plan that will manage EKS addons.
-type plan struct {
+type Plan struct {
 	installedAddons           []*EKSAddon
 	desiredAddons             []*EKSAddon
 	eksClient                 eks.Client
 	clusterName               string
 	maxWaitActiveUpdateDelete time.Duration
+	waitingFor                map[string][]string
 }
 
 // Create will create the plan (i.e. list of procedures) for managing EKS addons.
-func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
+// Addons are created and updated after their dependencies, which must be active and
+// healthy first. The addons waiting for their dependencies are left out of the plan
+// and reported by WaitingForDependencies.
+func (a *Plan) Create(_ context.Context) ([]planner.Procedure, error) {
 	procedures := []planner.Procedure{}
+	a.waitingFor = map[string][]string{}
+
+	desiredAddons, err := OrderByDependencies(a.desiredAddons)
+	if err != nil {
+		return nil, err
+	}
 
 	// Handle create and update
-	for i := range a.desiredAddons {
-		desired := a.desiredAddons[i]
+	for i := range desiredAddons {
+		desired := desiredAddons[i]
 		installed := a.getInstalled(*desired.Name)
+		if installed == nil || !desired.IsEqual(installed, false) {
+			if dependencies := a.pendingDependencies(desired); len(dependencies) > 0 {
+				a.waitingFor[*desired.Name] = dependencies
+				if installed == nil {
+					continue
+				}
+			}
+		}
+
 		if installed == nil {
 			// Need to add the addon
 			procedures = append(procedures,
@@ -68,6 +87,9 @@ func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
 				procedures = append(procedures, &UpdateAddonTagsProcedure{plan: a, name: *installed.Name})
 			}
 			// Check if we also need to update the addon
+			if _, waiting := a.waitingFor[*desired.Name]; waiting {
+				continue
+			}
 			if !desired.IsEqual(installed, false) {
 				procedures = append(procedures,
 					&UpdateAddonProcedure{plan: a, name: *installed.Name},
@@ -95,7 +117,31 @@ func (a *plan) Create(_ context.Context) ([]planner.Procedure, error) {
 	return procedures, nil
 }
 
-func (a *plan) getInstalled(name string) *EKSAddon {
+// WaitingForDependencies returns the addons left out of the plan, with the dependencies
+// they are waiting for.
+func (a *Plan) WaitingForDependencies() map[string][]string {
+	return a.waitingFor
+}
+
+// pendingDependencies returns the dependencies of an addon which are not active and healthy yet,
+// or which are themselves changed by the plan or waiting for their own dependencies.
+func (a *Plan) pendingDependencies(addon *EKSAddon) []string {
+	pending := []string{}
+	for _, name := range addon.Dependencies() {
+		desired := a.getDesired(name)
+		if desired == nil {
+			continue
+		}
+		installed := a.getInstalled(name)
+		_, waiting := a.waitingFor[name]
+		if installed == nil || waiting || !installed.IsHealthy() || !desired.IsEqual(installed, false) {
+			pending = append(pending, name)
+		}
+	}
+	return pending
+}
+
+func (a *Plan) getInstalled(name string) *EKSAddon {
 	for i := range a.installedAddons {
 		installed := a.installedAddons[i]
 		if *installed.Name == name {
@@ -106,7 +152,7 @@ func (a *plan) getInstalled(name string) *EKSAddon {
 	return nil
 }
 
-func (a *plan) getDesired(name string) *EKSAddon {
+func (a *Plan) getDesired(name string) *EKSAddon {
 	for i := range a.desiredAddons {
 		desired := a.desiredAddons[i]
 		if *desired.Name == name {
@@ -52,6 +52,7 @@ func TestEKSAddonPlan(t *testing.T) {
 		expect            func(m *mock_eksiface.MockEKSAPIMockRecorder)
 		expectCreateError bool
 		expectDoError     bool
+		expectWaitingFor  map[string][]string
 	}{
 		{
 			name: "no desired and no installed",
@@ -405,6 +406,136 @@ func TestEKSAddonPlan(t *testing.T) {
 			expectCreateError: false,
 			expectDoError:     false,
 		},
+		{
+			name: "no installed and 2 desired with dependency - dependent waits for dependency",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					CreateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.CreateAddonInput{
+						AddonName:        aws.String("vpc-cni"),
+						AddonVersion:     aws.String(addon1version),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+						Tags:             createTags(),
+					})).
+					Return(&eks.CreateAddonOutput{
+						Addon: &ekstypes.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonName:    aws.String("vpc-cni"),
+							AddonVersion: aws.String(addon1version),
+							ClusterName:  aws.String(clusterName),
+							Status:       ekstypes.AddonStatusCreating,
+							Tags:         createTags(),
+						},
+					}, nil)
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonInput{
+					AddonName:   aws.String("vpc-cni"),
+					ClusterName: aws.String(clusterName),
+				})).Return(&eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon("coredns", addon1version),
+				createDesiredAddon("vpc-cni", addon1version),
+			},
+			expectWaitingFor: map[string][]string{
+				"coredns": {"vpc-cni"},
+			},
+		},
+		{
+			name: "1 installed and 2 desired with dependency - dependency healthy",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					CreateAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.CreateAddonInput{
+						AddonName:        aws.String("coredns"),
+						AddonVersion:     aws.String(addon1version),
+						ClusterName:      aws.String(clusterName),
+						ResolveConflicts: ekstypes.ResolveConflictsOverwrite,
+						Tags:             createTags(),
+					})).
+					Return(&eks.CreateAddonOutput{
+						Addon: &ekstypes.Addon{
+							AddonArn:     aws.String(addonARN),
+							AddonName:    aws.String("coredns"),
+							AddonVersion: aws.String(addon1version),
+							ClusterName:  aws.String(clusterName),
+							Status:       ekstypes.AddonStatusCreating,
+							Tags:         createTags(),
+						},
+					}, nil)
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonInput{
+					AddonName:   aws.String("coredns"),
+					ClusterName: aws.String(clusterName),
+				})).Return(&eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}, nil)
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon("coredns", addon1version),
+				createDesiredAddon("vpc-cni", addon1version),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon("vpc-cni", addon1version, addonARN, addonStatusActive, addonPreserve),
+			},
+		},
+		{
+			name: "2 installed and 2 desired with dependency - update waits for dependency with issues",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				// No Action expected
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddon("vpc-cni", addon1version),
+				createDesiredAddon("coredns", addon1Upgrade),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddonWithIssues("vpc-cni", addon1version, addonARN, addonStatusActive, "InsufficientNumberOfReplicas"),
+				createInstalledAddon("coredns", addon1version, addonARN, addonStatusActive, addonPreserve),
+			},
+			expectWaitingFor: map[string][]string{
+				"coredns": {"vpc-cni"},
+			},
+		},
+		{
+			name: "3 desired with declared dependencies - dependents wait transitively",
+			desiredAddons: []*EKSAddon{
+				createDesiredAddonDependingOn("addon3", addon1version, "addon2"),
+				createDesiredAddonDependingOn("addon2", addon1Upgrade, addon1Name),
+				createDesiredAddon(addon1Name, addon1version),
+			},
+			installedAddons: []*EKSAddon{
+				createInstalledAddon(addon1Name, addon1version, addonARN, addonStatusCreating, addonPreserve),
+				createInstalledAddon("addon2", addon1version, addonARN, addonStatusActive, addonPreserve),
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddon(gomock.Eq(context.TODO()), gomock.Eq(&eks.DescribeAddonInput{
+					AddonName:   aws.String(addon1Name),
+					ClusterName: aws.String(clusterName),
+				})).Return(&eks.DescribeAddonOutput{
+					Addon: &ekstypes.Addon{
+						Status: ekstypes.AddonStatusActive,
+					},
+				}, nil)
+			},
+			expectWaitingFor: map[string][]string{
+				"addon2": {addon1Name},
+				"addon3": {"addon2"},
+			},
+		},
+		{
+			name: "2 desired with a dependency cycle",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				// No Action expected
+			},
+			desiredAddons: []*EKSAddon{
+				createDesiredAddonDependingOn(addon1Name, addon1version, "addon2"),
+				createDesiredAddonDependingOn("addon2", addon1version, addon1Name),
+			},
+			expectCreateError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -427,6 +558,10 @@ func TestEKSAddonPlan(t *testing.T) {
 			}
 			g.Expect(err).To(BeNil())
 			g.Expect(procedures).NotTo(BeNil())
+			if tc.expectWaitingFor == nil {
+				tc.expectWaitingFor = map[string][]string{}
+			}
+			g.Expect(planner.WaitingForDependencies()).To(Equal(tc.expectWaitingFor))
 
 			for _, proc := range procedures {
 				procErr := proc.Do(ctx)
@@ -484,3 +619,17 @@ func createInstalledAddon(name, version, arn, status string, preserve bool) *EKS
 
 	return desired
 }
+
+func createDesiredAddonDependingOn(name, version string, dependsOn ...string) *EKSAddon {
+	desired := createDesiredAddon(name, version)
+	desired.DependsOn = dependsOn
+
+	return desired
+}
+
+func createInstalledAddonWithIssues(name, version, arn, status string, issues ...string) *EKSAddon {
+	installed := createInstalledAddon(name, version, arn, status, false)
+	installed.Issues = issues
+
+	return installed
+}
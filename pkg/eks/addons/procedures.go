@@ -40,7 +40,7 @@ var (
 
 // DeleteAddonProcedure is a procedure that will delete an EKS addon.
 type DeleteAddonProcedure struct {
-	plan     *plan
+	plan     *Plan
 	name     string
 	preserve bool
 }
@@ -67,7 +67,7 @@ func (p *DeleteAddonProcedure) Name() string {
 
 // UpdateAddonProcedure is a procedure that will update an EKS addon.
 type UpdateAddonProcedure struct {
-	plan *plan
+	plan *Plan
 	name string
 }
 
@@ -80,12 +80,18 @@ func (p *UpdateAddonProcedure) Do(ctx context.Context) error {
 	}
 
 	input := &eks.UpdateAddonInput{
-		AddonName:             desired.Name,
-		AddonVersion:          desired.Version,
-		ClusterName:           &p.plan.clusterName,
-		ConfigurationValues:   desired.Configuration,
-		ResolveConflicts:      converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
-		ServiceAccountRoleArn: desired.ServiceAccountRoleARN,
+		AddonName:               desired.Name,
+		AddonVersion:            desired.Version,
+		ClusterName:             &p.plan.clusterName,
+		ConfigurationValues:     desired.Configuration,
+		ResolveConflicts:        converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
+		ServiceAccountRoleArn:   desired.ServiceAccountRoleARN,
+		PodIdentityAssociations: podIdentityAssociationsToSDK(desired.PodIdentityAssociations),
+	}
+
+	// An empty list removes the pod identity associations of the addon, a nil one leaves them unchanged.
+	if installed := p.plan.getInstalled(p.name); input.PodIdentityAssociations == nil && installed != nil && len(installed.PodIdentityAssociations) > 0 {
+		input.PodIdentityAssociations = []ekstypes.AddonPodIdentityAssociations{}
 	}
 
 	if _, err := p.plan.eksClient.UpdateAddon(ctx, input); err != nil {
@@ -102,7 +108,7 @@ func (p *UpdateAddonProcedure) Name() string {
 
 // UpdateAddonTagsProcedure is a procedure that will update an EKS addon tags.
 type UpdateAddonTagsProcedure struct {
-	plan *plan
+	plan *Plan
 	name string
 }
 
@@ -137,7 +143,7 @@ func (p *UpdateAddonTagsProcedure) Name() string {
 
 // CreateAddonProcedure is a procedure that will create an EKS addon for a cluster.
 type CreateAddonProcedure struct {
-	plan *plan
+	plan *Plan
 	name string
 }
 
@@ -149,13 +155,14 @@ func (p *CreateAddonProcedure) Do(ctx context.Context) error {
 	}
 
 	input := &eks.CreateAddonInput{
-		AddonName:             desired.Name,
-		AddonVersion:          desired.Version,
-		ClusterName:           &p.plan.clusterName,
-		ConfigurationValues:   desired.Configuration,
-		ServiceAccountRoleArn: desired.ServiceAccountRoleARN,
-		ResolveConflicts:      converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
-		Tags:                  desired.Tags,
+		AddonName:               desired.Name,
+		AddonVersion:            desired.Version,
+		ClusterName:             &p.plan.clusterName,
+		ConfigurationValues:     desired.Configuration,
+		ServiceAccountRoleArn:   desired.ServiceAccountRoleARN,
+		PodIdentityAssociations: podIdentityAssociationsToSDK(desired.PodIdentityAssociations),
+		ResolveConflicts:        converters.AddonConflictResolutionToSDK(desired.ResolveConflict),
+		Tags:                    desired.Tags,
 	}
 
 	output, err := p.plan.eksClient.CreateAddon(ctx, input)
@@ -179,7 +186,7 @@ func (p *CreateAddonProcedure) Name() string {
 // to be active in a cluster. Abd optionally include the degraded state.
 // Note: addons may be degraded until there are worker nodes.
 type WaitAddonActiveProcedure struct {
-	plan            *plan
+	plan            *Plan
 	name            string
 	includeDegraded bool
 }
@@ -221,7 +228,7 @@ func (p *WaitAddonActiveProcedure) Name() string {
 // WaitAddonDeleteProcedure is a procedure that will wait for an EKS addon
 // to be deleted from a cluster.
 type WaitAddonDeleteProcedure struct {
-	plan *plan
+	plan *Plan
 	name string
 }
 
@@ -17,6 +17,10 @@ limitations under the License.
 package addons
 
 import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
@@ -24,15 +28,24 @@ import (
 
 // EKSAddon represents an EKS addon.
 type EKSAddon struct {
-	Name                  *string
-	Version               *string
-	ServiceAccountRoleARN *string
-	Configuration         *string
-	Tags                  infrav1.Tags
-	ResolveConflict       *string
-	Preserve              bool
-	ARN                   *string
-	Status                *string
+	Name                    *string
+	Version                 *string
+	ServiceAccountRoleARN   *string
+	PodIdentityAssociations []PodIdentityAssociation
+	Configuration           *string
+	Tags                    infrav1.Tags
+	ResolveConflict         *string
+	Preserve                bool
+	ARN                     *string
+	Status                  *string
+	Issues                  []string
+	DependsOn               []string
+}
+
+// PodIdentityAssociation associates a service account of an EKS addon with an IAM role.
+type PodIdentityAssociation struct {
+	ServiceAccount string
+	RoleARN        string
 }
 
 // IsEqual determines if 2 EKSAddon are equal.
@@ -50,6 +63,9 @@ func (e *EKSAddon) IsEqual(other *EKSAddon, includeTags bool) bool {
 	if !cmp.Equal(e.Configuration, other.Configuration) {
 		return false
 	}
+	if !cmp.Equal(sortedPodIdentityAssociations(e.PodIdentityAssociations), sortedPodIdentityAssociations(other.PodIdentityAssociations)) {
+		return false
+	}
 
 	if includeTags {
 		diffTags := e.Tags.Difference(other.Tags)
@@ -60,3 +76,33 @@ func (e *EKSAddon) IsEqual(other *EKSAddon, includeTags bool) bool {
 
 	return true
 }
+
+// IsHealthy determines if the EKSAddon is active and has no health issues.
+func (e *EKSAddon) IsHealthy() bool {
+	return aws.ToString(e.Status) == string(ekstypes.AddonStatusActive) && len(e.Issues) == 0
+}
+
+func sortedPodIdentityAssociations(associations []PodIdentityAssociation) []PodIdentityAssociation {
+	if len(associations) == 0 {
+		return nil
+	}
+	sorted := append([]PodIdentityAssociation{}, associations...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ServiceAccount < sorted[j].ServiceAccount
+	})
+	return sorted
+}
+
+func podIdentityAssociationsToSDK(associations []PodIdentityAssociation) []ekstypes.AddonPodIdentityAssociations {
+	if len(associations) == 0 {
+		return nil
+	}
+	converted := make([]ekstypes.AddonPodIdentityAssociations, 0, len(associations))
+	for _, association := range associations {
+		converted = append(converted, ekstypes.AddonPodIdentityAssociations{
+			ServiceAccount: aws.String(association.ServiceAccount),
+			RoleArn:        aws.String(association.RoleARN),
+		})
+	}
+	return converted
+}
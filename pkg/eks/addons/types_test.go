@@ -161,6 +161,50 @@ func TestAddOnEqual(t *testing.T) {
 			result:      gomega.BeFalseBecause("addon tags differ and used for comparison"),
 			includeTags: true,
 		},
+		{
+			orig: &EKSAddon{
+				Version: ptr("a"),
+				PodIdentityAssociations: []PodIdentityAssociation{
+					{ServiceAccount: "a", RoleARN: "1"},
+					{ServiceAccount: "b", RoleARN: "2"},
+				},
+			},
+			other: &EKSAddon{
+				Version: ptr("a"),
+				PodIdentityAssociations: []PodIdentityAssociation{
+					{ServiceAccount: "b", RoleARN: "2"},
+					{ServiceAccount: "a", RoleARN: "1"},
+				},
+			},
+			result: gomega.BeTrueBecause("addon pod identity associations are equal in a different order"),
+		},
+		{
+			orig: &EKSAddon{
+				Version: ptr("a"),
+				PodIdentityAssociations: []PodIdentityAssociation{
+					{ServiceAccount: "a", RoleARN: "1"},
+				},
+			},
+			other: &EKSAddon{
+				Version: ptr("a"),
+				PodIdentityAssociations: []PodIdentityAssociation{
+					{ServiceAccount: "a", RoleARN: "2"},
+				},
+			},
+			result: gomega.BeFalseBecause("addon pod identity associations differ"),
+		},
+		{
+			orig: &EKSAddon{
+				Version: ptr("a"),
+			},
+			other: &EKSAddon{
+				Version: ptr("a"),
+				PodIdentityAssociations: []PodIdentityAssociation{
+					{ServiceAccount: "a", RoleARN: "1"},
+				},
+			},
+			result: gomega.BeFalseBecause("addon pod identity associations are removed"),
+		},
 	}
 
 	for _, test := range tests {
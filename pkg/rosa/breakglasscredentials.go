@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+const (
+	// BreakGlassCredentialIDAnnotation is set on the secret holding the kubeconfig of a break glass credential
+	// of spec.breakGlassCredentials, to the ID of the credential in OCM.
+	BreakGlassCredentialIDAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-break-glass-credential-id"
+
+	// BreakGlassCredentialMinTTL is the shortest TTL of a break glass credential accepted by OCM.
+	BreakGlassCredentialMinTTL = 10 * time.Minute
+	// BreakGlassCredentialMaxTTL is the longest TTL of a break glass credential accepted by OCM, and the default one.
+	BreakGlassCredentialMaxTTL = 24 * time.Hour
+
+	// breakGlassCredentialRefreshThreshold is how long before expiry a break glass credential is issued again.
+	// Credentials with a TTL shorter than twice the threshold are issued again once half of their TTL has elapsed.
+	breakGlassCredentialRefreshThreshold = time.Hour
+)
+
+// ListBreakGlassCredentials lists all the break glass credentials of the cluster, whatever their status.
+func (c *ExternalAuthClient) ListBreakGlassCredentials(clusterID string) ([]*cmv1.BreakGlassCredential, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		BreakGlassCredentials().
+		List().Page(1).Size(-1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
+
+// AwaitBreakGlassCredentialsRevocation returns an error while the revocation of the break glass credentials of the
+// cluster is in progress.
+func (c *ExternalAuthClient) AwaitBreakGlassCredentialsRevocation(clusterID string) error {
+	credentials, err := c.ListBreakGlassCredentials(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to list break glass credentials: %w", err)
+	}
+	return awaitBreakGlassCredentialsRevocation(credentials)
+}
+
+// awaitBreakGlassCredentialsRevocation returns an error if one of the credentials is awaiting revocation. No credential
+// is issued until the revocation completes, as OCM would revoke the credentials issued in the meantime as well.
+func awaitBreakGlassCredentialsRevocation(credentials []*cmv1.BreakGlassCredential) error {
+	for _, credential := range credentials {
+		if credential.Status() == cmv1.BreakGlassCredentialStatusAwaitingRevocation {
+			return fmt.Errorf("waiting for the revocation of break glass credential %s to complete", credential.ID())
+		}
+	}
+	return nil
+}
+
+// RevokeBreakGlassCredentials revokes all the break glass credentials of the cluster.
+// OCM doesn't support revoking a single credential.
+func (c *ExternalAuthClient) RevokeBreakGlassCredentials(clusterID string) error {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		BreakGlassCredentials().
+		Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+// ReconcileBreakGlassCredentials revokes all the break glass credentials of the cluster when the
+// BreakGlassCredentialsRevokeAnnotation annotation is set, issues the credentials of spec.breakGlassCredentials
+// which are missing, about to expire, or to rotate, and reports the active credentials in status.breakGlassCredentials.
+// The kubeconfig of each credential is stored in its own secret, and the secrets of the credentials removed from the spec
+// are deleted. The revocation time is recorded in status.breakGlassCredentialsRevokedAt.
+func ReconcileBreakGlassCredentials(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, externalAuthClient *ExternalAuthClient, clusterID string) error {
+	controlPlane := rosaScope.ControlPlane
+
+	if _, ok := controlPlane.Annotations[rosacontrolplanev1.BreakGlassCredentialsRevokeAnnotation]; ok {
+		rosaScope.Info("Revoking all break glass credentials")
+		if err := externalAuthClient.RevokeBreakGlassCredentials(clusterID); err != nil {
+			return fmt.Errorf("failed to revoke break glass credentials: %w", err)
+		}
+		now := metav1.Now()
+		controlPlane.Status.BreakGlassCredentialsRevokedAt = &now
+		delete(controlPlane.Annotations, rosacontrolplanev1.BreakGlassCredentialsRevokeAnnotation)
+		record.Eventf(controlPlane, "BreakGlassCredentialsRevoked", "Revoked all break glass credentials of cluster %s", clusterID)
+	}
+
+	credentials, err := externalAuthClient.ListBreakGlassCredentials(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to list break glass credentials: %w", err)
+	}
+	if err := awaitBreakGlassCredentialsRevocation(credentials); err != nil {
+		return err
+	}
+	var active []*cmv1.BreakGlassCredential
+	activeByID := map[string]*cmv1.BreakGlassCredential{}
+	for _, credential := range credentials {
+		if isActiveBreakGlassCredential(credential) {
+			active = append(active, credential)
+			activeByID[credential.ID()] = credential
+		}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := rosaScope.Client.List(ctx, secrets,
+		client.InNamespace(controlPlane.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: rosaScope.Cluster.Name},
+		client.HasLabels{rosacontrolplanev1.BreakGlassCredentialNameLabel},
+	); err != nil {
+		return fmt.Errorf("failed to list break glass credential secrets: %w", err)
+	}
+	existing := make(map[string]*corev1.Secret, len(secrets.Items))
+	for i := range secrets.Items {
+		existing[secrets.Items[i].Labels[rosacontrolplanev1.BreakGlassCredentialNameLabel]] = &secrets.Items[i]
+	}
+
+	// The credentials already rotated are recorded so that a failed rotation only issues the remaining ones again.
+	_, rotate := controlPlane.Annotations[rosacontrolplanev1.BreakGlassCredentialsRotateAnnotation]
+	if !rotate {
+		controlPlane.Status.RotatedBreakGlassCredentials = nil
+	}
+	credentialNames := map[string]string{}
+	var errs []error
+	for _, spec := range controlPlane.Spec.BreakGlassCredentials {
+		credentialSecret := existing[spec.Name]
+		delete(existing, spec.Name)
+
+		var current *cmv1.BreakGlassCredential
+		if credentialSecret != nil {
+			current = activeByID[credentialSecret.Annotations[BreakGlassCredentialIDAnnotation]]
+		}
+		toRotate := rotate && !slices.Contains(controlPlane.Status.RotatedBreakGlassCredentials, spec.Name)
+		if current != nil && !toRotate && !needsBreakGlassCredentialRefresh(current, breakGlassCredentialTTL(spec)) {
+			credentialNames[current.ID()] = spec.Name
+			continue
+		}
+
+		issued, err := issueBreakGlassCredential(ctx, rosaScope, externalAuthClient, clusterID, spec, credentialSecret)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to issue break glass credential %s: %w", spec.Name, err))
+			if current != nil {
+				credentialNames[current.ID()] = spec.Name
+			}
+			continue
+		}
+		active = append(active, issued)
+		credentialNames[issued.ID()] = spec.Name
+		if toRotate {
+			controlPlane.Status.RotatedBreakGlassCredentials = append(controlPlane.Status.RotatedBreakGlassCredentials, spec.Name)
+		}
+	}
+	if rotate && len(errs) == 0 {
+		delete(controlPlane.Annotations, rosacontrolplanev1.BreakGlassCredentialsRotateAnnotation)
+		controlPlane.Status.RotatedBreakGlassCredentials = nil
+	}
+
+	// Delete the secrets of the credentials removed from the spec.
+	for name, credentialSecret := range existing {
+		rosaScope.Info("Deleting break glass credential secret", "name", name, "secret", credentialSecret.Name)
+		if err := rosaScope.Client.Delete(ctx, credentialSecret); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete secret %s of break glass credential %s: %w", credentialSecret.Name, name, err))
+		}
+	}
+
+	controlPlane.Status.BreakGlassCredentials = nil
+	for _, credential := range active {
+		controlPlane.Status.BreakGlassCredentials = append(controlPlane.Status.BreakGlassCredentials, breakGlassCredentialStatus(credential, credentialNames[credential.ID()]))
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// issueBreakGlassCredential issues a break glass credential, and stores its kubeconfig in the secret of the credential.
+func issueBreakGlassCredential(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, externalAuthClient *ExternalAuthClient, clusterID string,
+	spec rosacontrolplanev1.BreakGlassCredential, existing *corev1.Secret) (*cmv1.BreakGlassCredential, error) {
+	expiration := time.Now().Add(breakGlassCredentialTTL(spec))
+	breakGlassConfig, err := cmv1.NewBreakGlassCredential().
+		Username(names.SimpleNameGenerator.GenerateName(spec.Name + "-")). // OCM requires unique usernames
+		ExpirationTimestamp(expiration).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build break glass credential: %w", err)
+	}
+
+	rosaScope.Info("Issuing break glass credential", "name", spec.Name, "username", breakGlassConfig.Username())
+	credential, err := externalAuthClient.CreateBreakGlassCredential(clusterID, breakGlassConfig)
+	if err != nil {
+		return nil, err
+	}
+	kubeconfigData, err := externalAuthClient.PollKubeconfig(ctx, clusterID, credential.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	credentialSecret := existing
+	if credentialSecret == nil {
+		credentialSecret = rosaScope.BreakGlassCredentialSecret(spec.Name)
+	}
+	credentialSecret.Data = map[string][]byte{secret.KubeconfigDataName: []byte(kubeconfigData)}
+	if credentialSecret.Annotations == nil {
+		credentialSecret.Annotations = map[string]string{}
+	}
+	credentialSecret.Annotations[BreakGlassCredentialIDAnnotation] = credential.ID()
+	if existing != nil {
+		err = rosaScope.Client.Update(ctx, credentialSecret)
+	} else {
+		err = rosaScope.Client.Create(ctx, credentialSecret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to store kubeconfig in secret %s: %w", credentialSecret.Name, err)
+	}
+
+	record.Eventf(rosaScope.ControlPlane, "BreakGlassCredentialIssued", "Issued break glass credential %s with username %s, expiring at %s",
+		spec.Name, breakGlassConfig.Username(), expiration.UTC().Format(time.RFC3339))
+	return credential, nil
+}
+
+// breakGlassCredentialTTL returns the TTL of a break glass credential, defaulting to the longest TTL accepted by OCM.
+func breakGlassCredentialTTL(spec rosacontrolplanev1.BreakGlassCredential) time.Duration {
+	if spec.TTL == nil || spec.TTL.Duration == 0 {
+		return BreakGlassCredentialMaxTTL
+	}
+	return spec.TTL.Duration
+}
+
+// needsBreakGlassCredentialRefresh returns true if the credential is about to expire.
+func needsBreakGlassCredentialRefresh(credential *cmv1.BreakGlassCredential, ttl time.Duration) bool {
+	expiration, ok := credential.GetExpirationTimestamp()
+	if !ok {
+		return true
+	}
+	return time.Until(expiration) < min(breakGlassCredentialRefreshThreshold, ttl/2)
+}
+
+// isActiveBreakGlassCredential returns true if the credential is issued and not expired.
+func isActiveBreakGlassCredential(credential *cmv1.BreakGlassCredential) bool {
+	if credential.Status() != cmv1.BreakGlassCredentialStatusIssued {
+		return false
+	}
+	expiration, ok := credential.GetExpirationTimestamp()
+	return !ok || time.Now().Before(expiration)
+}
+
+func breakGlassCredentialStatus(credential *cmv1.BreakGlassCredential, name string) rosacontrolplanev1.BreakGlassCredentialStatus {
+	status := rosacontrolplanev1.BreakGlassCredentialStatus{
+		ID:       credential.ID(),
+		Username: credential.Username(),
+		Name:     name,
+	}
+	if expiration, ok := credential.GetExpirationTimestamp(); ok {
+		status.ExpirationTimestamp = &metav1.Time{Time: expiration}
+	}
+	return status
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestReconcileBreakGlassCredentials(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	server, err := ocmfake.NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	ocmClient, err := server.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "test-cluster",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator:     &rosaaws.Creator{ARN: "arn:aws:iam::123456789012:user/test", AccountID: "123456789012"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+
+	kubeClient := fake.NewClientBuilder().WithObjects(server.CredentialsSecret("rosa-creds-secret", "default")).Build()
+	rosaScope := &scope.ROSAControlPlaneScope{
+		Client:  kubeClient,
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+		ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+				CredentialsSecretRef:        &corev1.LocalObjectReference{Name: "rosa-creds-secret"},
+				EnableExternalAuthProviders: true,
+				BreakGlassCredentials: []rosacontrolplanev1.BreakGlassCredential{
+					{Name: "oncall"},
+					{Name: "incident", TTL: &metav1.Duration{Duration: 2 * time.Hour}},
+				},
+			},
+		},
+		Logger: *logger.NewLogger(klog.Background()),
+	}
+
+	externalAuthClient, err := rosa.NewExternalAuthClient(ctx, rosaScope)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer externalAuthClient.Close()
+
+	getSecret := func(name string) *corev1.Secret {
+		credentialSecret := rosaScope.BreakGlassCredentialSecret(name)
+		if err := kubeClient.Get(ctx, client.ObjectKeyFromObject(credentialSecret), credentialSecret); err != nil {
+			g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			return nil
+		}
+		return credentialSecret
+	}
+	credentialID := func(name string) string {
+		credentialSecret := getSecret(name)
+		g.Expect(credentialSecret).ToNot(BeNil())
+		return credentialSecret.Annotations[rosa.BreakGlassCredentialIDAnnotation]
+	}
+
+	t.Run("issues the credentials of the spec", func(t *testing.T) {
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+
+		for _, name := range []string{"oncall", "incident"} {
+			credentialSecret := getSecret(name)
+			g.Expect(credentialSecret).ToNot(BeNil())
+			g.Expect(credentialSecret.Name).To(Equal("test-cluster-break-glass-" + name))
+			g.Expect(credentialSecret.Labels).To(HaveKeyWithValue(rosacontrolplanev1.BreakGlassCredentialNameLabel, name))
+			g.Expect(credentialSecret.Data).To(HaveKey(secret.KubeconfigDataName))
+		}
+
+		status := rosaScope.ControlPlane.Status.BreakGlassCredentials
+		g.Expect(status).To(HaveLen(2))
+		for _, credential := range status {
+			g.Expect(credential.ID).To(Equal(credentialID(credential.Name)))
+			g.Expect(strings.HasPrefix(credential.Username, credential.Name+"-")).To(BeTrue())
+			g.Expect(credential.ExpirationTimestamp).ToNot(BeNil())
+		}
+	})
+
+	t.Run("keeps the credentials which are not about to expire", func(t *testing.T) {
+		oncallID, incidentID := credentialID("oncall"), credentialID("incident")
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+		g.Expect(credentialID("oncall")).To(Equal(oncallID))
+		g.Expect(credentialID("incident")).To(Equal(incidentID))
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentials).To(HaveLen(2))
+	})
+
+	t.Run("rotates the credentials when requested", func(t *testing.T) {
+		oncallID := credentialID("oncall")
+		rosaScope.ControlPlane.Annotations = map[string]string{rosacontrolplanev1.BreakGlassCredentialsRotateAnnotation: ""}
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+
+		g.Expect(credentialID("oncall")).ToNot(Equal(oncallID))
+		g.Expect(rosaScope.ControlPlane.Annotations).ToNot(HaveKey(rosacontrolplanev1.BreakGlassCredentialsRotateAnnotation))
+		// The rotated credentials remain active until they expire.
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentials).To(HaveLen(4))
+	})
+
+	t.Run("only rotates the credentials which haven't been rotated yet", func(t *testing.T) {
+		oncallID, incidentID := credentialID("oncall"), credentialID("incident")
+		rosaScope.ControlPlane.Annotations = map[string]string{rosacontrolplanev1.BreakGlassCredentialsRotateAnnotation: ""}
+		rosaScope.ControlPlane.Status.RotatedBreakGlassCredentials = []string{"oncall"}
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+
+		g.Expect(credentialID("oncall")).To(Equal(oncallID))
+		g.Expect(credentialID("incident")).ToNot(Equal(incidentID))
+		g.Expect(rosaScope.ControlPlane.Annotations).ToNot(HaveKey(rosacontrolplanev1.BreakGlassCredentialsRotateAnnotation))
+		g.Expect(rosaScope.ControlPlane.Status.RotatedBreakGlassCredentials).To(BeEmpty())
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentials).To(HaveLen(5))
+	})
+
+	t.Run("revokes all the credentials and issues the credentials of the spec again", func(t *testing.T) {
+		oncallID := credentialID("oncall")
+		rosaScope.ControlPlane.Annotations = map[string]string{rosacontrolplanev1.BreakGlassCredentialsRevokeAnnotation: ""}
+		// No credential is issued until the revocation completes.
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).ToNot(Succeed())
+		g.Expect(credentialID("oncall")).To(Equal(oncallID))
+		g.Expect(rosaScope.ControlPlane.Annotations).ToNot(HaveKey(rosacontrolplanev1.BreakGlassCredentialsRevokeAnnotation))
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentialsRevokedAt).ToNot(BeNil())
+		g.Expect(externalAuthClient.AwaitBreakGlassCredentialsRevocation(cluster.ID())).ToNot(Succeed())
+
+		server.Settle()
+		g.Expect(externalAuthClient.AwaitBreakGlassCredentialsRevocation(cluster.ID())).To(Succeed())
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+
+		g.Expect(credentialID("oncall")).ToNot(Equal(oncallID))
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentials).To(HaveLen(2))
+
+		credentials, err := externalAuthClient.ListBreakGlassCredentials(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(credentials).To(HaveLen(7))
+	})
+
+	t.Run("deletes the secrets of the credentials removed from the spec", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.BreakGlassCredentials = rosaScope.ControlPlane.Spec.BreakGlassCredentials[:1]
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+
+		g.Expect(getSecret("oncall")).ToNot(BeNil())
+		g.Expect(getSecret("incident")).To(BeNil())
+		// The credential remains active until it expires.
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentials).To(HaveLen(2))
+	})
+
+	t.Run("waits for the revocation without credentials in the spec", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.BreakGlassCredentials = nil
+		g.Expect(externalAuthClient.RevokeBreakGlassCredentials(cluster.ID())).To(Succeed())
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).ToNot(Succeed())
+
+		server.Settle()
+		g.Expect(rosa.ReconcileBreakGlassCredentials(ctx, rosaScope, externalAuthClient, cluster.ID())).To(Succeed())
+		g.Expect(rosaScope.ControlPlane.Status.BreakGlassCredentials).To(BeEmpty())
+	})
+}
@@ -41,18 +41,30 @@ const (
 	ocmAPIURLKey       = "ocmApiUrl"
 	ocmClientIDKey     = "ocmClientID"
 	ocmClientSecretKey = "ocmClientSecret"
+	ocmTokenURLKey     = "ocmTokenUrl"
 	capaAgentName      = "CAPA"
+	defaultOCMAPIURL   = "https://api.openshift.com"
 )
 
 // OCMSecretsRetriever contains functions that are needed for creating OCM connection.
 type OCMSecretsRetriever interface {
 	CredentialsSecret() *corev1.Secret
+	OCMAccountRef() *corev1.LocalObjectReference
+	Namespace() string
 	GetClient() client.Client // Or just Client, depending on your actual field
 	Info(msg string, keysAndValues ...interface{})
 }
 
 // NewOCMClient creates a new OCM client.
 func NewOCMClient(ctx context.Context, rosaScope OCMSecretsRetriever) (*ocm.Client, error) {
+	if ocmAccountRef := rosaScope.OCMAccountRef(); ocmAccountRef != nil {
+		connection, err := ocmAccountConnection(ctx, rosaScope, ocmAccountRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		return ocm.NewClientWithConnection(connection), nil
+	}
+
 	token, url, clientID, clientSecret, err := ocmCredentials(ctx, rosaScope)
 	if err != nil {
 		return nil, err
@@ -105,11 +117,8 @@ func NewWrappedOCMClientFromOCMClient(ctx context.Context, ocmClient *ocm.Client
 }
 
 func newOCMRawConnection(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope) (*sdk.Connection, error) {
-	ocmSdkLogger, err := sdk.NewGoLoggerBuilder().
-		Debug(false).
-		Build()
-	if err != nil {
-		return nil, fmt.Errorf("failed to build logger: %w", err)
+	if ocmAccountRef := rosaScope.OCMAccountRef(); ocmAccountRef != nil {
+		return ocmAccountConnection(ctx, rosaScope, ocmAccountRef.Name)
 	}
 
 	token, url, clientID, clientSecret, err := ocmCredentials(ctx, rosaScope)
@@ -117,11 +126,26 @@ func newOCMRawConnection(ctx context.Context, rosaScope *scope.ROSAControlPlaneS
 		return nil, err
 	}
 
+	return buildOCMConnection(url, "", token, clientID, clientSecret)
+}
+
+func buildOCMConnection(url, tokenURL, token, clientID, clientSecret string) (*sdk.Connection, error) {
+	ocmSdkLogger, err := sdk.NewGoLoggerBuilder().
+		Debug(false).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build logger: %w", err)
+	}
+
 	connBuilder := sdk.NewConnectionBuilder().
 		Logger(ocmSdkLogger).
 		URL(url).
 		Agent(capaAgentName + "/" + version.Get().GitVersion + " " + sdk.DefaultAgent)
 
+	if tokenURL != "" {
+		connBuilder.TokenURL(tokenURL)
+	}
+
 	if clientID != "" && clientSecret != "" {
 		connBuilder.Client(clientID, clientSecret)
 	} else if token != "" {
@@ -192,7 +216,7 @@ func ocmCredentials(ctx context.Context, rosaScope OCMSecretsRetriever) (string,
 	}
 
 	if ocmAPIUrl == "" {
-		ocmAPIUrl = "https://api.openshift.com" // Defaults to production URL
+		ocmAPIUrl = defaultOCMAPIURL // Defaults to production URL
 	}
 
 	return token, ocmAPIUrl, ocmClientID, ocmClientSecret, nil
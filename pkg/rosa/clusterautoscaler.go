@@ -0,0 +1,257 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+// ClusterAutoscalerClient handles the cluster autoscaler of a cluster.
+type ClusterAutoscalerClient struct {
+	ocm *sdk.Connection
+}
+
+// NewClusterAutoscalerClient creates and returns a new client to handle the cluster autoscaler.
+func NewClusterAutoscalerClient(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope) (*ClusterAutoscalerClient, error) {
+	ocmConnection, err := newOCMRawConnection(ctx, rosaScope)
+	if err != nil {
+		return nil, err
+	}
+	return &ClusterAutoscalerClient{
+		ocm: ocmConnection,
+	}, nil
+}
+
+// Close closes the underlying ocm connection, unless it is shared by the reconciles using the same OCM account.
+func (c *ClusterAutoscalerClient) Close() error {
+	return closeConnection(c.ocm)
+}
+
+// GetClusterAutoscaler returns the cluster autoscaler, or nil if it doesn't exist.
+func (c *ClusterAutoscalerClient) GetClusterAutoscaler(clusterID string) (*cmv1.ClusterAutoscaler, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		Autoscaler().Get().Send()
+	if response != nil && response.Status() == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// CreateClusterAutoscaler creates the cluster autoscaler.
+func (c *ClusterAutoscalerClient) CreateClusterAutoscaler(clusterID string, autoscaler *cmv1.ClusterAutoscaler) (*cmv1.ClusterAutoscaler, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		Autoscaler().Post().Request(autoscaler).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// UpdateClusterAutoscaler updates the fields of the cluster autoscaler set in autoscaler.
+func (c *ClusterAutoscalerClient) UpdateClusterAutoscaler(clusterID string, autoscaler *cmv1.ClusterAutoscaler) (*cmv1.ClusterAutoscaler, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		Autoscaler().Update().Body(autoscaler).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// DeleteClusterAutoscaler deletes the cluster autoscaler.
+func (c *ClusterAutoscalerClient) DeleteClusterAutoscaler(clusterID string) error {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		Autoscaler().Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+// ReconcileClusterAutoscaler creates or updates the cluster autoscaler to match spec.autoscaler, reverting the changes
+// made to the fields set in the spec outside of it. The cluster autoscaler is deleted when spec.autoscaler is not set.
+func ReconcileClusterAutoscaler(rosaScope *scope.ROSAControlPlaneScope, autoscalerClient *ClusterAutoscalerClient, clusterID string) error {
+	current, err := autoscalerClient.GetClusterAutoscaler(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster autoscaler: %w", err)
+	}
+
+	spec := rosaScope.ControlPlane.Spec.Autoscaler
+	if spec == nil {
+		if current == nil {
+			return nil
+		}
+		rosaScope.Info("Deleting cluster autoscaler")
+		if err := autoscalerClient.DeleteClusterAutoscaler(clusterID); err != nil {
+			return fmt.Errorf("failed to delete cluster autoscaler: %w", err)
+		}
+		return nil
+	}
+
+	autoscaler, err := buildClusterAutoscaler(spec)
+	if err != nil {
+		return fmt.Errorf("failed to build cluster autoscaler: %w", err)
+	}
+
+	if current == nil {
+		rosaScope.Info("Creating cluster autoscaler")
+		if _, err := autoscalerClient.CreateClusterAutoscaler(clusterID, autoscaler); err != nil {
+			return fmt.Errorf("failed to create cluster autoscaler: %w", err)
+		}
+		return nil
+	}
+
+	if drift := clusterAutoscalerDrift(spec, current); len(drift) > 0 {
+		rosaScope.Info("Updating cluster autoscaler", "fields", drift)
+		if _, err := autoscalerClient.UpdateClusterAutoscaler(clusterID, autoscaler); err != nil {
+			return fmt.Errorf("failed to update cluster autoscaler: %w", err)
+		}
+	}
+	return nil
+}
+
+// buildClusterAutoscaler builds a cluster autoscaler with only the fields set in the spec, so that the other fields
+// keep the defaults set by OCM.
+func buildClusterAutoscaler(spec *rosacontrolplanev1.ClusterAutoscaler) (*cmv1.ClusterAutoscaler, error) {
+	builder := cmv1.NewClusterAutoscaler()
+	if spec.BalanceSimilarNodeGroups != nil {
+		builder.BalanceSimilarNodeGroups(*spec.BalanceSimilarNodeGroups)
+	}
+	if spec.BalancingIgnoredLabels != nil {
+		builder.BalancingIgnoredLabels(spec.BalancingIgnoredLabels...)
+	}
+	if spec.SkipNodesWithLocalStorage != nil {
+		builder.SkipNodesWithLocalStorage(*spec.SkipNodesWithLocalStorage)
+	}
+	if spec.IgnoreDaemonSetsUtilization != nil {
+		builder.IgnoreDaemonsetsUtilization(*spec.IgnoreDaemonSetsUtilization)
+	}
+	if spec.MaxPodGracePeriod != nil {
+		builder.MaxPodGracePeriod(int(*spec.MaxPodGracePeriod))
+	}
+	if spec.PodPriorityThreshold != nil {
+		builder.PodPriorityThreshold(int(*spec.PodPriorityThreshold))
+	}
+	if spec.MaxNodeProvisionTime != nil {
+		builder.MaxNodeProvisionTime(spec.MaxNodeProvisionTime.Duration.String())
+	}
+	if spec.MaxNodesTotal != nil {
+		builder.ResourceLimits(cmv1.NewAutoscalerResourceLimits().MaxNodesTotal(int(*spec.MaxNodesTotal)))
+	}
+
+	if scaleDown := spec.ScaleDown; scaleDown != nil {
+		scaleDownBuilder := cmv1.NewAutoscalerScaleDownConfig()
+		if scaleDown.Enabled != nil {
+			scaleDownBuilder.Enabled(*scaleDown.Enabled)
+		}
+		if scaleDown.UnneededTime != nil {
+			scaleDownBuilder.UnneededTime(scaleDown.UnneededTime.Duration.String())
+		}
+		if scaleDown.UtilizationThreshold != "" {
+			scaleDownBuilder.UtilizationThreshold(scaleDown.UtilizationThreshold)
+		}
+		if scaleDown.DelayAfterAdd != nil {
+			scaleDownBuilder.DelayAfterAdd(scaleDown.DelayAfterAdd.Duration.String())
+		}
+		if scaleDown.DelayAfterDelete != nil {
+			scaleDownBuilder.DelayAfterDelete(scaleDown.DelayAfterDelete.Duration.String())
+		}
+		if scaleDown.DelayAfterFailure != nil {
+			scaleDownBuilder.DelayAfterFailure(scaleDown.DelayAfterFailure.Duration.String())
+		}
+		builder.ScaleDown(scaleDownBuilder)
+	}
+
+	return builder.Build()
+}
+
+// clusterAutoscalerDrift returns the fields set in the spec whose value differs in the cluster autoscaler.
+// Durations and ratios are compared by value, as OCM may format them differently.
+func clusterAutoscalerDrift(spec *rosacontrolplanev1.ClusterAutoscaler, current *cmv1.ClusterAutoscaler) []string {
+	var drift []string
+	diff := func(field string, differs bool) {
+		if differs {
+			drift = append(drift, field)
+		}
+	}
+
+	diff("balanceSimilarNodeGroups", boolDiffers(spec.BalanceSimilarNodeGroups, current.BalanceSimilarNodeGroups()))
+	diff("balancingIgnoredLabels", spec.BalancingIgnoredLabels != nil &&
+		!sets.New(spec.BalancingIgnoredLabels...).Equal(sets.New(current.BalancingIgnoredLabels()...)))
+	diff("skipNodesWithLocalStorage", boolDiffers(spec.SkipNodesWithLocalStorage, current.SkipNodesWithLocalStorage()))
+	diff("ignoreDaemonSetsUtilization", boolDiffers(spec.IgnoreDaemonSetsUtilization, current.IgnoreDaemonsetsUtilization()))
+	diff("maxPodGracePeriod", intDiffers(spec.MaxPodGracePeriod, current.MaxPodGracePeriod()))
+	diff("podPriorityThreshold", intDiffers(spec.PodPriorityThreshold, current.PodPriorityThreshold()))
+	diff("maxNodeProvisionTime", durationDiffers(spec.MaxNodeProvisionTime, current.MaxNodeProvisionTime()))
+	diff("maxNodesTotal", intDiffers(spec.MaxNodesTotal, current.ResourceLimits().MaxNodesTotal()))
+
+	if scaleDown := spec.ScaleDown; scaleDown != nil {
+		currentScaleDown := current.ScaleDown()
+		diff("scaleDown.enabled", boolDiffers(scaleDown.Enabled, currentScaleDown.Enabled()))
+		diff("scaleDown.unneededTime", durationDiffers(scaleDown.UnneededTime, currentScaleDown.UnneededTime()))
+		diff("scaleDown.utilizationThreshold", scaleDown.UtilizationThreshold != "" &&
+			!ratioEqual(scaleDown.UtilizationThreshold, currentScaleDown.UtilizationThreshold()))
+		diff("scaleDown.delayAfterAdd", durationDiffers(scaleDown.DelayAfterAdd, currentScaleDown.DelayAfterAdd()))
+		diff("scaleDown.delayAfterDelete", durationDiffers(scaleDown.DelayAfterDelete, currentScaleDown.DelayAfterDelete()))
+		diff("scaleDown.delayAfterFailure", durationDiffers(scaleDown.DelayAfterFailure, currentScaleDown.DelayAfterFailure()))
+	}
+
+	return drift
+}
+
+func boolDiffers(desired *bool, current bool) bool {
+	return desired != nil && *desired != current
+}
+
+func intDiffers(desired *int32, current int) bool {
+	return desired != nil && int(*desired) != current
+}
+
+func durationDiffers(desired *metav1.Duration, current string) bool {
+	if desired == nil {
+		return false
+	}
+	currentDuration, err := time.ParseDuration(current)
+	return err != nil || currentDuration != desired.Duration
+}
+
+func ratioEqual(desired, current string) bool {
+	desiredRatio, err := strconv.ParseFloat(desired, 64)
+	if err != nil {
+		return false
+	}
+	currentRatio, err := strconv.ParseFloat(current, 64)
+	return err == nil && desiredRatio == currentRatio
+}
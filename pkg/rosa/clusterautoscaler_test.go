@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileClusterAutoscaler(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	server, err := ocmfake.NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	ocmClient, err := server.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "test-cluster",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator:     &rosaaws.Creator{ARN: "arn:aws:iam::123456789012:user/test", AccountID: "123456789012"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+
+	kubeClient := fake.NewClientBuilder().WithObjects(server.CredentialsSecret("rosa-creds-secret", "default")).Build()
+	rosaScope := &scope.ROSAControlPlaneScope{
+		Client:  kubeClient,
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+		ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+				CredentialsSecretRef: &corev1.LocalObjectReference{Name: "rosa-creds-secret"},
+				Autoscaler: &rosacontrolplanev1.ClusterAutoscaler{
+					BalanceSimilarNodeGroups: ptr.To(true),
+					MaxPodGracePeriod:        ptr.To[int32](300),
+					MaxNodeProvisionTime:     &metav1.Duration{Duration: 20 * time.Minute},
+					MaxNodesTotal:            ptr.To[int32](100),
+					ScaleDown: &rosacontrolplanev1.AutoscalerScaleDown{
+						UnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+						UtilizationThreshold: "0.6",
+						DelayAfterAdd:        &metav1.Duration{Duration: 15 * time.Minute},
+					},
+				},
+			},
+		},
+		Logger: *logger.NewLogger(klog.Background()),
+	}
+
+	autoscalerClient, err := rosa.NewClusterAutoscalerClient(ctx, rosaScope)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer autoscalerClient.Close()
+
+	getAutoscaler := func() *cmv1.ClusterAutoscaler {
+		autoscaler, err := autoscalerClient.GetClusterAutoscaler(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		return autoscaler
+	}
+
+	t.Run("creates the cluster autoscaler", func(t *testing.T) {
+		g.Expect(getAutoscaler()).To(BeNil())
+		g.Expect(rosa.ReconcileClusterAutoscaler(rosaScope, autoscalerClient, cluster.ID())).To(Succeed())
+
+		autoscaler := getAutoscaler()
+		g.Expect(autoscaler).ToNot(BeNil())
+		g.Expect(autoscaler.BalanceSimilarNodeGroups()).To(BeTrue())
+		g.Expect(autoscaler.MaxPodGracePeriod()).To(Equal(300))
+		g.Expect(autoscaler.MaxNodeProvisionTime()).To(Equal("20m0s"))
+		g.Expect(autoscaler.ResourceLimits().MaxNodesTotal()).To(Equal(100))
+		g.Expect(autoscaler.ScaleDown().UnneededTime()).To(Equal("5m0s"))
+		g.Expect(autoscaler.ScaleDown().UtilizationThreshold()).To(Equal("0.6"))
+		// Fields not set in the spec are left to OCM.
+		_, ok := autoscaler.GetPodPriorityThreshold()
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("reverts changes made outside of the spec", func(t *testing.T) {
+		drifted, err := cmv1.NewClusterAutoscaler().
+			MaxNodeProvisionTime("30m").
+			ScaleDown(cmv1.NewAutoscalerScaleDownConfig().UtilizationThreshold("0.9")).
+			Build()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = autoscalerClient.UpdateClusterAutoscaler(cluster.ID(), drifted)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(rosa.ReconcileClusterAutoscaler(rosaScope, autoscalerClient, cluster.ID())).To(Succeed())
+
+		autoscaler := getAutoscaler()
+		g.Expect(autoscaler.MaxNodeProvisionTime()).To(Equal("20m0s"))
+		g.Expect(autoscaler.ScaleDown().UtilizationThreshold()).To(Equal("0.6"))
+	})
+
+	t.Run("doesn't update an equivalent cluster autoscaler", func(t *testing.T) {
+		equivalent, err := cmv1.NewClusterAutoscaler().
+			MaxNodeProvisionTime("20m").
+			ScaleDown(cmv1.NewAutoscalerScaleDownConfig().UtilizationThreshold("0.600000")).
+			Build()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = autoscalerClient.UpdateClusterAutoscaler(cluster.ID(), equivalent)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(rosa.ReconcileClusterAutoscaler(rosaScope, autoscalerClient, cluster.ID())).To(Succeed())
+
+		autoscaler := getAutoscaler()
+		g.Expect(autoscaler.MaxNodeProvisionTime()).To(Equal("20m"))
+		g.Expect(autoscaler.ScaleDown().UtilizationThreshold()).To(Equal("0.600000"))
+	})
+
+	t.Run("updates the cluster autoscaler when the spec changes", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.Autoscaler.ScaleDown.Enabled = ptr.To(false)
+		rosaScope.ControlPlane.Spec.Autoscaler.MaxNodesTotal = ptr.To[int32](50)
+		g.Expect(rosa.ReconcileClusterAutoscaler(rosaScope, autoscalerClient, cluster.ID())).To(Succeed())
+
+		autoscaler := getAutoscaler()
+		g.Expect(autoscaler.ScaleDown().Enabled()).To(BeFalse())
+		g.Expect(autoscaler.ResourceLimits().MaxNodesTotal()).To(Equal(50))
+	})
+
+	t.Run("deletes the cluster autoscaler removed from the spec", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.Autoscaler = nil
+		g.Expect(rosa.ReconcileClusterAutoscaler(rosaScope, autoscalerClient, cluster.ID())).To(Succeed())
+		g.Expect(getAutoscaler()).To(BeNil())
+	})
+}
@@ -27,9 +27,9 @@ func NewExternalAuthClient(ctx context.Context, rosaScope *scope.ROSAControlPlan
 	}, nil
 }
 
-// Close closes the underlying ocm connection.
+// Close closes the underlying ocm connection, unless it is shared by the reconciles using the same OCM account.
 func (c *ExternalAuthClient) Close() error {
-	return c.ocm.Close()
+	return closeConnection(c.ocm)
 }
 
 // CreateExternalAuth creates a new external auth porivder.
@@ -1,14 +1,32 @@
 package rosa
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
+	sdk "github.com/openshift-online/ocm-sdk-go"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 )
 
 const (
 	clusterAdminUserGroup = "cluster-admins"
-	clusterAdminIDPname   = "cluster-admin"
+
+	// ClusterAdminIdentityProviderName is the name of the identity provider of the cluster-admin user created by the controller.
+	ClusterAdminIdentityProviderName = "cluster-admin"
+
+	// IdentityProvidersLastAppliedAnnotation annotation tracks the identity providers applied from spec.identityProviders
+	// and a hash of their last applied configuration, to inform if an update or a deletion is required.
+	IdentityProvidersLastAppliedAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-last-applied-identity-providers"
 )
 
 // CreateAdminUserIfNotExist creates a new admin user withe username/password in the cluster if username doesn't already exist.
@@ -48,13 +66,13 @@ func CreateAdminUserIfNotExist(client OCMClient, clusterID, username, password s
 	))
 	clusterAdminIDP, err := cmv1.NewIdentityProvider().
 		Type(cmv1.IdentityProviderTypeHtpasswd).
-		Name(clusterAdminIDPname).
+		Name(ClusterAdminIdentityProviderName).
 		Htpasswd(htpasswdIDP).
 		Build()
 	if err != nil {
 		return fmt.Errorf(
 			"failed to create '%s' identity provider for cluster '%s'",
-			clusterAdminIDPname,
+			ClusterAdminIdentityProviderName,
 			clusterID,
 		)
 	}
@@ -96,7 +114,7 @@ func findExistingClusterAdminIDP(client OCMClient, clusterID string) (
 	}
 
 	for _, idp := range idps {
-		if idp.Name() != clusterAdminIDPname {
+		if idp.Name() != ClusterAdminIdentityProviderName {
 			continue
 		}
 
@@ -125,3 +143,399 @@ func hasUser(username string, userList *cmv1.HTPasswdUserList) bool {
 	})
 	return hasUser
 }
+
+// IdentityProviderClient handles the identity providers of a cluster.
+type IdentityProviderClient struct {
+	ocm *sdk.Connection
+}
+
+// NewIdentityProviderClient creates and returns a new client to handle identity providers.
+func NewIdentityProviderClient(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope) (*IdentityProviderClient, error) {
+	ocmConnection, err := newOCMRawConnection(ctx, rosaScope)
+	if err != nil {
+		return nil, err
+	}
+	return &IdentityProviderClient{
+		ocm: ocmConnection,
+	}, nil
+}
+
+// Close closes the underlying ocm connection, unless it is shared by the reconciles using the same OCM account.
+func (c *IdentityProviderClient) Close() error {
+	return closeConnection(c.ocm)
+}
+
+// ListIdentityProviders lists all identity providers of the cluster.
+func (c *IdentityProviderClient) ListIdentityProviders(clusterID string) ([]*cmv1.IdentityProvider, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		IdentityProviders().
+		List().Page(1).Size(-1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
+
+// CreateIdentityProvider creates a new identity provider.
+func (c *IdentityProviderClient) CreateIdentityProvider(clusterID string, idp *cmv1.IdentityProvider) (*cmv1.IdentityProvider, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		IdentityProviders().Add().Body(idp).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// UpdateIdentityProvider updates an existing identity provider.
+func (c *IdentityProviderClient) UpdateIdentityProvider(clusterID string, idpID string, idp *cmv1.IdentityProvider) (*cmv1.IdentityProvider, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		IdentityProviders().IdentityProvider(idpID).
+		Update().Body(idp).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// DeleteIdentityProvider deletes the specified identity provider.
+func (c *IdentityProviderClient) DeleteIdentityProvider(clusterID string, idpID string) error {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		IdentityProviders().IdentityProvider(idpID).
+		Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+// ReconcileIdentityProviders creates, updates and deletes the identity providers of the cluster to match
+// spec.identityProviders. The identity providers applied are recorded in the IdentityProvidersLastAppliedAnnotation
+// annotation, with a hash of their configuration and of the versions of the referenced secrets, to detect changes
+// and to only delete the identity providers managed through the spec. The identity providers of the spec which
+// already exist but weren't applied from it are left untouched, and their names are returned.
+func ReconcileIdentityProviders(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, idpClient *IdentityProviderClient, clusterID string) (unmanaged []string, err error) {
+	lastApplied := map[string]string{}
+	if jsonAnnotation := rosaScope.ControlPlane.Annotations[IdentityProvidersLastAppliedAnnotation]; jsonAnnotation != "" {
+		if err := json.Unmarshal([]byte(jsonAnnotation), &lastApplied); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal '%s' annotation content: %w", IdentityProvidersLastAppliedAnnotation, err)
+		}
+	}
+
+	existingIDPs, err := idpClient.ListIdentityProviders(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identity providers: %w", err)
+	}
+	existing := make(map[string]*cmv1.IdentityProvider, len(existingIDPs))
+	for _, idp := range existingIDPs {
+		existing[idp.Name()] = idp
+	}
+
+	applied := map[string]string{}
+	var errs []error
+	for _, idpSpec := range rosaScope.ControlPlane.Spec.IdentityProviders {
+		lastAppliedHash, managed := lastApplied[idpSpec.Name]
+		if _, ok := existing[idpSpec.Name]; ok && !managed {
+			// The identity provider was created outside of the spec, e.g. with the rosa CLI.
+			unmanaged = append(unmanaged, idpSpec.Name)
+			continue
+		}
+		hash, err := reconcileIdentityProvider(ctx, rosaScope, idpClient, clusterID, idpSpec, existing[idpSpec.Name], lastAppliedHash)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile identity provider %s: %w", idpSpec.Name, err))
+			// Keep tracking the identity provider, so that it is deleted if removed from the spec.
+			if managed {
+				applied[idpSpec.Name] = lastAppliedHash
+			}
+			continue
+		}
+		applied[idpSpec.Name] = hash
+	}
+
+	// Delete the identity providers removed from the spec.
+	for name, hash := range lastApplied {
+		if specHasIdentityProvider(rosaScope.ControlPlane.Spec.IdentityProviders, name) {
+			continue
+		}
+		if current, ok := existing[name]; ok {
+			rosaScope.Info("Deleting identity provider", "name", name)
+			if err := idpClient.DeleteIdentityProvider(clusterID, current.ID()); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete identity provider %s: %w", name, err))
+				applied[name] = hash
+			}
+		}
+	}
+
+	if len(applied) == 0 {
+		delete(rosaScope.ControlPlane.Annotations, IdentityProvidersLastAppliedAnnotation)
+	} else {
+		jsonAnnotation, err := json.Marshal(applied)
+		if err != nil {
+			return unmanaged, fmt.Errorf("failed to marshal '%s' annotation content: %w", IdentityProvidersLastAppliedAnnotation, err)
+		}
+		if rosaScope.ControlPlane.Annotations == nil {
+			rosaScope.ControlPlane.Annotations = map[string]string{}
+		}
+		rosaScope.ControlPlane.Annotations[IdentityProvidersLastAppliedAnnotation] = string(jsonAnnotation)
+	}
+
+	return unmanaged, kerrors.NewAggregate(errs)
+}
+
+// reconcileIdentityProvider creates, updates or replaces an identity provider, and returns the hash of its applied
+// configuration.
+func reconcileIdentityProvider(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, idpClient *IdentityProviderClient, clusterID string,
+	idpSpec rosacontrolplanev1.IdentityProvider, current *cmv1.IdentityProvider, lastAppliedHash string) (string, error) {
+	idp, err := buildIdentityProvider(ctx, rosaScope, idpSpec, secretValue)
+	if err != nil {
+		return "", err
+	}
+	// The hash covers the versions of the referenced secrets rather than their values, which aren't stored.
+	hashedIDP, err := buildIdentityProvider(ctx, rosaScope, idpSpec, secretVersion)
+	if err != nil {
+		return "", err
+	}
+	hash, err := identityProviderHash(hashedIDP)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case current == nil:
+		rosaScope.Info("Creating identity provider", "name", idpSpec.Name)
+		_, err = idpClient.CreateIdentityProvider(clusterID, idp)
+	case current.Type() != idp.Type():
+		// The type of an identity provider can't be patched, the identity provider is replaced instead.
+		rosaScope.Info("Replacing identity provider", "name", idpSpec.Name)
+		if err = idpClient.DeleteIdentityProvider(clusterID, current.ID()); err == nil {
+			_, err = idpClient.CreateIdentityProvider(clusterID, idp)
+		}
+	case lastAppliedHash != hash:
+		rosaScope.Info("Updating identity provider", "name", idpSpec.Name)
+		_, err = idpClient.UpdateIdentityProvider(clusterID, current.ID(), idp)
+	}
+	if err != nil {
+		return "", err
+	}
+	return hash, nil
+}
+
+func specHasIdentityProvider(idps []rosacontrolplanev1.IdentityProvider, name string) bool {
+	for _, idp := range idps {
+		if idp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IdentityProviderReferences returns the names of the secrets and config maps referenced by the identity providers.
+func IdentityProviderReferences(idps []rosacontrolplanev1.IdentityProvider) (secrets, configMaps []string) {
+	addConfigMap := func(ref *rosacontrolplanev1.LocalObjectReference) {
+		if ref != nil {
+			configMaps = append(configMaps, ref.Name)
+		}
+	}
+	for _, idp := range idps {
+		if idp.GitHub != nil {
+			secrets = append(secrets, idp.GitHub.ClientSecret.Name)
+			addConfigMap(idp.GitHub.CertificateAuthority)
+		}
+		if idp.GitLab != nil {
+			secrets = append(secrets, idp.GitLab.ClientSecret.Name)
+			addConfigMap(idp.GitLab.CertificateAuthority)
+		}
+		if idp.Google != nil {
+			secrets = append(secrets, idp.Google.ClientSecret.Name)
+		}
+		if idp.LDAP != nil {
+			if idp.LDAP.BindPassword != nil {
+				secrets = append(secrets, idp.LDAP.BindPassword.Name)
+			}
+			addConfigMap(idp.LDAP.CertificateAuthority)
+		}
+		if idp.OpenID != nil {
+			secrets = append(secrets, idp.OpenID.ClientSecret.Name)
+			addConfigMap(idp.OpenID.CertificateAuthority)
+		}
+	}
+	return secrets, configMaps
+}
+
+// identityProviderHash returns a hash of the configuration of an identity provider, which must not hold the values of
+// its secrets.
+func identityProviderHash(idp *cmv1.IdentityProvider) (string, error) {
+	var buf bytes.Buffer
+	if err := cmv1.MarshalIdentityProvider(idp, &buf); err != nil {
+		return "", fmt.Errorf("failed to marshal identity provider %s: %w", idp.Name(), err)
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// secretResolver returns the value set in an identity provider for the given key of a secret.
+type secretResolver func(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ref rosacontrolplanev1.LocalObjectReference, key string) (string, error)
+
+// buildIdentityProvider returns the identity provider of the spec, with the values of its secrets returned by resolveSecret.
+func buildIdentityProvider(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, idpSpec rosacontrolplanev1.IdentityProvider, resolveSecret secretResolver) (*cmv1.IdentityProvider, error) {
+	mappingMethod := idpSpec.MappingMethod
+	if mappingMethod == "" {
+		mappingMethod = rosacontrolplanev1.IdentityProviderMappingMethodClaim
+	}
+	idpBuilder := cmv1.NewIdentityProvider().
+		Name(idpSpec.Name).
+		MappingMethod(cmv1.IdentityProviderMappingMethod(mappingMethod))
+
+	switch idpSpec.Type {
+	case rosacontrolplanev1.GitHubIdentityProviderType:
+		if idpSpec.GitHub == nil {
+			return nil, fmt.Errorf("github configuration is required")
+		}
+		clientSecret, err := resolveSecret(ctx, rosaScope, idpSpec.GitHub.ClientSecret, "clientSecret")
+		if err != nil {
+			return nil, err
+		}
+		ca, err := caBundle(ctx, rosaScope, idpSpec.GitHub.CertificateAuthority)
+		if err != nil {
+			return nil, err
+		}
+		idpBuilder.Type(cmv1.IdentityProviderTypeGithub).Github(cmv1.NewGithubIdentityProvider().
+			ClientID(idpSpec.GitHub.ClientID).
+			ClientSecret(clientSecret).
+			Hostname(idpSpec.GitHub.Hostname).
+			Organizations(idpSpec.GitHub.Organizations...).
+			Teams(idpSpec.GitHub.Teams...).
+			CA(ca))
+	case rosacontrolplanev1.GitLabIdentityProviderType:
+		if idpSpec.GitLab == nil {
+			return nil, fmt.Errorf("gitlab configuration is required")
+		}
+		clientSecret, err := resolveSecret(ctx, rosaScope, idpSpec.GitLab.ClientSecret, "clientSecret")
+		if err != nil {
+			return nil, err
+		}
+		ca, err := caBundle(ctx, rosaScope, idpSpec.GitLab.CertificateAuthority)
+		if err != nil {
+			return nil, err
+		}
+		idpBuilder.Type(cmv1.IdentityProviderTypeGitlab).Gitlab(cmv1.NewGitlabIdentityProvider().
+			URL(idpSpec.GitLab.URL).
+			ClientID(idpSpec.GitLab.ClientID).
+			ClientSecret(clientSecret).
+			CA(ca))
+	case rosacontrolplanev1.GoogleIdentityProviderType:
+		if idpSpec.Google == nil {
+			return nil, fmt.Errorf("google configuration is required")
+		}
+		clientSecret, err := resolveSecret(ctx, rosaScope, idpSpec.Google.ClientSecret, "clientSecret")
+		if err != nil {
+			return nil, err
+		}
+		idpBuilder.Type(cmv1.IdentityProviderTypeGoogle).Google(cmv1.NewGoogleIdentityProvider().
+			ClientID(idpSpec.Google.ClientID).
+			ClientSecret(clientSecret).
+			HostedDomain(idpSpec.Google.HostedDomain))
+	case rosacontrolplanev1.LDAPIdentityProviderType:
+		if idpSpec.LDAP == nil {
+			return nil, fmt.Errorf("ldap configuration is required")
+		}
+		ldapBuilder := cmv1.NewLDAPIdentityProvider().
+			URL(idpSpec.LDAP.URL).
+			BindDN(idpSpec.LDAP.BindDN).
+			Insecure(idpSpec.LDAP.Insecure).
+			Attributes(cmv1.NewLDAPAttributes().
+				ID(idpSpec.LDAP.Attributes.ID...).
+				Email(idpSpec.LDAP.Attributes.Email...).
+				Name(idpSpec.LDAP.Attributes.Name...).
+				PreferredUsername(idpSpec.LDAP.Attributes.PreferredUsername...))
+		if idpSpec.LDAP.BindPassword != nil {
+			bindPassword, err := resolveSecret(ctx, rosaScope, *idpSpec.LDAP.BindPassword, "bindPassword")
+			if err != nil {
+				return nil, err
+			}
+			ldapBuilder.BindPassword(bindPassword)
+		}
+		ca, err := caBundle(ctx, rosaScope, idpSpec.LDAP.CertificateAuthority)
+		if err != nil {
+			return nil, err
+		}
+		idpBuilder.Type(cmv1.IdentityProviderTypeLDAP).LDAP(ldapBuilder.CA(ca))
+	case rosacontrolplanev1.OpenIDIdentityProviderType:
+		if idpSpec.OpenID == nil {
+			return nil, fmt.Errorf("openID configuration is required")
+		}
+		clientSecret, err := resolveSecret(ctx, rosaScope, idpSpec.OpenID.ClientSecret, "clientSecret")
+		if err != nil {
+			return nil, err
+		}
+		ca, err := caBundle(ctx, rosaScope, idpSpec.OpenID.CertificateAuthority)
+		if err != nil {
+			return nil, err
+		}
+		openIDBuilder := cmv1.NewOpenIDIdentityProvider().
+			Issuer(idpSpec.OpenID.Issuer).
+			ClientID(idpSpec.OpenID.ClientID).
+			ClientSecret(clientSecret).
+			CA(ca).
+			Claims(cmv1.NewOpenIDClaims().
+				Email(idpSpec.OpenID.Claims.Email...).
+				Groups(idpSpec.OpenID.Claims.Groups...).
+				Name(idpSpec.OpenID.Claims.Name...).
+				PreferredUsername(idpSpec.OpenID.Claims.PreferredUsername...)).
+			ExtraScopes(idpSpec.OpenID.ExtraScopes...)
+		if len(idpSpec.OpenID.ExtraAuthorizeParameters) > 0 {
+			openIDBuilder.ExtraAuthorizeParameters(idpSpec.OpenID.ExtraAuthorizeParameters)
+		}
+		idpBuilder.Type(cmv1.IdentityProviderTypeOpenID).OpenID(openIDBuilder)
+	default:
+		return nil, fmt.Errorf("unsupported identity provider type %q", idpSpec.Type)
+	}
+
+	return idpBuilder.Build()
+}
+
+// secretValue returns the value of the given key of a secret in the namespace of the control plane.
+func secretValue(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ref rosacontrolplanev1.LocalObjectReference, key string) (string, error) {
+	secret, err := getSecretWithKey(ctx, rosaScope, ref, key)
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+// secretVersion returns the UID and resource version of a secret in the namespace of the control plane holding the
+// given key, which change with its value.
+func secretVersion(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ref rosacontrolplanev1.LocalObjectReference, key string) (string, error) {
+	secret, err := getSecretWithKey(ctx, rosaScope, ref, key)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s", secret.UID, secret.ResourceVersion), nil
+}
+
+func getSecretWithKey(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ref rosacontrolplanev1.LocalObjectReference, key string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := rosaScope.Client.Get(ctx, types.NamespacedName{Namespace: rosaScope.Namespace(), Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+	}
+	if _, ok := secret.Data[key]; !ok {
+		return nil, fmt.Errorf("secret %s has no %s key", ref.Name, key)
+	}
+	return secret, nil
+}
+
+// caBundle returns the CA bundle held by the "ca-bundle.crt" key of a config map in the namespace of the control plane.
+func caBundle(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope, ref *rosacontrolplanev1.LocalObjectReference) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := rosaScope.Client.Get(ctx, types.NamespacedName{Namespace: rosaScope.Namespace(), Name: ref.Name}, configMap); err != nil {
+		return "", fmt.Errorf("failed to get CA config map %s: %w", ref.Name, err)
+	}
+	return configMap.Data["ca-bundle.crt"], nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileIdentityProviders(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	server, err := ocmfake.NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	ocmClient, err := server.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "test-cluster",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator:     &rosaaws.Creator{ARN: "arn:aws:iam::123456789012:user/test", AccountID: "123456789012"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+
+	// An identity provider which isn't managed through the spec.
+	g.Expect(rosa.CreateAdminUserIfNotExist(ocmClient, cluster.ID(), "admin", "password")).To(Succeed())
+
+	clientSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-secret", Namespace: "default"},
+		Data:       map[string][]byte{"clientSecret": []byte("secret")},
+	}
+	kubeClient := fake.NewClientBuilder().WithObjects(server.CredentialsSecret("rosa-creds-secret", "default"), clientSecret).Build()
+	rosaScope := &scope.ROSAControlPlaneScope{
+		Client:  kubeClient,
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+		ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+				CredentialsSecretRef: &corev1.LocalObjectReference{Name: "rosa-creds-secret"},
+				IdentityProviders: []rosacontrolplanev1.IdentityProvider{
+					{
+						Name: "github",
+						Type: rosacontrolplanev1.GitHubIdentityProviderType,
+						GitHub: &rosacontrolplanev1.GitHubIdentityProvider{
+							ClientID:      "github-client",
+							ClientSecret:  rosacontrolplanev1.LocalObjectReference{Name: "client-secret"},
+							Organizations: []string{"example"},
+						},
+					},
+					{
+						Name:          "openid",
+						Type:          rosacontrolplanev1.OpenIDIdentityProviderType,
+						MappingMethod: rosacontrolplanev1.IdentityProviderMappingMethodAdd,
+						OpenID: &rosacontrolplanev1.OpenIDIdentityProvider{
+							Issuer:       "https://issuer.example.com",
+							ClientID:     "openid-client",
+							ClientSecret: rosacontrolplanev1.LocalObjectReference{Name: "client-secret"},
+							Claims: rosacontrolplanev1.OpenIDClaims{
+								PreferredUsername: []string{"preferred_username"},
+								Groups:            []string{"groups"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Logger: *logger.NewLogger(klog.Background()),
+	}
+
+	idpClient, err := rosa.NewIdentityProviderClient(ctx, rosaScope)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer idpClient.Close()
+
+	reconcileIDPs := func() error {
+		unmanaged, err := rosa.ReconcileIdentityProviders(ctx, rosaScope, idpClient, cluster.ID())
+		g.Expect(unmanaged).To(BeEmpty())
+		return err
+	}
+	listIDPs := func() map[string]*cmv1.IdentityProvider {
+		idps, err := idpClient.ListIdentityProviders(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		byName := map[string]*cmv1.IdentityProvider{}
+		for _, idp := range idps {
+			byName[idp.Name()] = idp
+		}
+		return byName
+	}
+
+	t.Run("creates the identity providers of the spec", func(t *testing.T) {
+		g.Expect(reconcileIDPs()).To(Succeed())
+
+		idps := listIDPs()
+		g.Expect(idps).To(HaveKey(rosa.ClusterAdminIdentityProviderName))
+		g.Expect(idps).To(HaveKey("github"))
+		g.Expect(idps["github"].Type()).To(Equal(cmv1.IdentityProviderTypeGithub))
+		g.Expect(idps["github"].Github().Organizations()).To(Equal([]string{"example"}))
+		g.Expect(idps).To(HaveKey("openid"))
+		g.Expect(idps["openid"].MappingMethod()).To(Equal(cmv1.IdentityProviderMappingMethodAdd))
+		g.Expect(idps["openid"].OpenID().Claims().Groups()).To(Equal([]string{"groups"}))
+		g.Expect(rosaScope.ControlPlane.Annotations).To(HaveKey(rosa.IdentityProvidersLastAppliedAnnotation))
+	})
+
+	t.Run("updates changed identity providers", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.IdentityProviders[0].GitHub.Organizations = []string{"example", "other"}
+		g.Expect(reconcileIDPs()).To(Succeed())
+
+		idps := listIDPs()
+		g.Expect(idps["github"].Github().Organizations()).To(Equal([]string{"example", "other"}))
+	})
+
+	t.Run("updates identity providers when their secrets change", func(t *testing.T) {
+		lastApplied := rosaScope.ControlPlane.Annotations[rosa.IdentityProvidersLastAppliedAnnotation]
+		clientSecret.Data["clientSecret"] = []byte("rotated")
+		g.Expect(kubeClient.Update(ctx, clientSecret)).To(Succeed())
+
+		g.Expect(reconcileIDPs()).To(Succeed())
+		g.Expect(rosaScope.ControlPlane.Annotations[rosa.IdentityProvidersLastAppliedAnnotation]).ToNot(Equal(lastApplied))
+	})
+
+	t.Run("replaces identity providers when their type changes", func(t *testing.T) {
+		previousID := listIDPs()["openid"].ID()
+		rosaScope.ControlPlane.Spec.IdentityProviders[1] = rosacontrolplanev1.IdentityProvider{
+			Name: "openid",
+			Type: rosacontrolplanev1.GitLabIdentityProviderType,
+			GitLab: &rosacontrolplanev1.GitLabIdentityProvider{
+				URL:          "https://gitlab.example.com",
+				ClientID:     "gitlab-client",
+				ClientSecret: rosacontrolplanev1.LocalObjectReference{Name: "client-secret"},
+			},
+		}
+		g.Expect(reconcileIDPs()).To(Succeed())
+
+		idps := listIDPs()
+		g.Expect(idps["openid"].ID()).ToNot(Equal(previousID))
+		g.Expect(idps["openid"].Type()).To(Equal(cmv1.IdentityProviderTypeGitlab))
+		g.Expect(idps["openid"].Gitlab().URL()).To(Equal("https://gitlab.example.com"))
+	})
+
+	t.Run("leaves the identity providers not created from the spec untouched", func(t *testing.T) {
+		legacy, err := cmv1.NewIdentityProvider().
+			Name("legacy").
+			Type(cmv1.IdentityProviderTypeGoogle).
+			MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+			Google(cmv1.NewGoogleIdentityProvider().ClientID("legacy-client").ClientSecret("legacy")).
+			Build()
+		g.Expect(err).ToNot(HaveOccurred())
+		legacy, err = idpClient.CreateIdentityProvider(cluster.ID(), legacy)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		rosaScope.ControlPlane.Spec.IdentityProviders = append(rosaScope.ControlPlane.Spec.IdentityProviders, rosacontrolplanev1.IdentityProvider{
+			Name: "legacy",
+			Type: rosacontrolplanev1.GoogleIdentityProviderType,
+			Google: &rosacontrolplanev1.GoogleIdentityProvider{
+				ClientID:     "google-client",
+				ClientSecret: rosacontrolplanev1.LocalObjectReference{Name: "client-secret"},
+			},
+		})
+		unmanaged, err := rosa.ReconcileIdentityProviders(ctx, rosaScope, idpClient, cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(unmanaged).To(Equal([]string{"legacy"}))
+
+		current := listIDPs()["legacy"]
+		g.Expect(current.ID()).To(Equal(legacy.ID()))
+		g.Expect(current.Google().ClientID()).To(Equal("legacy-client"))
+		g.Expect(rosaScope.ControlPlane.Annotations[rosa.IdentityProvidersLastAppliedAnnotation]).ToNot(ContainSubstring("legacy"))
+
+		// The identity provider isn't deleted once removed from the spec.
+		rosaScope.ControlPlane.Spec.IdentityProviders = rosaScope.ControlPlane.Spec.IdentityProviders[:2]
+		g.Expect(reconcileIDPs()).To(Succeed())
+		g.Expect(listIDPs()).To(HaveKey("legacy"))
+	})
+
+	t.Run("reports missing secrets", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.IdentityProviders[0].GitHub.ClientSecret.Name = "missing"
+		g.Expect(reconcileIDPs()).To(MatchError(ContainSubstring("failed to get secret missing")))
+		rosaScope.ControlPlane.Spec.IdentityProviders[0].GitHub.ClientSecret.Name = "client-secret"
+	})
+
+	t.Run("deletes the identity providers removed from the spec", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.IdentityProviders = rosaScope.ControlPlane.Spec.IdentityProviders[:1]
+		g.Expect(reconcileIDPs()).To(Succeed())
+		g.Expect(listIDPs()).To(SatisfyAll(HaveKey("github"), Not(HaveKey("openid")), HaveKey(rosa.ClusterAdminIdentityProviderName)))
+
+		rosaScope.ControlPlane.Spec.IdentityProviders = nil
+		g.Expect(reconcileIDPs()).To(Succeed())
+		g.Expect(listIDPs()).To(SatisfyAll(Not(HaveKey("github")), HaveKey(rosa.ClusterAdminIdentityProviderName), HaveKey("legacy")))
+		g.Expect(rosaScope.ControlPlane.Annotations).ToNot(HaveKey(rosa.IdentityProvidersLastAppliedAnnotation))
+	})
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift/rosa/pkg/ocm"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+var (
+	ingressListeningMethods = map[rosacontrolplanev1.IngressListeningMethod]cmv1.ListeningMethod{
+		rosacontrolplanev1.IngressListeningExternal: cmv1.ListeningMethodExternal,
+		rosacontrolplanev1.IngressListeningInternal: cmv1.ListeningMethodInternal,
+	}
+
+	ingressLoadBalancerTypes = map[rosacontrolplanev1.IngressLoadBalancerType]cmv1.LoadBalancerFlavor{
+		rosacontrolplanev1.IngressLoadBalancerClassic: cmv1.LoadBalancerFlavorClassic,
+		rosacontrolplanev1.IngressLoadBalancerNLB:     cmv1.LoadBalancerFlavorNlb,
+	}
+)
+
+// IngressClient handles the ingress controllers of a cluster.
+type IngressClient struct {
+	ocm *sdk.Connection
+}
+
+// NewIngressClient creates and returns a new client to handle the ingress controllers.
+func NewIngressClient(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope) (*IngressClient, error) {
+	ocmConnection, err := newOCMRawConnection(ctx, rosaScope)
+	if err != nil {
+		return nil, err
+	}
+	return &IngressClient{
+		ocm: ocmConnection,
+	}, nil
+}
+
+// Close closes the underlying ocm connection, unless it is shared by the reconciles using the same OCM account.
+func (c *IngressClient) Close() error {
+	return closeConnection(c.ocm)
+}
+
+// ListIngresses returns the ingress controllers of the cluster.
+func (c *IngressClient) ListIngresses(clusterID string) ([]*cmv1.Ingress, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		Ingresses().
+		List().Page(1).Size(-1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
+
+// GetDefaultIngress returns the default ingress controller of the cluster, or nil if it doesn't exist.
+func (c *IngressClient) GetDefaultIngress(clusterID string) (*cmv1.Ingress, error) {
+	ingresses, err := c.ListIngresses(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ingress := range ingresses {
+		if ingress.Default() {
+			return ingress, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateIngress updates the fields of the ingress controller set in ingress.
+func (c *IngressClient) UpdateIngress(clusterID string, ingressID string, ingress *cmv1.Ingress) (*cmv1.Ingress, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		Ingresses().Ingress(ingressID).
+		Update().Body(ingress).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// BuildDefaultIngressSpec returns the default ingress settings of spec.ingress applied when creating the cluster,
+// and whether its routes are private. The load balancer type can't be set at creation and is applied afterwards
+// by ReconcileDefaultIngress.
+func BuildDefaultIngressSpec(spec *rosacontrolplanev1.DefaultIngress) (ocm.DefaultIngressSpec, *bool) {
+	defaultIngressSpec := ocm.NewDefaultIngressSpec()
+	if spec == nil {
+		return defaultIngressSpec, nil
+	}
+
+	maps.Copy(defaultIngressSpec.RouteSelectors, spec.RouteSelectors)
+	defaultIngressSpec.ExcludedNamespaces = append(defaultIngressSpec.ExcludedNamespaces, spec.ExcludedNamespaces...)
+	defaultIngressSpec.NamespaceOwnershipPolicy = string(spec.RouteNamespaceOwnershipPolicy)
+	defaultIngressSpec.WildcardPolicy = string(spec.RouteWildcardPolicy)
+
+	var privateIngress *bool
+	if spec.Listening != "" {
+		private := spec.Listening == rosacontrolplanev1.IngressListeningInternal
+		privateIngress = &private
+	}
+	return defaultIngressSpec, privateIngress
+}
+
+// ReconcileDefaultIngress updates the default ingress controller to match spec.ingress, reverting the changes
+// made to the fields set in the spec outside of it.
+func ReconcileDefaultIngress(rosaScope *scope.ROSAControlPlaneScope, ingressClient *IngressClient, clusterID string) error {
+	spec := rosaScope.ControlPlane.Spec.Ingress
+	if spec == nil {
+		return nil
+	}
+
+	current, err := ingressClient.GetDefaultIngress(clusterID)
+	if err != nil {
+		return fmt.Errorf("failed to get default ingress: %w", err)
+	}
+	if current == nil {
+		return fmt.Errorf("cluster %s has no default ingress", clusterID)
+	}
+
+	drift := defaultIngressDrift(spec, current)
+	if len(drift) == 0 {
+		return nil
+	}
+
+	ingress, err := buildDefaultIngress(spec)
+	if err != nil {
+		return fmt.Errorf("failed to build default ingress: %w", err)
+	}
+	rosaScope.Info("Updating default ingress", "fields", drift)
+	if _, err := ingressClient.UpdateIngress(clusterID, current.ID(), ingress); err != nil {
+		return fmt.Errorf("failed to update default ingress: %w", err)
+	}
+	return nil
+}
+
+// buildDefaultIngress builds an ingress with only the fields set in the spec, so that the other fields
+// keep their current values.
+func buildDefaultIngress(spec *rosacontrolplanev1.DefaultIngress) (*cmv1.Ingress, error) {
+	builder := cmv1.NewIngress()
+	if spec.Listening != "" {
+		builder.Listening(ingressListeningMethods[spec.Listening])
+	}
+	if len(spec.RouteSelectors) > 0 {
+		builder.RouteSelectors(spec.RouteSelectors)
+	}
+	if len(spec.ExcludedNamespaces) > 0 {
+		builder.ExcludedNamespaces(spec.ExcludedNamespaces...)
+	}
+	if spec.RouteNamespaceOwnershipPolicy != "" {
+		builder.RouteNamespaceOwnershipPolicy(cmv1.NamespaceOwnershipPolicy(spec.RouteNamespaceOwnershipPolicy))
+	}
+	if spec.RouteWildcardPolicy != "" {
+		builder.RouteWildcardPolicy(cmv1.WildcardPolicy(spec.RouteWildcardPolicy))
+	}
+	if spec.LoadBalancerType != "" {
+		builder.LoadBalancerType(ingressLoadBalancerTypes[spec.LoadBalancerType])
+	}
+	return builder.Build()
+}
+
+// defaultIngressDrift returns the fields set in the spec whose value differs in the default ingress controller.
+func defaultIngressDrift(spec *rosacontrolplanev1.DefaultIngress, current *cmv1.Ingress) []string {
+	var drift []string
+	diff := func(field string, differs bool) {
+		if differs {
+			drift = append(drift, field)
+		}
+	}
+
+	diff("listening", spec.Listening != "" && ingressListeningMethods[spec.Listening] != current.Listening())
+	diff("routeSelectors", len(spec.RouteSelectors) > 0 && !maps.Equal(spec.RouteSelectors, current.RouteSelectors()))
+	diff("excludedNamespaces", len(spec.ExcludedNamespaces) > 0 &&
+		!sets.New(spec.ExcludedNamespaces...).Equal(sets.New(current.ExcludedNamespaces()...)))
+	diff("routeNamespaceOwnershipPolicy", spec.RouteNamespaceOwnershipPolicy != "" &&
+		cmv1.NamespaceOwnershipPolicy(spec.RouteNamespaceOwnershipPolicy) != current.RouteNamespaceOwnershipPolicy())
+	diff("routeWildcardPolicy", spec.RouteWildcardPolicy != "" &&
+		cmv1.WildcardPolicy(spec.RouteWildcardPolicy) != current.RouteWildcardPolicy())
+	diff("loadBalancerType", spec.LoadBalancerType != "" &&
+		ingressLoadBalancerTypes[spec.LoadBalancerType] != current.LoadBalancerType())
+
+	return drift
+}
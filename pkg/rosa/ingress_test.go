@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileDefaultIngress(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	server, err := ocmfake.NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	ingressSpec := &rosacontrolplanev1.DefaultIngress{
+		Listening:                     rosacontrolplanev1.IngressListeningInternal,
+		RouteSelectors:                map[string]string{"router": "default"},
+		ExcludedNamespaces:            []string{"kube-system"},
+		RouteNamespaceOwnershipPolicy: rosacontrolplanev1.NamespaceOwnershipPolicyInterNamespaceAllowed,
+		LoadBalancerType:              rosacontrolplanev1.IngressLoadBalancerClassic,
+	}
+	defaultIngressSpec, privateIngress := rosa.BuildDefaultIngressSpec(ingressSpec)
+
+	ocmClient, err := server.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "test-cluster",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator:     &rosaaws.Creator{ARN: "arn:aws:iam::123456789012:user/test", AccountID: "123456789012"},
+		DefaultIngress: defaultIngressSpec,
+		PrivateIngress: privateIngress,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+
+	kubeClient := fake.NewClientBuilder().WithObjects(server.CredentialsSecret("rosa-creds-secret", "default")).Build()
+	rosaScope := &scope.ROSAControlPlaneScope{
+		Client:  kubeClient,
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+		ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+				CredentialsSecretRef: &corev1.LocalObjectReference{Name: "rosa-creds-secret"},
+				Ingress:              ingressSpec,
+			},
+		},
+		Logger: *logger.NewLogger(klog.Background()),
+	}
+
+	ingressClient, err := rosa.NewIngressClient(ctx, rosaScope)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer ingressClient.Close()
+
+	getDefaultIngress := func() *cmv1.Ingress {
+		ingress, err := ingressClient.GetDefaultIngress(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ingress).ToNot(BeNil())
+		return ingress
+	}
+
+	t.Run("creates the cluster with the default ingress of the spec", func(t *testing.T) {
+		ingress := getDefaultIngress()
+		g.Expect(ingress.Listening()).To(Equal(cmv1.ListeningMethodInternal))
+		g.Expect(ingress.RouteSelectors()).To(Equal(map[string]string{"router": "default"}))
+		g.Expect(ingress.ExcludedNamespaces()).To(ConsistOf("kube-system"))
+		g.Expect(ingress.RouteNamespaceOwnershipPolicy()).To(Equal(cmv1.NamespaceOwnershipPolicyInterNamespaceAllowed))
+		// The load balancer type can only be set once the cluster is created.
+		g.Expect(ingress.LoadBalancerType()).To(Equal(cmv1.LoadBalancerFlavorNlb))
+	})
+
+	t.Run("applies the settings which can't be set at creation", func(t *testing.T) {
+		g.Expect(rosa.ReconcileDefaultIngress(rosaScope, ingressClient, cluster.ID())).To(Succeed())
+		g.Expect(getDefaultIngress().LoadBalancerType()).To(Equal(cmv1.LoadBalancerFlavorClassic))
+	})
+
+	t.Run("reverts changes made outside of the spec", func(t *testing.T) {
+		ingress := getDefaultIngress()
+		drifted, err := cmv1.NewIngress().
+			Listening(cmv1.ListeningMethodExternal).
+			RouteSelectors(map[string]string{"router": "sharded"}).
+			RouteWildcardPolicy(cmv1.WildcardPolicyWildcardsAllowed).
+			Build()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = ingressClient.UpdateIngress(cluster.ID(), ingress.ID(), drifted)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(rosa.ReconcileDefaultIngress(rosaScope, ingressClient, cluster.ID())).To(Succeed())
+
+		ingress = getDefaultIngress()
+		g.Expect(ingress.Listening()).To(Equal(cmv1.ListeningMethodInternal))
+		g.Expect(ingress.RouteSelectors()).To(Equal(map[string]string{"router": "default"}))
+		// Fields not set in the spec are left as they are.
+		g.Expect(ingress.RouteWildcardPolicy()).To(Equal(cmv1.WildcardPolicyWildcardsAllowed))
+	})
+
+	t.Run("updates the default ingress when the spec changes", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.Ingress.Listening = rosacontrolplanev1.IngressListeningExternal
+		rosaScope.ControlPlane.Spec.Ingress.ExcludedNamespaces = []string{"kube-system", "openshift-monitoring"}
+		g.Expect(rosa.ReconcileDefaultIngress(rosaScope, ingressClient, cluster.ID())).To(Succeed())
+
+		ingress := getDefaultIngress()
+		g.Expect(ingress.Listening()).To(Equal(cmv1.ListeningMethodExternal))
+		g.Expect(ingress.ExcludedNamespaces()).To(ConsistOf("kube-system", "openshift-monitoring"))
+	})
+
+	t.Run("keeps the default ingress when removed from the spec", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.Ingress = nil
+		g.Expect(rosa.ReconcileDefaultIngress(rosaScope, ingressClient, cluster.ID())).To(Succeed())
+		g.Expect(getDefaultIngress().Listening()).To(Equal(cmv1.ListeningMethodExternal))
+	})
+}
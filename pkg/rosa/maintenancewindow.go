@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+)
+
+const (
+	// minMaintenanceWindowDuration is the shortest maintenance window accepted, leaving enough time to schedule an
+	// upgrade once the window has opened.
+	minMaintenanceWindowDuration = 30 * time.Minute
+
+	// maintenanceWindowSearchLimit bounds how far ahead the next maintenance window is searched for.
+	maintenanceWindowSearchLimit = 2 * 366 * 24 * time.Hour
+)
+
+// blackoutPeriod is a blackout period of a maintenance window, from the start of its first day to the end of its last
+// day in the time zone of the window.
+type blackoutPeriod struct {
+	start time.Time
+	end   time.Time
+}
+
+// NextMaintenanceWindow returns when an upgrade held until the given maintenance window can be scheduled: now when a
+// window is open long enough to schedule the upgrade, or the start of the next window otherwise. Windows opening on
+// a blackout date are skipped.
+func NextMaintenanceWindow(window *rosacontrolplanev1.MaintenanceWindow, now time.Time) (time.Time, error) {
+	schedule, location, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return time.Time{}, err
+	}
+	blackouts, err := parseBlackoutDates(window.BlackoutDates, location)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	earliestRun := now.Add(upgradeLeadTime)
+	limit := now.Add(maintenanceWindowSearchLimit)
+	// Start with the earliest window which can still be open.
+	from := now.Add(-window.Duration.Duration).In(location)
+	for {
+		start := schedule.Next(from)
+		if start.IsZero() || start.After(limit) {
+			return time.Time{}, fmt.Errorf("no maintenance window opens before %s", limit.Format(time.RFC3339))
+		}
+		from = start
+
+		if !earliestRun.Before(start.Add(window.Duration.Duration)) {
+			// The window closes before an upgrade can be scheduled in it.
+			continue
+		}
+		if blackout := blackoutAt(blackouts, maxTime(start, earliestRun)); blackout != nil {
+			// Skip the windows opening until the end of the blackout period.
+			from = blackout.end.Add(-time.Nanosecond)
+			continue
+		}
+		return maxTime(start, now), nil
+	}
+}
+
+// UntilMaintenanceWindow returns how long to wait before an upgrade held until a maintenance window can be scheduled,
+// or zero when no upgrade is held.
+func UntilMaintenanceWindow(pendingUpgrade *rosacontrolplanev1.PendingUpgrade) time.Duration {
+	if pendingUpgrade == nil || !pendingUpgrade.WaitingForMaintenanceWindow {
+		return 0
+	}
+	return max(time.Until(pendingUpgrade.ScheduledAt.Time), time.Second)
+}
+
+// ValidateMaintenanceWindow validates the schedule, duration, time zone and blackout dates of a maintenance window.
+func ValidateMaintenanceWindow(window *rosacontrolplanev1.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if window == nil {
+		return allErrs
+	}
+
+	if _, err := parseSchedule(window.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), window.Schedule, err.Error()))
+	}
+
+	if window.Duration.Duration < minMaintenanceWindowDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), window.Duration.String(),
+			fmt.Sprintf("must be at least %s", minMaintenanceWindowDuration)))
+	}
+
+	location, err := time.LoadLocation(window.TimeZone)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), window.TimeZone, "must be a valid IANA time zone name"))
+		location = time.UTC
+	}
+
+	for i, blackout := range window.BlackoutDates {
+		if _, err := parseBlackoutPeriod(blackout, location); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("blackoutDates").Index(i), blackout, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func parseMaintenanceWindow(window *rosacontrolplanev1.MaintenanceWindow) (*cron.SpecSchedule, *time.Location, error) {
+	schedule, err := parseSchedule(window.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid maintenance window schedule %q: %w", window.Schedule, err)
+	}
+	location, err := time.LoadLocation(window.TimeZone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid maintenance window time zone %q: %w", window.TimeZone, err)
+	}
+	return schedule, location, nil
+}
+
+// parseSchedule parses a standard cron expression. Time zone prefixes and descriptors not anchored to the clock, such
+// as "@every 1h", are rejected.
+func parseSchedule(expression string) (*cron.SpecSchedule, error) {
+	if strings.HasPrefix(expression, "TZ=") || strings.HasPrefix(expression, "CRON_TZ=") {
+		return nil, fmt.Errorf("time zone must be set with timeZone")
+	}
+
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, err
+	}
+	specSchedule, ok := schedule.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("must open at fixed times")
+	}
+	return specSchedule, nil
+}
+
+func parseBlackoutDates(dates []rosacontrolplanev1.BlackoutPeriod, location *time.Location) ([]blackoutPeriod, error) {
+	blackouts := make([]blackoutPeriod, 0, len(dates))
+	for _, date := range dates {
+		blackout, err := parseBlackoutPeriod(date, location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blackout period %s: %w", date.Start, err)
+		}
+		blackouts = append(blackouts, *blackout)
+	}
+	return blackouts, nil
+}
+
+func parseBlackoutPeriod(period rosacontrolplanev1.BlackoutPeriod, location *time.Location) (*blackoutPeriod, error) {
+	start, err := time.ParseInLocation(time.DateOnly, period.Start, location)
+	if err != nil {
+		return nil, fmt.Errorf("start must be a date in the YYYY-MM-DD format")
+	}
+
+	end := start
+	if period.End != "" {
+		end, err = time.ParseInLocation(time.DateOnly, period.End, location)
+		if err != nil {
+			return nil, fmt.Errorf("end must be a date in the YYYY-MM-DD format")
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("end must not be before start")
+		}
+	}
+
+	return &blackoutPeriod{start: start, end: end.AddDate(0, 0, 1)}, nil
+}
+
+// blackoutAt returns the blackout period including the given time, if any.
+func blackoutAt(blackouts []blackoutPeriod, t time.Time) *blackoutPeriod {
+	for i := range blackouts {
+		if !t.Before(blackouts[i].start) && t.Before(blackouts[i].end) {
+			return &blackouts[i]
+		}
+	}
+	return nil
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+)
+
+func TestNextMaintenanceWindow(t *testing.T) {
+	// Every Saturday from 02:00 to 06:00.
+	saturdays := rosacontrolplanev1.MaintenanceWindow{
+		Schedule: "0 2 * * 6",
+		Duration: metav1.Duration{Duration: 4 * time.Hour},
+	}
+
+	tests := []struct {
+		name     string
+		window   func(rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow
+		now      string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "returns the start of the next window",
+			now:      "2026-10-14T10:00:00Z",
+			expected: "2026-10-17T02:00:00Z",
+		},
+		{
+			name:     "returns now while a window is open",
+			now:      "2026-10-17T03:30:00Z",
+			expected: "2026-10-17T03:30:00Z",
+		},
+		{
+			name:     "skips a window closing before an upgrade can be scheduled",
+			now:      "2026-10-17T05:58:00Z",
+			expected: "2026-10-24T02:00:00Z",
+		},
+		{
+			name:     "returns the start of a window opening after now",
+			now:      "2026-10-17T01:59:00Z",
+			expected: "2026-10-17T02:00:00Z",
+		},
+		{
+			name: "evaluates the schedule in the time zone of the window",
+			window: func(w rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow {
+				w.TimeZone = "Europe/Berlin"
+				return w
+			},
+			now:      "2026-10-14T10:00:00Z",
+			expected: "2026-10-17T00:00:00Z",
+		},
+		{
+			name: "skips windows opening on a blackout date",
+			window: func(w rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow {
+				w.BlackoutDates = []rosacontrolplanev1.BlackoutPeriod{{Start: "2026-10-17"}}
+				return w
+			},
+			now:      "2026-10-14T10:00:00Z",
+			expected: "2026-10-24T02:00:00Z",
+		},
+		{
+			name: "skips windows opening during a blackout period",
+			window: func(w rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow {
+				w.BlackoutDates = []rosacontrolplanev1.BlackoutPeriod{{Start: "2026-10-15", End: "2026-10-25"}}
+				return w
+			},
+			now:      "2026-10-14T10:00:00Z",
+			expected: "2026-10-31T02:00:00Z",
+		},
+		{
+			name: "skips the open window when a blackout period starts",
+			window: func(w rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow {
+				w.BlackoutDates = []rosacontrolplanev1.BlackoutPeriod{{Start: "2026-10-17"}}
+				return w
+			},
+			now:      "2026-10-17T03:30:00Z",
+			expected: "2026-10-24T02:00:00Z",
+		},
+		{
+			name: "fails when every window is blacked out",
+			window: func(w rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow {
+				w.BlackoutDates = []rosacontrolplanev1.BlackoutPeriod{{Start: "2026-01-01", End: "2030-12-31"}}
+				return w
+			},
+			now:     "2026-10-14T10:00:00Z",
+			wantErr: true,
+		},
+		{
+			name: "fails with an invalid schedule",
+			window: func(w rosacontrolplanev1.MaintenanceWindow) rosacontrolplanev1.MaintenanceWindow {
+				w.Schedule = "every saturday"
+				return w
+			},
+			now:     "2026-10-14T10:00:00Z",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			window := saturdays
+			if tt.window != nil {
+				window = tt.window(saturdays)
+			}
+			now, err := time.Parse(time.RFC3339, tt.now)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			next, err := rosa.NextMaintenanceWindow(&window, now)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(next.UTC().Format(time.RFC3339)).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestUntilMaintenanceWindow(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(rosa.UntilMaintenanceWindow(nil)).To(BeZero())
+	g.Expect(rosa.UntilMaintenanceWindow(&rosacontrolplanev1.PendingUpgrade{
+		Version:     "4.18.1",
+		ScheduledAt: metav1.NewTime(time.Now().Add(time.Hour)),
+	})).To(BeZero())
+
+	g.Expect(rosa.UntilMaintenanceWindow(&rosacontrolplanev1.PendingUpgrade{
+		Version:                     "4.18.1",
+		ScheduledAt:                 metav1.NewTime(time.Now().Add(time.Hour)),
+		WaitingForMaintenanceWindow: true,
+	})).To(BeNumerically("~", time.Hour, time.Minute))
+	g.Expect(rosa.UntilMaintenanceWindow(&rosacontrolplanev1.PendingUpgrade{
+		Version:                     "4.18.1",
+		ScheduledAt:                 metav1.NewTime(time.Now().Add(-time.Hour)),
+		WaitingForMaintenanceWindow: true,
+	})).To(Equal(time.Second))
+}
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name           string
+		window         *rosacontrolplanev1.MaintenanceWindow
+		expectedFields []string
+	}{
+		{
+			name: "no window",
+		},
+		{
+			name: "valid window",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "0 22 * * 1-5",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+				TimeZone: "America/New_York",
+				BlackoutDates: []rosacontrolplanev1.BlackoutPeriod{
+					{Start: "2026-12-24", End: "2027-01-02"},
+					{Start: "2027-03-31"},
+				},
+			},
+		},
+		{
+			name: "invalid schedule",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "0 25 * * *",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+			},
+			expectedFields: []string{"spec.maintenanceWindow.schedule"},
+		},
+		{
+			name: "schedule with a time zone",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "CRON_TZ=Europe/Berlin 0 2 * * 6",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+			},
+			expectedFields: []string{"spec.maintenanceWindow.schedule"},
+		},
+		{
+			name: "schedule not opening at fixed times",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "@every 24h",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+			},
+			expectedFields: []string{"spec.maintenanceWindow.schedule"},
+		},
+		{
+			name: "too short duration",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "0 2 * * 6",
+				Duration: metav1.Duration{Duration: 10 * time.Minute},
+			},
+			expectedFields: []string{"spec.maintenanceWindow.duration"},
+		},
+		{
+			name: "unknown time zone",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "0 2 * * 6",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			expectedFields: []string{"spec.maintenanceWindow.timeZone"},
+		},
+		{
+			name: "invalid blackout dates",
+			window: &rosacontrolplanev1.MaintenanceWindow{
+				Schedule: "0 2 * * 6",
+				Duration: metav1.Duration{Duration: 2 * time.Hour},
+				BlackoutDates: []rosacontrolplanev1.BlackoutPeriod{
+					{Start: "2026-02-30"},
+					{Start: "2026-12-31", End: "2026-12-24"},
+				},
+			},
+			expectedFields: []string{"spec.maintenanceWindow.blackoutDates[0]", "spec.maintenanceWindow.blackoutDates[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			errs := rosa.ValidateMaintenanceWindow(tt.window, field.NewPath("spec", "maintenanceWindow"))
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tt.expectedFields))
+		})
+	}
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+)
+
+const (
+	// KubeletConfigsLastAppliedAnnotation annotation tracks the names of the kubelet configs applied from
+	// spec.kubeletConfigs, to only delete the kubelet configs managed through the spec.
+	KubeletConfigsLastAppliedAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-last-applied-kubelet-configs"
+
+	// TuningConfigsLastAppliedAnnotation annotation tracks the names of the tuning configs applied from
+	// spec.tuningConfigs, to only delete the tuning configs managed through the spec.
+	TuningConfigsLastAppliedAnnotation = "controlplane.cluster.x-k8s.io/rosacontrolplane-last-applied-tuning-configs"
+)
+
+// NodeConfigClient handles the kubelet configs and tuning configs of a cluster.
+type NodeConfigClient struct {
+	ocm *sdk.Connection
+}
+
+// NewNodeConfigClient creates and returns a new client to handle kubelet configs and tuning configs.
+func NewNodeConfigClient(ctx context.Context, rosaScope *scope.ROSAControlPlaneScope) (*NodeConfigClient, error) {
+	ocmConnection, err := newOCMRawConnection(ctx, rosaScope)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeConfigClient{
+		ocm: ocmConnection,
+	}, nil
+}
+
+// Close closes the underlying ocm connection, unless it is shared by the reconciles using the same OCM account.
+func (c *NodeConfigClient) Close() error {
+	return closeConnection(c.ocm)
+}
+
+// ListKubeletConfigs lists all kubelet configs of the cluster.
+func (c *NodeConfigClient) ListKubeletConfigs(clusterID string) ([]*cmv1.KubeletConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		KubeletConfigs().
+		List().Page(1).Size(-1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
+
+// CreateKubeletConfig creates a new kubelet config.
+func (c *NodeConfigClient) CreateKubeletConfig(clusterID string, kubeletConfig *cmv1.KubeletConfig) (*cmv1.KubeletConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		KubeletConfigs().Add().Body(kubeletConfig).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// UpdateKubeletConfig updates an existing kubelet config.
+func (c *NodeConfigClient) UpdateKubeletConfig(clusterID string, kubeletConfigID string, kubeletConfig *cmv1.KubeletConfig) (*cmv1.KubeletConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		KubeletConfigs().KubeletConfig(kubeletConfigID).
+		Update().Body(kubeletConfig).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// DeleteKubeletConfig deletes the specified kubelet config.
+func (c *NodeConfigClient) DeleteKubeletConfig(clusterID string, kubeletConfigID string) error {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		KubeletConfigs().KubeletConfig(kubeletConfigID).
+		Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+// ListTuningConfigs lists all tuning configs of the cluster.
+func (c *NodeConfigClient) ListTuningConfigs(clusterID string) ([]*cmv1.TuningConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		TuningConfigs().
+		List().Page(1).Size(-1).
+		Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Items().Slice(), nil
+}
+
+// CreateTuningConfig creates a new tuning config.
+func (c *NodeConfigClient) CreateTuningConfig(clusterID string, tuningConfig *cmv1.TuningConfig) (*cmv1.TuningConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		TuningConfigs().Add().Body(tuningConfig).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// UpdateTuningConfig updates an existing tuning config.
+func (c *NodeConfigClient) UpdateTuningConfig(clusterID string, tuningConfigID string, tuningConfig *cmv1.TuningConfig) (*cmv1.TuningConfig, error) {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		TuningConfigs().TuningConfig(tuningConfigID).
+		Update().Body(tuningConfig).Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// DeleteTuningConfig deletes the specified tuning config.
+func (c *NodeConfigClient) DeleteTuningConfig(clusterID string, tuningConfigID string) error {
+	response, err := c.ocm.ClustersMgmt().V1().
+		Clusters().Cluster(clusterID).
+		TuningConfigs().TuningConfig(tuningConfigID).
+		Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+// ReconcileNodeConfigs creates, updates and deletes the kubelet configs and tuning configs of the cluster to match
+// spec.kubeletConfigs and spec.tuningConfigs. The configs applied are recorded in the KubeletConfigsLastAppliedAnnotation
+// and TuningConfigsLastAppliedAnnotation annotations, to only delete the configs managed through the spec. Configs
+// removed from the spec are kept until none of the given node pools and machine pools uses them, and are reported
+// in the returned messages.
+func ReconcileNodeConfigs(rosaScope *scope.ROSAControlPlaneScope, nodeConfigClient *NodeConfigClient, clusterID string,
+	nodePools []*cmv1.NodePool, machinePools []expinfrav1.ROSAMachinePool) (inUse []string, err error) {
+	kubeletConfigsInUse := map[string]sets.Set[string]{}
+	tuningConfigsInUse := map[string]sets.Set[string]{}
+	addUser := func(inUse map[string]sets.Set[string], names []string, nodePoolName string) {
+		for _, name := range names {
+			if inUse[name] == nil {
+				inUse[name] = sets.New[string]()
+			}
+			inUse[name].Insert(nodePoolName)
+		}
+	}
+	for _, nodePool := range nodePools {
+		addUser(kubeletConfigsInUse, nodePool.KubeletConfigs(), nodePool.ID())
+		addUser(tuningConfigsInUse, nodePool.TuningConfigs(), nodePool.ID())
+	}
+	// The machine pools whose node pool isn't created yet use the configs of their spec.
+	for _, machinePool := range machinePools {
+		addUser(kubeletConfigsInUse, machinePool.Spec.KubeletConfigs, machinePool.Spec.NodePoolName)
+		addUser(tuningConfigsInUse, machinePool.Spec.TuningConfigs, machinePool.Spec.NodePoolName)
+	}
+
+	kubeletConfigsKept, kubeletErr := reconcileKubeletConfigs(rosaScope, nodeConfigClient, clusterID, kubeletConfigsInUse)
+	tuningConfigsKept, tuningErr := reconcileTuningConfigs(rosaScope, nodeConfigClient, clusterID, tuningConfigsInUse)
+	return append(kubeletConfigsKept, tuningConfigsKept...), kerrors.NewAggregate([]error{kubeletErr, tuningErr})
+}
+
+func reconcileKubeletConfigs(rosaScope *scope.ROSAControlPlaneScope, nodeConfigClient *NodeConfigClient, clusterID string, inUse map[string]sets.Set[string]) ([]string, error) {
+	lastApplied, err := lastAppliedNames(rosaScope, KubeletConfigsLastAppliedAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
+	existingConfigs, err := nodeConfigClient.ListKubeletConfigs(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kubelet configs: %w", err)
+	}
+	existing := make(map[string]*cmv1.KubeletConfig, len(existingConfigs))
+	for _, kubeletConfig := range existingConfigs {
+		existing[kubeletConfig.Name()] = kubeletConfig
+	}
+
+	applied := sets.New[string]()
+	var kept []string
+	var errs []error
+	for _, spec := range rosaScope.ControlPlane.Spec.KubeletConfigs {
+		kubeletConfig, err := cmv1.NewKubeletConfig().
+			Name(spec.Name).
+			PodPidsLimit(int(spec.PodPidsLimit)).
+			Build()
+		if err == nil {
+			switch current, ok := existing[spec.Name]; {
+			case !ok:
+				rosaScope.Info("Creating kubelet config", "name", spec.Name)
+				_, err = nodeConfigClient.CreateKubeletConfig(clusterID, kubeletConfig)
+			case current.PodPidsLimit() != int(spec.PodPidsLimit):
+				rosaScope.Info("Updating kubelet config", "name", spec.Name)
+				_, err = nodeConfigClient.UpdateKubeletConfig(clusterID, current.ID(), kubeletConfig)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile kubelet config %s: %w", spec.Name, err))
+			// Keep tracking the kubelet config, so that it is deleted if removed from the spec.
+			if lastApplied.Has(spec.Name) {
+				applied.Insert(spec.Name)
+			}
+			continue
+		}
+		applied.Insert(spec.Name)
+	}
+
+	// Delete the kubelet configs removed from the spec which are no longer used.
+	for _, name := range sets.List(lastApplied) {
+		if specHasKubeletConfig(rosaScope.ControlPlane.Spec.KubeletConfigs, name) {
+			continue
+		}
+		current, ok := existing[name]
+		if !ok {
+			continue
+		}
+		if nodePools := inUse[name]; nodePools.Len() > 0 {
+			kept = append(kept, fmt.Sprintf("kubelet config %s can't be deleted while used by node pools %s", name, strings.Join(sets.List(nodePools), ", ")))
+			applied.Insert(name)
+			continue
+		}
+		rosaScope.Info("Deleting kubelet config", "name", name)
+		if err := nodeConfigClient.DeleteKubeletConfig(clusterID, current.ID()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete kubelet config %s: %w", name, err))
+			applied.Insert(name)
+		}
+	}
+
+	if err := setLastAppliedNames(rosaScope, KubeletConfigsLastAppliedAnnotation, applied); err != nil {
+		return kept, err
+	}
+	return kept, kerrors.NewAggregate(errs)
+}
+
+func reconcileTuningConfigs(rosaScope *scope.ROSAControlPlaneScope, nodeConfigClient *NodeConfigClient, clusterID string, inUse map[string]sets.Set[string]) ([]string, error) {
+	lastApplied, err := lastAppliedNames(rosaScope, TuningConfigsLastAppliedAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
+	existingConfigs, err := nodeConfigClient.ListTuningConfigs(clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tuning configs: %w", err)
+	}
+	existing := make(map[string]*cmv1.TuningConfig, len(existingConfigs))
+	for _, tuningConfig := range existingConfigs {
+		existing[tuningConfig.Name()] = tuningConfig
+	}
+
+	applied := sets.New[string]()
+	var kept []string
+	var errs []error
+	for _, spec := range rosaScope.ControlPlane.Spec.TuningConfigs {
+		err := reconcileTuningConfig(rosaScope, nodeConfigClient, clusterID, spec, existing[spec.Name])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile tuning config %s: %w", spec.Name, err))
+			// Keep tracking the tuning config, so that it is deleted if removed from the spec.
+			if lastApplied.Has(spec.Name) {
+				applied.Insert(spec.Name)
+			}
+			continue
+		}
+		applied.Insert(spec.Name)
+	}
+
+	// Delete the tuning configs removed from the spec which are no longer used.
+	for _, name := range sets.List(lastApplied) {
+		if specHasTuningConfig(rosaScope.ControlPlane.Spec.TuningConfigs, name) {
+			continue
+		}
+		current, ok := existing[name]
+		if !ok {
+			continue
+		}
+		if nodePools := inUse[name]; nodePools.Len() > 0 {
+			kept = append(kept, fmt.Sprintf("tuning config %s can't be deleted while used by node pools %s", name, strings.Join(sets.List(nodePools), ", ")))
+			applied.Insert(name)
+			continue
+		}
+		rosaScope.Info("Deleting tuning config", "name", name)
+		if err := nodeConfigClient.DeleteTuningConfig(clusterID, current.ID()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete tuning config %s: %w", name, err))
+			applied.Insert(name)
+		}
+	}
+
+	if err := setLastAppliedNames(rosaScope, TuningConfigsLastAppliedAnnotation, applied); err != nil {
+		return kept, err
+	}
+	return kept, kerrors.NewAggregate(errs)
+}
+
+// reconcileTuningConfig creates a tuning config, or updates it when its spec differs from the desired spec.
+func reconcileTuningConfig(rosaScope *scope.ROSAControlPlaneScope, nodeConfigClient *NodeConfigClient, clusterID string,
+	spec rosacontrolplanev1.TuningConfig, current *cmv1.TuningConfig) error {
+	var desiredSpec interface{}
+	if err := json.Unmarshal(spec.Spec.Raw, &desiredSpec); err != nil {
+		return fmt.Errorf("failed to unmarshal spec: %w", err)
+	}
+	tuningConfig, err := cmv1.NewTuningConfig().
+		Name(spec.Name).
+		Spec(desiredSpec).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		rosaScope.Info("Creating tuning config", "name", spec.Name)
+		_, err = nodeConfigClient.CreateTuningConfig(clusterID, tuningConfig)
+		return err
+	}
+
+	equal, err := tuningConfigSpecsEqual(current.Spec(), desiredSpec)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		rosaScope.Info("Updating tuning config", "name", spec.Name)
+		_, err = nodeConfigClient.UpdateTuningConfig(clusterID, current.ID(), tuningConfig)
+	}
+	return err
+}
+
+// tuningConfigSpecsEqual compares tuning config specs through their normalized JSON encoding, which doesn't depend on
+// the types the specs were decoded to.
+func tuningConfigSpecsEqual(a, b interface{}) (bool, error) {
+	normalizedA, err := normalizedJSON(a)
+	if err != nil {
+		return false, err
+	}
+	normalizedB, err := normalizedJSON(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(normalizedA, normalizedB), nil
+}
+
+// normalizedJSON returns the JSON encoding of a value, with sorted object keys.
+func normalizedJSON(value interface{}) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tuning config spec: %w", err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tuning config spec: %w", err)
+	}
+	return json.Marshal(decoded)
+}
+
+func specHasKubeletConfig(kubeletConfigs []rosacontrolplanev1.KubeletConfig, name string) bool {
+	for _, kubeletConfig := range kubeletConfigs {
+		if kubeletConfig.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func specHasTuningConfig(tuningConfigs []rosacontrolplanev1.TuningConfig, name string) bool {
+	for _, tuningConfig := range tuningConfigs {
+		if tuningConfig.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// lastAppliedNames returns the names recorded in the given annotation of the control plane.
+func lastAppliedNames(rosaScope *scope.ROSAControlPlaneScope, annotation string) (sets.Set[string], error) {
+	var names []string
+	if jsonAnnotation := rosaScope.ControlPlane.Annotations[annotation]; jsonAnnotation != "" {
+		if err := json.Unmarshal([]byte(jsonAnnotation), &names); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal '%s' annotation content: %w", annotation, err)
+		}
+	}
+	return sets.New(names...), nil
+}
+
+// setLastAppliedNames records names in the given annotation of the control plane, removing the annotation when
+// there is no name to record.
+func setLastAppliedNames(rosaScope *scope.ROSAControlPlaneScope, annotation string, names sets.Set[string]) error {
+	if names.Len() == 0 {
+		delete(rosaScope.ControlPlane.Annotations, annotation)
+		return nil
+	}
+
+	jsonAnnotation, err := json.Marshal(sets.List(names))
+	if err != nil {
+		return fmt.Errorf("failed to marshal '%s' annotation content: %w", annotation, err)
+	}
+	if rosaScope.ControlPlane.Annotations == nil {
+		rosaScope.ControlPlane.Annotations = map[string]string{}
+	}
+	rosaScope.ControlPlane.Annotations[annotation] = string(jsonAnnotation)
+	return nil
+}
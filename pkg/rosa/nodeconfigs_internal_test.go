@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTuningConfigSpecsEqual(t *testing.T) {
+	decode := func(raw string) interface{} {
+		var spec interface{}
+		NewWithT(t).Expect(json.Unmarshal([]byte(raw), &spec)).To(Succeed())
+		return spec
+	}
+
+	testCases := []struct {
+		name   string
+		a      interface{}
+		b      interface{}
+		expect bool
+	}{
+		{
+			name:   "same specs with keys in another order",
+			a:      decode(`{"profile":[{"name":"a","data":"x"}],"recommend":[{"priority":10,"profile":"a"}]}`),
+			b:      decode(`{"recommend":[{"profile":"a","priority":10}],"profile":[{"data":"x","name":"a"}]}`),
+			expect: true,
+		},
+		{
+			name: "same specs decoded to different types",
+			a:    decode(`{"recommend":[{"priority":10,"profile":"a"}]}`),
+			b: map[string]interface{}{
+				"recommend": []map[string]interface{}{{"priority": 10, "profile": "a"}},
+			},
+			expect: true,
+		},
+		{
+			name:   "different specs",
+			a:      decode(`{"recommend":[{"priority":10,"profile":"a"}]}`),
+			b:      decode(`{"recommend":[{"priority":20,"profile":"a"}]}`),
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			equal, err := tuningConfigSpecsEqual(tc.a, tc.b)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(equal).To(Equal(tc.expect))
+		})
+	}
+}
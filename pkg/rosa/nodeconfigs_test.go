@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileNodeConfigs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	server, err := ocmfake.NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	ocmClient, err := server.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "test-cluster",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator:     &rosaaws.Creator{ARN: "arn:aws:iam::123456789012:user/test", AccountID: "123456789012"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+
+	kubeClient := fake.NewClientBuilder().WithObjects(server.CredentialsSecret("rosa-creds-secret", "default")).Build()
+	rosaScope := &scope.ROSAControlPlaneScope{
+		Client:  kubeClient,
+		Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+		ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+				CredentialsSecretRef: &corev1.LocalObjectReference{Name: "rosa-creds-secret"},
+				KubeletConfigs: []rosacontrolplanev1.KubeletConfig{
+					{Name: "high-pids", PodPidsLimit: 8192},
+				},
+				TuningConfigs: []rosacontrolplanev1.TuningConfig{
+					{Name: "dirty-ratio", Spec: runtime.RawExtension{Raw: []byte(`{"profile":[{"name":"dirty-ratio","data":"[sysctl]\nvm.dirty_ratio=\"55\"\n"}],"recommend":[{"priority":10,"profile":"dirty-ratio"}]}`)}},
+				},
+			},
+		},
+		Logger: *logger.NewLogger(klog.Background()),
+	}
+
+	nodeConfigClient, err := rosa.NewNodeConfigClient(ctx, rosaScope)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer nodeConfigClient.Close()
+
+	var machinePools []expinfrav1.ROSAMachinePool
+	reconcileInUse := func() ([]string, error) {
+		nodePools, err := ocmClient.GetNodePools(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		return rosa.ReconcileNodeConfigs(rosaScope, nodeConfigClient, cluster.ID(), nodePools, machinePools)
+	}
+	reconcile := func() error {
+		inUse, err := reconcileInUse()
+		g.Expect(inUse).To(BeEmpty())
+		return err
+	}
+	kubeletConfigs := func() map[string]*cmv1.KubeletConfig {
+		configs, err := nodeConfigClient.ListKubeletConfigs(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		byName := map[string]*cmv1.KubeletConfig{}
+		for _, config := range configs {
+			byName[config.Name()] = config
+		}
+		return byName
+	}
+	tuningConfigs := func() map[string]*cmv1.TuningConfig {
+		configs, err := nodeConfigClient.ListTuningConfigs(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+		byName := map[string]*cmv1.TuningConfig{}
+		for _, config := range configs {
+			byName[config.Name()] = config
+		}
+		return byName
+	}
+
+	t.Run("creates the configs of the spec", func(t *testing.T) {
+		g.Expect(reconcile()).To(Succeed())
+
+		g.Expect(kubeletConfigs()).To(HaveKey("high-pids"))
+		g.Expect(kubeletConfigs()["high-pids"].PodPidsLimit()).To(Equal(8192))
+		g.Expect(tuningConfigs()).To(HaveKey("dirty-ratio"))
+		g.Expect(tuningConfigs()["dirty-ratio"].Spec()).To(HaveKeyWithValue("recommend", HaveLen(1)))
+		g.Expect(rosaScope.ControlPlane.Annotations).To(HaveKeyWithValue(rosa.KubeletConfigsLastAppliedAnnotation, `["high-pids"]`))
+		g.Expect(rosaScope.ControlPlane.Annotations).To(HaveKeyWithValue(rosa.TuningConfigsLastAppliedAnnotation, `["dirty-ratio"]`))
+	})
+
+	t.Run("updates changed configs", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.KubeletConfigs[0].PodPidsLimit = 16384
+		rosaScope.ControlPlane.Spec.TuningConfigs[0].Spec = runtime.RawExtension{Raw: []byte(`{"profile":[],"recommend":[]}`)}
+		g.Expect(reconcile()).To(Succeed())
+
+		g.Expect(kubeletConfigs()["high-pids"].PodPidsLimit()).To(Equal(16384))
+		g.Expect(tuningConfigs()["dirty-ratio"].Spec()).To(HaveKeyWithValue("recommend", BeEmpty()))
+	})
+
+	nodePool, err := cmv1.NewNodePool().
+		ID("workers").
+		Replicas(2).
+		KubeletConfigs("high-pids").
+		TuningConfigs("dirty-ratio").
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = ocmClient.CreateNodePool(cluster.ID(), nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	t.Run("keeps the configs removed from the spec while node pools use them", func(t *testing.T) {
+		rosaScope.ControlPlane.Spec.KubeletConfigs = nil
+		rosaScope.ControlPlane.Spec.TuningConfigs = nil
+		inUse, err := reconcileInUse()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(inUse).To(ConsistOf(
+			"kubelet config high-pids can't be deleted while used by node pools workers",
+			"tuning config dirty-ratio can't be deleted while used by node pools workers",
+		))
+
+		g.Expect(kubeletConfigs()).To(HaveKey("high-pids"))
+		g.Expect(tuningConfigs()).To(HaveKey("dirty-ratio"))
+		g.Expect(rosaScope.ControlPlane.Annotations).To(HaveKey(rosa.KubeletConfigsLastAppliedAnnotation))
+		g.Expect(rosaScope.ControlPlane.Annotations).To(HaveKey(rosa.TuningConfigsLastAppliedAnnotation))
+	})
+
+	t.Run("keeps the configs used by machine pools whose node pool doesn't exist yet", func(t *testing.T) {
+		g.Expect(ocmClient.DeleteNodePool(cluster.ID(), "workers")).To(Succeed())
+		server.Settle()
+		machinePools = []expinfrav1.ROSAMachinePool{{
+			Spec: expinfrav1.RosaMachinePoolSpec{NodePoolName: "pending", KubeletConfigs: []string{"high-pids"}},
+		}}
+		inUse, err := reconcileInUse()
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(inUse).To(ConsistOf("kubelet config high-pids can't be deleted while used by node pools pending"))
+
+		g.Expect(kubeletConfigs()).To(HaveKey("high-pids"))
+		g.Expect(tuningConfigs()).To(BeEmpty())
+	})
+
+	t.Run("deletes the configs removed from the spec once unused", func(t *testing.T) {
+		machinePools = nil
+		g.Expect(reconcile()).To(Succeed())
+
+		g.Expect(kubeletConfigs()).To(BeEmpty())
+		g.Expect(tuningConfigs()).To(BeEmpty())
+		g.Expect(rosaScope.ControlPlane.Annotations).ToNot(HaveKey(rosa.KubeletConfigsLastAppliedAnnotation))
+		g.Expect(rosaScope.ControlPlane.Annotations).ToNot(HaveKey(rosa.TuningConfigsLastAppliedAnnotation))
+	})
+}
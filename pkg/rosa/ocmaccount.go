@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
+)
+
+// connections are the OCM connections of the OCM accounts, shared by all the reconciles using them.
+var connections = &connectionPool{
+	connections: map[string]*pooledConnection{},
+}
+
+// connectionPool holds an OCM connection per OCM account. The connection of an OCM account is replaced when
+// its credentials or organization change, and removed when it is deleted.
+type connectionPool struct {
+	mu          sync.Mutex
+	connections map[string]*pooledConnection
+}
+
+type pooledConnection struct {
+	connection *sdk.Connection
+	// fingerprint identifies the credentials and organization the connection was built for.
+	fingerprint string
+}
+
+// ocmAccountConnection returns the OCM connection of the OCM account referenced by the object of rosaScope,
+// building it if it isn't pooled yet.
+func ocmAccountConnection(ctx context.Context, rosaScope OCMSecretsRetriever, name string) (*sdk.Connection, error) {
+	k8sClient := rosaScope.GetClient()
+
+	account := &expinfrav1.OCMAccount{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, account); err != nil {
+		if apierrors.IsNotFound(err) {
+			connections.remove(name)
+		}
+		return nil, fmt.Errorf("failed to get OCMAccount %s: %w", name, err)
+	}
+
+	// Cluster-scoped objects can use any OCM account.
+	if namespace := rosaScope.Namespace(); namespace != "" {
+		permitted, err := scope.IsNamespacePermitted(k8sClient, account.Spec.AllowedNamespaces, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the namespaces allowed by OCMAccount %s: %w", name, err)
+		}
+		if !permitted {
+			return nil, fmt.Errorf("namespace %s is not permitted to use OCMAccount %s", namespace, name)
+		}
+	}
+
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Name: account.Spec.SecretRef, Namespace: system.GetManagerNamespace()}
+	if err := k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret of OCMAccount %s: %w", name, err)
+	}
+
+	url := string(secret.Data[ocmAPIURLKey])
+	if url == "" {
+		url = defaultOCMAPIURL
+	}
+	tokenURL := string(secret.Data[ocmTokenURLKey])
+	clientID := string(secret.Data[ocmClientIDKey])
+	clientSecret := string(secret.Data[ocmClientSecretKey])
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("credentials secret %s of OCMAccount %s must contain the %s and %s keys", secretKey, name, ocmClientIDKey, ocmClientSecretKey)
+	}
+
+	fingerprint := sha256.Sum256([]byte(url + "\x00" + tokenURL + "\x00" + clientID + "\x00" + clientSecret + "\x00" + account.Spec.OrganizationID))
+	return connections.get(name, hex.EncodeToString(fingerprint[:]), func() (*sdk.Connection, error) {
+		rosaScope.Info("Connecting to OCM", "ocmAccount", name)
+		connection, err := buildOCMConnection(url, tokenURL, "", clientID, clientSecret)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyOrganization(ctx, connection, account.Spec.OrganizationID); err != nil {
+			_ = connection.Close()
+			return nil, fmt.Errorf("failed to verify the organization of OCMAccount %s: %w", name, err)
+		}
+		return connection, nil
+	})
+}
+
+// verifyOrganization checks that the account of an OCM connection belongs to the organization with the given ID
+// or external ID.
+func verifyOrganization(ctx context.Context, connection *sdk.Connection, organizationID string) error {
+	if organizationID == "" {
+		return nil
+	}
+
+	response, err := connection.AccountsMgmt().V1().CurrentAccount().Get().SendContext(ctx)
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	organization := response.Body().Organization()
+	if organization.ID() != organizationID && organization.ExternalID() != organizationID {
+		return fmt.Errorf("the service account belongs to organization %s (%s), not %s", organization.ID(), organization.ExternalID(), organizationID)
+	}
+	return nil
+}
+
+// get returns the pooled connection of an OCM account, or builds and pools a new connection when the account
+// has no connection or its connection was built for other credentials. The replaced connection is closed, failing
+// the requests still using it, which are retried with the new connection by the next reconcile.
+func (p *connectionPool) get(name, fingerprint string, build func() (*sdk.Connection, error)) (*sdk.Connection, error) {
+	p.mu.Lock()
+	if pooled, ok := p.connections[name]; ok && pooled.fingerprint == fingerprint {
+		p.mu.Unlock()
+		return pooled.connection, nil
+	}
+	p.mu.Unlock()
+
+	// The connection is built without holding the lock, so that an unreachable OCM doesn't block the other accounts.
+	connection, err := build()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pooled, ok := p.connections[name]; ok {
+		if pooled.fingerprint == fingerprint {
+			// Another reconcile pooled a connection in the meantime.
+			_ = connection.Close()
+			return pooled.connection, nil
+		}
+		_ = pooled.connection.Close()
+	}
+	p.connections[name] = &pooledConnection{connection: connection, fingerprint: fingerprint}
+	return connection, nil
+}
+
+// remove closes and removes the pooled connection of an OCM account.
+func (p *connectionPool) remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pooled, ok := p.connections[name]; ok {
+		_ = pooled.connection.Close()
+		delete(p.connections, name)
+	}
+}
+
+// pooled returns whether a connection is pooled.
+func (p *connectionPool) pooled(connection *sdk.Connection) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pooled := range p.connections {
+		if pooled.connection == connection {
+			return true
+		}
+	}
+	return false
+}
+
+// closeConnection closes an OCM connection, unless it is pooled and shared with other reconciles.
+func closeConnection(connection *sdk.Connection) error {
+	if connections.pooled(connection) {
+		return nil
+	}
+	return connection.Close()
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rosa_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/helpers/ocmfake"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/system"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestOCMAccountConnections(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	server, err := ocmfake.NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	ocmClient, err := server.NewOCMClient(ctx, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	cluster, err := ocmClient.CreateCluster(ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           "test-cluster",
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator:     &rosaaws.Creator{ARN: "arn:aws:iam::123456789012:user/test", AccountID: "123456789012"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(expinfrav1.AddToScheme(scheme)).To(Succeed())
+	kubeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(server.ServiceAccountSecret("ocm-service-account", system.GetManagerNamespace())).
+		Build()
+
+	createAccount := func(name string, allowedNamespaces *infrav1.AllowedNamespaces, organizationID string) *expinfrav1.OCMAccount {
+		account := &expinfrav1.OCMAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: expinfrav1.OCMAccountSpec{
+				AllowedNamespaces: allowedNamespaces,
+				SecretRef:         "ocm-service-account",
+				OrganizationID:    organizationID,
+			},
+		}
+		g.Expect(kubeClient.Create(ctx, account)).To(Succeed())
+		return account
+	}
+	scopeFor := func(accountName string) *scope.ROSAControlPlaneScope {
+		return &scope.ROSAControlPlaneScope{
+			Client:  kubeClient,
+			Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"}},
+			ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+				Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+					OCMAccountRef: &corev1.LocalObjectReference{Name: accountName},
+				},
+			},
+			Logger: *logger.NewLogger(klog.Background()),
+		}
+	}
+
+	t.Run("shares the connection of an OCM account between the clients", func(t *testing.T) {
+		createAccount("shared", &infrav1.AllowedNamespaces{}, ocmfake.OrganizationID)
+		tokenRequests := server.TokenRequests()
+
+		first, err := rosa.NewIngressClient(ctx, scopeFor("shared"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(first.Close()).To(Succeed())
+
+		second, err := rosa.NewIngressClient(ctx, scopeFor("shared"))
+		g.Expect(err).ToNot(HaveOccurred())
+		defer second.Close()
+		_, err = second.ListIngresses(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(server.TokenRequests()).To(Equal(tokenRequests + 1))
+	})
+
+	t.Run("rejects the namespaces not allowed by the OCM account", func(t *testing.T) {
+		createAccount("restricted", &infrav1.AllowedNamespaces{NamespaceList: []string{"team-a"}}, "")
+
+		_, err := rosa.NewIngressClient(ctx, scopeFor("restricted"))
+		g.Expect(err).To(MatchError(ContainSubstring("namespace default is not permitted to use OCMAccount restricted")))
+	})
+
+	t.Run("fails when the service account belongs to another organization", func(t *testing.T) {
+		createAccount("other-organization", &infrav1.AllowedNamespaces{}, "other")
+
+		_, err := rosa.NewIngressClient(ctx, scopeFor("other-organization"))
+		g.Expect(err).To(MatchError(ContainSubstring("failed to verify the organization of OCMAccount other-organization")))
+	})
+
+	t.Run("reconnects when the OCM account changes", func(t *testing.T) {
+		account := createAccount("changed", &infrav1.AllowedNamespaces{}, ocmfake.OrganizationID)
+		_, err := rosa.NewIngressClient(ctx, scopeFor("changed"))
+		g.Expect(err).ToNot(HaveOccurred())
+		tokenRequests := server.TokenRequests()
+
+		account.Spec.OrganizationID = ocmfake.OrganizationExternalID
+		g.Expect(kubeClient.Update(ctx, account)).To(Succeed())
+		client, err := rosa.NewIngressClient(ctx, scopeFor("changed"))
+		g.Expect(err).ToNot(HaveOccurred())
+		defer client.Close()
+		_, err = client.ListIngresses(cluster.ID())
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(server.TokenRequests()).To(Equal(tokenRequests + 1))
+	})
+
+	t.Run("forgets the connection of a deleted OCM account", func(t *testing.T) {
+		account := createAccount("deleted", &infrav1.AllowedNamespaces{}, ocmfake.OrganizationID)
+		_, err := rosa.NewIngressClient(ctx, scopeFor("deleted"))
+		g.Expect(err).ToNot(HaveOccurred())
+		tokenRequests := server.TokenRequests()
+
+		g.Expect(kubeClient.Delete(ctx, account)).To(Succeed())
+		_, err = rosa.NewIngressClient(ctx, scopeFor("deleted"))
+		g.Expect(err).To(MatchError(ContainSubstring("failed to get OCMAccount deleted")))
+
+		createAccount("deleted", &infrav1.AllowedNamespaces{}, ocmfake.OrganizationID)
+		_, err = rosa.NewIngressClient(ctx, scopeFor("deleted"))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(server.TokenRequests()).To(Equal(tokenRequests + 1))
+	})
+}
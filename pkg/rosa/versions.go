@@ -12,6 +12,9 @@ import (
 // MinSupportedVersion is the minimum supported version for ROSA.
 var MinSupportedVersion = semver.MustParse("4.14.0")
 
+// upgradeLeadTime is how far in the future upgrades are scheduled at the earliest.
+const upgradeLeadTime = 6 * time.Minute
+
 // CheckExistingScheduledUpgrade checks and returns the current upgrade schedule if any.
 func CheckExistingScheduledUpgrade(client OCMClient, cluster *cmv1.Cluster) (*cmv1.ControlPlaneUpgradePolicy, error) {
 	upgradePolicies, err := client.GetControlPlaneUpgradePolicies(cluster.ID())
@@ -31,7 +34,7 @@ func ScheduleControlPlaneUpgrade(client OCMClient, cluster *cmv1.Cluster, versio
 	// earliestNextRun is set to at least 5 min from now by the OCM API.
 	// Set our next run request to something slightly longer than 5min to make sure we account for the latency between when we send this
 	// request and when the server processes it.
-	earliestNextRun := time.Now().Add(time.Minute * 6)
+	earliestNextRun := time.Now().Add(upgradeLeadTime)
 	if nextRun.Before(earliestNextRun) {
 		nextRun = earliestNextRun
 	}
@@ -75,7 +78,7 @@ func ScheduleNodePoolUpgrade(client OCMClient, clusterID string, nodePool *cmv1.
 	// earliestNextRun is set to at least 5 min from now by the OCM API.
 	// Set our next run request to something slightly longer than 5min to make sure we account for the latency between when we send this
 	// request and when the server processes it.
-	earliestNextRun := time.Now().Add(time.Minute * 6)
+	earliestNextRun := time.Now().Add(upgradeLeadTime)
 	if nextRun.Before(earliestNextRun) {
 		nextRun = earliestNextRun
 	}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocmfake
+
+import (
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// tokenPath is the path of the token endpoint, where service accounts get their access tokens.
+	tokenPath = "/auth/realms/redhat-external/protocol/openid-connect/token"
+
+	currentAccountPath = "/api/accounts_mgmt/v1/current_account"
+
+	// ServiceAccountClientID is the client ID of the service account accepted by the server.
+	ServiceAccountClientID = "ocmfake-service-account"
+
+	// ServiceAccountClientSecret is the client secret of the service account accepted by the server.
+	ServiceAccountClientSecret = "ocmfake-service-account-secret"
+
+	// OrganizationID is the ID of the organization of the account using the server.
+	OrganizationID = "2ocmfakeorganization"
+
+	// OrganizationExternalID is the external ID of the organization of the account using the server.
+	OrganizationExternalID = "12345678"
+)
+
+// ServiceAccountSecret returns a secret holding the service account credentials of the server, which can be
+// referenced by the secretRef of an OCMAccount so that the connections built by the controllers reach the server.
+func (s *Server) ServiceAccountSecret(name, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"ocmClientID":     []byte(ServiceAccountClientID),
+			"ocmClientSecret": []byte(ServiceAccountClientSecret),
+			"ocmApiUrl":       []byte(s.URL()),
+			"ocmTokenUrl":     []byte(s.URL() + tokenPath),
+		},
+	}
+}
+
+// TokenRequests returns the number of access tokens issued to service accounts, which is the number of connections
+// built from service account credentials.
+func (s *Server) TokenRequests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tokenRequests
+}
+
+// issueToken handles the client credentials grant of the token endpoint.
+func (s *Server) issueToken(r *http.Request) (int, any, *apiError) {
+	if r.Method != http.MethodPost {
+		return 0, nil, errorf(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+	}
+	if err := r.ParseForm(); err != nil {
+		return 0, nil, errorf(http.StatusBadRequest, "failed to parse form: %v", err)
+	}
+	if grantType := r.PostForm.Get("grant_type"); grantType != "client_credentials" {
+		return 0, nil, errorf(http.StatusBadRequest, "unsupported grant type %q", grantType)
+	}
+	// The client credentials are sent as basic auth, or in the form.
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != ServiceAccountClientID || clientSecret != ServiceAccountClientSecret {
+		return 0, nil, errorf(http.StatusUnauthorized, "invalid client credentials")
+	}
+
+	s.tokenRequests++
+	return http.StatusOK, map[string]any{
+		"access_token": s.token,
+		"token_type":   "Bearer",
+	}, nil
+}
+
+// currentAccount returns the account using the server.
+func (s *Server) currentAccount(r *http.Request) (int, any, *apiError) {
+	if r.Method != http.MethodGet {
+		return 0, nil, errorf(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+	}
+	return http.StatusOK, map[string]any{
+		"kind":     "Account",
+		"id":       "2ocmfakeaccount",
+		"username": "ocmfake",
+		"organization": map[string]any{
+			"kind":        "Organization",
+			"id":          OrganizationID,
+			"external_id": OrganizationExternalID,
+			"name":        "ocmfake",
+		},
+	}, nil
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocmfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const apiPrefix = "/api/clusters_mgmt/v1"
+
+// collections are the names of the collections of the objects of a cluster.
+var collections = map[string]string{
+	"node_pools":              "NodePool",
+	"upgrade_policies":        "UpgradePolicy",
+	"identity_providers":      "IdentityProvider",
+	"htpasswd_users":          "HTPasswdUser",
+	"users":                   "User",
+	"external_auths":          "ExternalAuth",
+	"log_forwarders":          "LogForwarder",
+	"break_glass_credentials": "BreakGlassCredential",
+	"gate_agreements":         "VersionGateAgreement",
+	"kubelet_configs":         "KubeletConfig",
+	"tuning_configs":          "TuningConfig",
+	"ingresses":               "Ingress",
+}
+
+// searchTerm matches the `field = 'value'` terms of search queries.
+var searchTerm = regexp.MustCompile(`([a-z_.]+)\s*=\s*'([^']*)'`)
+
+// apiError is a request failure, rendered as an OCM error.
+type apiError struct {
+	status  int
+	reason  string
+	details any
+}
+
+func (e *apiError) Error() string {
+	return e.reason
+}
+
+func errorf(status int, format string, args ...any) *apiError {
+	return &apiError{status: status, reason: fmt.Sprintf(format, args...)}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, body, err := s.handle(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, status, body)
+}
+
+func (s *Server) handle(r *http.Request) (int, any, *apiError) {
+	switch r.URL.Path {
+	case tokenPath:
+		return s.issueToken(r)
+	case currentAccountPath:
+		return s.currentAccount(r)
+	}
+
+	path, ok := strings.CutPrefix(r.URL.Path, apiPrefix)
+	if !ok {
+		return 0, nil, errorf(http.StatusNotFound, "path %q not found", r.URL.Path)
+	}
+	path = strings.TrimSuffix(path, "/")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	switch {
+	case path == "/versions" && r.Method == http.MethodGet:
+		return s.listVersions(r)
+	case len(segments) == 2 && segments[0] == "versions" && r.Method == http.MethodGet:
+		return s.getVersion(segments[1])
+	case path == "/aws_inquiries/sts_policies" && r.Method == http.MethodGet:
+		return list(r, "STSPolicyList", nil)
+	case path == "/clusters" || path == "/oidc_configs":
+		return s.handleCollection(r, path)
+	case len(segments) == 2 && segments[0] == "oidc_configs":
+		return s.handleObject(r, path)
+	case segments[0] != "clusters":
+		return 0, nil, errorf(http.StatusNotFound, "path %q not found", r.URL.Path)
+	}
+
+	// Every other object belongs to a cluster.
+	if _, ok := s.resources[clusterPath(segments[1])]; !ok {
+		return 0, nil, errorf(http.StatusNotFound, "cluster %q not found", segments[1])
+	}
+
+	last := segments[len(segments)-1]
+	switch {
+	case len(segments) == 2:
+		return s.handleObject(r, path)
+	case len(segments) == 3 && last == "delete_protection":
+		return s.handleDeleteProtection(r, clusterPath(segments[1]))
+	case len(segments) == 3 && last == "autoscaler":
+		return s.handleAutoscaler(r, path)
+	case collections[last] != "" && collections[segments[len(segments)-2]] == "":
+		return s.handleCollection(r, path)
+	case collections[segments[len(segments)-2]] != "":
+		return s.handleObject(r, path)
+	}
+	return 0, nil, errorf(http.StatusNotFound, "path %q not found", r.URL.Path)
+}
+
+func (s *Server) handleCollection(r *http.Request, path string) (int, any, *apiError) {
+	switch r.Method {
+	case http.MethodGet:
+		items := s.items(path)
+		if path == "/clusters" {
+			items = filterClusters(items, r.URL.Query().Get("search"))
+		}
+		return list(r, collectionKind(path)+"List", items)
+	case http.MethodPost:
+		body := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return 0, nil, errorf(http.StatusBadRequest, "invalid body: %v", err)
+		}
+		created, err := s.create(path, body, r.URL.Query().Get("dryRun") == "true")
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusCreated, created, nil
+	case http.MethodDelete:
+		if err := s.deleteCollection(path); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, errorf(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+}
+
+func (s *Server) handleObject(r *http.Request, path string) (int, any, *apiError) {
+	object, ok := s.resources[path]
+	if !ok {
+		return 0, nil, errorf(http.StatusNotFound, "object %q not found", path)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return http.StatusOK, object.body, nil
+	case http.MethodPatch:
+		patch := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			return 0, nil, errorf(http.StatusBadRequest, "invalid body: %v", err)
+		}
+		if err := s.update(path, object, patch); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, object.body, nil
+	case http.MethodDelete:
+		if err := s.delete(path, object); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusNoContent, nil, nil
+	}
+	return 0, nil, errorf(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+}
+
+func (s *Server) handleDeleteProtection(r *http.Request, path string) (int, any, *apiError) {
+	cluster := s.resources[path]
+	switch r.Method {
+	case http.MethodGet:
+		return http.StatusOK, cluster.body["delete_protection"], nil
+	case http.MethodPatch:
+		patch := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			return 0, nil, errorf(http.StatusBadRequest, "invalid body: %v", err)
+		}
+		merge(cluster.body, map[string]any{"delete_protection": patch})
+		return http.StatusOK, cluster.body["delete_protection"], nil
+	}
+	return 0, nil, errorf(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+}
+
+// handleAutoscaler serves the autoscaler of a cluster, which exists once created.
+func (s *Server) handleAutoscaler(r *http.Request, path string) (int, any, *apiError) {
+	if r.Method != http.MethodPost {
+		return s.handleObject(r, path)
+	}
+	if _, exists := s.resources[path]; exists {
+		return 0, nil, errorf(http.StatusConflict, "autoscaler %q already exists", path)
+	}
+	body := map[string]any{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return 0, nil, errorf(http.StatusBadRequest, "invalid body: %v", err)
+	}
+	body["kind"] = "ClusterAutoscaler"
+	s.add(path, body)
+	return http.StatusCreated, body, nil
+}
+
+// items returns the objects of a collection in creation order.
+func (s *Server) items(collection string) []map[string]any {
+	var resources []*resource
+	for path, r := range s.resources {
+		if parent, _ := splitPath(path); parent == collection {
+			resources = append(resources, r)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].sequence < resources[j].sequence })
+
+	items := make([]map[string]any, 0, len(resources))
+	for _, r := range resources {
+		items = append(items, r.body)
+	}
+	return items
+}
+
+// filterClusters returns the clusters matching the ID, name or external ID of a search query.
+func filterClusters(clusters []map[string]any, search string) []map[string]any {
+	keys := map[string]bool{}
+	for _, term := range searchTerm.FindAllStringSubmatch(search, -1) {
+		switch term[1] {
+		case "id", "name", "external_id":
+			keys[term[2]] = true
+		}
+	}
+	if len(keys) == 0 {
+		return clusters
+	}
+
+	var matching []map[string]any
+	for _, cluster := range clusters {
+		if keys[str(cluster, "id")] || keys[str(cluster, "name")] || keys[str(cluster, "external_id")] {
+			matching = append(matching, cluster)
+		}
+	}
+	return matching
+}
+
+// list renders the requested page of a list of objects.
+func list(r *http.Request, kind string, items []map[string]any) (int, any, *apiError) {
+	page, size := 1, len(items)
+	if value := r.URL.Query().Get("page"); value != "" {
+		page, _ = strconv.Atoi(value)
+	}
+	if value := r.URL.Query().Get("size"); value != "" {
+		if requested, _ := strconv.Atoi(value); requested >= 0 {
+			size = requested
+		}
+	}
+
+	pageItems := []map[string]any{}
+	if start := (page - 1) * size; page > 0 && start < len(items) {
+		pageItems = items[start:min(start+size, len(items))]
+	}
+	return http.StatusOK, map[string]any{
+		"kind":  kind,
+		"page":  page,
+		"size":  len(pageItems),
+		"total": len(items),
+		"items": pageItems,
+	}, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	body := map[string]any{
+		"kind":   "Error",
+		"id":     strconv.Itoa(err.status),
+		"href":   apiPrefix + "/errors/" + strconv.Itoa(err.status),
+		"code":   fmt.Sprintf("CLUSTERS-MGMT-%d", err.status),
+		"reason": err.reason,
+	}
+	if err.details != nil {
+		body["details"] = err.details
+	}
+	writeJSON(w, err.status, body)
+}
+
+// collectionKind returns the kind of the objects of a collection.
+func collectionKind(collection string) string {
+	_, name := splitPath(collection)
+	switch name {
+	case "clusters":
+		return "Cluster"
+	case "oidc_configs":
+		return "OidcConfig"
+	}
+	return collections[name]
+}
+
+// splitPath splits a path into its parent and its last segment.
+func splitPath(path string) (string, string) {
+	i := strings.LastIndex(path, "/")
+	return path[:i], path[i+1:]
+}
+
+func clusterPath(clusterID string) string {
+	return "/clusters/" + clusterID
+}
+
+// merge merges a JSON patch into an object.
+func merge(object, patch map[string]any) {
+	for key, value := range patch {
+		patchObject, ok := value.(map[string]any)
+		if !ok {
+			object[key] = value
+			continue
+		}
+		existing, ok := object[key].(map[string]any)
+		if !ok {
+			existing = map[string]any{}
+			object[key] = existing
+		}
+		merge(existing, patchObject)
+	}
+}
+
+// str returns the string at the given dotted path of an object.
+func str(object map[string]any, path string) string {
+	for {
+		key, rest, nested := strings.Cut(path, ".")
+		if !nested {
+			value, _ := object[key].(string)
+			return value
+		}
+		child, ok := object[key].(map[string]any)
+		if !ok {
+			return ""
+		}
+		object, path = child, rest
+	}
+}
+
+// strSlice returns the strings of the list at the given key of an object.
+func strSlice(object map[string]any, key string) []string {
+	values, _ := object[key].([]any)
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
+
+// child returns the object at the given key of an object, creating it when needed.
+func child(object map[string]any, key string) map[string]any {
+	value, ok := object[key].(map[string]any)
+	if !ok {
+		value = map[string]any{}
+		object[key] = value
+	}
+	return value
+}
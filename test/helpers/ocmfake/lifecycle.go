@@ -0,0 +1,487 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocmfake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/openshift/rosa/pkg/ocm"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+)
+
+// create adds an object to a collection, and returns the created object.
+func (s *Server) create(collection string, body map[string]any, dryRun bool) (map[string]any, *apiError) {
+	parent, name := splitPath(collection)
+	if parent != "" {
+		// The objects of node pools and identity providers require them to exist.
+		if owners, _ := splitPath(parent); collections[owners[strings.LastIndex(owners, "/")+1:]] != "" {
+			if _, ok := s.resources[parent]; !ok {
+				return nil, errorf(http.StatusNotFound, "object %q not found", parent)
+			}
+		}
+	}
+
+	body["kind"] = collectionKind(collection)
+	switch name {
+	case "clusters":
+		return s.createCluster(body, dryRun)
+	case "node_pools":
+		return s.createNodePool(collection, body)
+	case "upgrade_policies":
+		return s.createUpgradePolicy(collection, body, dryRun)
+	case "break_glass_credentials":
+		body["id"] = s.newID()
+		body["status"] = string(cmv1.BreakGlassCredentialStatusIssued)
+		body["kubeconfig"] = s.kubeconfig(s.resources[parent].body, str(body, "username"))
+	case "htpasswd_users":
+		body["id"] = s.newID()
+		delete(body, "password")
+		delete(body, "hashed_password")
+	case "oidc_configs":
+		// Managed OIDC configs are hosted by OCM, unmanaged ones bring their issuer.
+		body["id"] = s.newID()
+		if managed, _ := body["managed"].(bool); managed {
+			body["issuer_url"] = s.URL() + "/oidc/" + str(body, "id")
+		}
+	case "gate_agreements":
+		body["id"] = s.newID()
+		body["agreed_timestamp"] = time.Now().UTC().Format(time.RFC3339)
+	case "users", "external_auths":
+		// The IDs of users and external auth providers are their names.
+		if str(body, "id") == "" {
+			return nil, errorf(http.StatusBadRequest, "%s ID is required", body["kind"])
+		}
+		if _, exists := s.resources[collection+"/"+str(body, "id")]; exists {
+			return nil, errorf(http.StatusConflict, "%s %q already exists", body["kind"], str(body, "id"))
+		}
+	default:
+		for _, item := range s.items(collection) {
+			if name, ok := body["name"]; ok && item["name"] == name {
+				return nil, errorf(http.StatusBadRequest, "%s with name %q already exists", body["kind"], name)
+			}
+		}
+		body["id"] = s.newID()
+	}
+
+	s.add(collection+"/"+str(body, "id"), body)
+	return body, nil
+}
+
+// update applies a patch to an object.
+func (s *Server) update(path string, object *resource, patch map[string]any) *apiError {
+	collection, _ := splitPath(path)
+	_, name := splitPath(collection)
+
+	delete(patch, "id")
+	delete(patch, "href")
+	delete(patch, "kind")
+
+	switch name {
+	case "clusters":
+		delete(patch, "status")
+		delete(patch, "state")
+		if version, ok := patch["version"].(map[string]any); ok {
+			// Versions are changed through upgrade policies.
+			delete(version, "id")
+			delete(version, "raw_id")
+		}
+	case "node_pools":
+		delete(patch, "status")
+		if _, ok := patch["version"]; ok {
+			return errorf(http.StatusBadRequest, "the version of node pools is changed through upgrade policies")
+		}
+		merge(object.body, patch)
+		s.scaleNodePool(object)
+		return nil
+	case "ingresses":
+		// Route selectors are replaced rather than merged.
+		if routeSelectors, ok := patch["route_selectors"]; ok {
+			object.body["route_selectors"] = routeSelectors
+			delete(patch, "route_selectors")
+		}
+	}
+
+	merge(object.body, patch)
+	return nil
+}
+
+// delete deletes an object. Clusters and node pools are removed by the next step, other objects right away.
+func (s *Server) delete(path string, object *resource) *apiError {
+	collection, _ := splitPath(path)
+	_, name := splitPath(collection)
+
+	switch name {
+	case "clusters":
+		if enabled, _ := child(object.body, "delete_protection")["enabled"].(bool); enabled {
+			return errorf(http.StatusBadRequest, "cluster %q has delete protection enabled", str(object.body, "name"))
+		}
+		if str(object.body, "state") == string(cmv1.ClusterStateUninstalling) {
+			return nil
+		}
+		setClusterState(object.body, cmv1.ClusterStateUninstalling, "Uninstalling cluster")
+		object.transitions = []func(){func() { s.remove(path) }}
+	case "node_pools":
+		child(object.body, "status")["message"] = "Deleting"
+		object.transitions = []func(){func() { s.remove(path) }}
+	default:
+		s.remove(path)
+	}
+	return nil
+}
+
+// deleteCollection deletes every object of a collection. Only break glass credentials can be revoked all at once, and
+// they are awaiting revocation until the next step.
+func (s *Server) deleteCollection(collection string) *apiError {
+	if _, name := splitPath(collection); name != "break_glass_credentials" {
+		return errorf(http.StatusMethodNotAllowed, "method %s not allowed", http.MethodDelete)
+	}
+
+	for path, r := range s.resources {
+		if parent, _ := splitPath(path); parent != collection || r.body["status"] != string(cmv1.BreakGlassCredentialStatusIssued) {
+			continue
+		}
+		credential := r.body
+		credential["status"] = string(cmv1.BreakGlassCredentialStatusAwaitingRevocation)
+		r.transitions = []func(){func() {
+			credential["status"] = string(cmv1.BreakGlassCredentialStatusRevoked)
+			credential["revocation_timestamp"] = time.Now().UTC().Format(time.RFC3339)
+		}}
+	}
+	return nil
+}
+
+// createCluster adds a pending cluster, which becomes ready after two steps.
+func (s *Server) createCluster(body map[string]any, dryRun bool) (map[string]any, *apiError) {
+	name := str(body, "name")
+	if name == "" {
+		return nil, errorf(http.StatusBadRequest, "cluster name is required")
+	}
+	for _, cluster := range s.items("/clusters") {
+		if cluster["name"] == name {
+			return nil, errorf(http.StatusBadRequest, "cluster %q already exists", name)
+		}
+	}
+
+	version, err := s.version(str(body, "version.id"), str(body, "version.channel_group"))
+	if err != nil {
+		return nil, err
+	}
+	body["version"] = version
+	if dryRun {
+		return body, nil
+	}
+
+	id := s.newID()
+	body["id"] = id
+	body["external_id"] = fmt.Sprintf("%08s-0000-0000-0000-%012s", id[len(id)-8:], id[len(id)-12:])
+	child(body, "product")["id"] = "rosa"
+	// OCM always reports the delete protection of clusters.
+	if _, ok := child(body, "delete_protection")["enabled"]; !ok {
+		child(body, "delete_protection")["enabled"] = false
+	}
+	setClusterState(body, cmv1.ClusterStatePending, "Preparing account")
+
+	defaultIngress := s.defaultIngress(body)
+
+	path := clusterPath(id)
+	s.add(path, body,
+		func() {
+			setClusterState(body, cmv1.ClusterStateInstalling, "Installing cluster")
+		},
+		func() {
+			setClusterState(body, cmv1.ClusterStateReady, "")
+			domain := fmt.Sprintf("%s.%s.openshiftapps.com", name, id[len(id)-4:])
+			child(body, "api")["url"] = fmt.Sprintf("https://api.%s:443", domain)
+			child(body, "console")["url"] = fmt.Sprintf("https://console-openshift-console.apps.rosa.%s", domain)
+		},
+	)
+	s.add(path+"/ingresses/"+str(defaultIngress, "id"), defaultIngress)
+	return body, nil
+}
+
+// defaultIngress removes the ingresses of a cluster creation request, and returns the default ingress of the
+// cluster built from them. The routes of the default ingress are published like the API server unless requested
+// otherwise.
+func (s *Server) defaultIngress(cluster map[string]any) map[string]any {
+	ingress := map[string]any{
+		"kind":                             "Ingress",
+		"id":                               s.newID(),
+		"default":                          true,
+		"listening":                        string(cmv1.ListeningMethodExternal),
+		"load_balancer_type":               string(cmv1.LoadBalancerFlavorNlb),
+		"route_namespace_ownership_policy": string(cmv1.NamespaceOwnershipPolicyStrict),
+		"route_wildcard_policy":            string(cmv1.WildcardPolicyWildcardsDisallowed),
+	}
+	if listening := str(cluster, "api.listening"); listening != "" {
+		ingress["listening"] = listening
+	}
+	if requested, ok := child(cluster, "ingresses")["items"].([]any); ok && len(requested) > 0 {
+		if requestedIngress, ok := requested[0].(map[string]any); ok {
+			delete(requestedIngress, "id")
+			merge(ingress, requestedIngress)
+		}
+	}
+	delete(cluster, "ingresses")
+	return ingress
+}
+
+// createNodePool adds a node pool, whose replicas become available after a step.
+func (s *Server) createNodePool(collection string, body map[string]any) (map[string]any, *apiError) {
+	id := str(body, "id")
+	if id == "" {
+		return nil, errorf(http.StatusBadRequest, "node pool ID is required")
+	}
+	if _, exists := s.resources[collection+"/"+id]; exists {
+		return nil, errorf(http.StatusBadRequest, "node pool %q already exists", id)
+	}
+
+	parent := strings.TrimSuffix(collection, "/node_pools")
+	for _, configs := range []string{"kubelet_configs", "tuning_configs"} {
+		for _, name := range strSlice(body, configs) {
+			if !s.hasNamed(parent+"/"+configs, name) {
+				return nil, errorf(http.StatusBadRequest, "%s %q not found", collections[configs], name)
+			}
+		}
+	}
+
+	cluster := s.resources[parent].body
+	versionID := str(body, "version.id")
+	if versionID == "" {
+		versionID = str(cluster, "version.id")
+	}
+	version, err := s.version(versionID, "")
+	if err != nil {
+		return nil, err
+	}
+	body["version"] = version
+	body["status"] = map[string]any{
+		"current_replicas": 0,
+		"message":          "WaitingForAvailableMachines: NodePool is creating",
+	}
+
+	object := s.add(collection+"/"+id, body)
+	s.scaleNodePool(object)
+	return body, nil
+}
+
+// hasNamed returns whether a collection holds an object with the given name.
+func (s *Server) hasNamed(collection, name string) bool {
+	for _, item := range s.items(collection) {
+		if item["name"] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// scaleNodePool makes the current replicas of a node pool match its desired replicas by the next step.
+func (s *Server) scaleNodePool(object *resource) {
+	desired := 0
+	if replicas, ok := object.body["replicas"].(float64); ok {
+		desired = int(replicas)
+	} else if replicas, ok := object.body["replicas"].(int); ok {
+		desired = replicas
+	}
+	if autoscaling, ok := object.body["autoscaling"].(map[string]any); ok {
+		if minReplica, ok := autoscaling["min_replica"].(float64); ok {
+			desired = int(minReplica)
+		}
+	}
+
+	status := child(object.body, "status")
+	if current, _ := status["current_replicas"].(int); current == desired && status["message"] == "" {
+		return
+	}
+	if status["message"] == "" {
+		status["message"] = "Scaling"
+	}
+	object.transitions = []func(){func() {
+		status["current_replicas"] = desired
+		status["message"] = ""
+	}}
+}
+
+// createUpgradePolicy schedules the upgrade of a cluster or node pool, which starts after a step and completes after
+// another one.
+func (s *Server) createUpgradePolicy(collection string, body map[string]any, dryRun bool) (map[string]any, *apiError) {
+	owner := s.resources[strings.TrimSuffix(strings.TrimSuffix(collection, "/upgrade_policies"), "/control_plane")]
+	clusterID := strings.Split(collection, "/")[2]
+	controlPlane := strings.HasSuffix(collection, "/control_plane/upgrade_policies")
+
+	version, err := s.version(ocm.VersionPrefix+str(body, "version"), str(owner.body, "version.channel_group"))
+	if err != nil {
+		return nil, err
+	}
+	if controlPlane {
+		if gates := s.missingGates(clusterID, str(version, "raw_id")); len(gates) > 0 {
+			return nil, &apiError{
+				status:  http.StatusBadRequest,
+				reason:  "There are missing version gate agreements for this cluster",
+				details: gates,
+			}
+		}
+	}
+	if dryRun {
+		return body, nil
+	}
+	if len(s.items(collection)) > 0 {
+		return nil, errorf(http.StatusBadRequest, "there is already a scheduled upgrade")
+	}
+
+	if controlPlane {
+		body["kind"] = "ControlPlaneUpgradePolicy"
+	} else {
+		body["kind"] = "NodePoolUpgradePolicy"
+	}
+	body["id"] = s.newID()
+	body["cluster_id"] = clusterID
+	body["state"] = map[string]any{"value": string(cmv1.UpgradePolicyStateValueScheduled)}
+
+	path := collection + "/" + str(body, "id")
+	s.add(path, body,
+		func() {
+			body["state"] = map[string]any{"value": string(cmv1.UpgradePolicyStateValueStarted)}
+		},
+		func() {
+			owner.body["version"] = version
+			s.remove(path)
+		},
+	)
+	return body, nil
+}
+
+// missingGates returns the version gates of a version which haven't been agreed for a cluster.
+func (s *Server) missingGates(clusterID, rawID string) []map[string]any {
+	agreed := map[string]bool{}
+	for _, agreement := range s.items(clusterPath(clusterID) + "/gate_agreements") {
+		agreed[str(agreement, "version_gate.id")] = true
+	}
+
+	var missing []map[string]any
+	for _, gate := range s.gates {
+		if strings.HasPrefix(rawID, str(gate, "version_raw_id_prefix")) && !agreed[str(gate, "id")] {
+			missing = append(missing, gate)
+		}
+	}
+	return missing
+}
+
+func (s *Server) listVersions(r *http.Request) (int, any, *apiError) {
+	var rawID, channelGroup string
+	for _, term := range searchTerm.FindAllStringSubmatch(r.URL.Query().Get("search"), -1) {
+		switch term[1] {
+		case "raw_id":
+			rawID = term[2]
+		case "channel_group":
+			channelGroup = term[2]
+		}
+	}
+
+	var versions []map[string]any
+	for _, v := range s.versions {
+		if rawID != "" && v.String() != rawID {
+			continue
+		}
+		version, _ := s.version(rosa.CreateVersionID(v.String(), channelGroup, ""), channelGroup)
+		versions = append(versions, version)
+	}
+	return list(r, "VersionList", versions)
+}
+
+func (s *Server) getVersion(id string) (int, any, *apiError) {
+	version, err := s.version(id, "")
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, version, nil
+}
+
+// version returns the version with the given ID. The channel group is taken from the ID, or from the given channel
+// group when the ID doesn't have any.
+func (s *Server) version(id, channelGroup string) (map[string]any, *apiError) {
+	rawID := ocm.GetRawVersionId(id)
+	if i := strings.LastIndex(strings.TrimPrefix(id, ocm.VersionPrefix), "-"); i > 0 {
+		channelGroup = strings.TrimPrefix(id, ocm.VersionPrefix)[i+1:]
+	}
+	if channelGroup == "" {
+		channelGroup = ocm.DefaultChannelGroup
+	}
+
+	parsed, err := semver.Parse(rawID)
+	if err != nil {
+		return nil, errorf(http.StatusNotFound, "version %q not found", id)
+	}
+	found := false
+	availableUpgrades := []string{}
+	for _, v := range s.versions {
+		found = found || v.EQ(parsed)
+		if v.GT(parsed) {
+			availableUpgrades = append(availableUpgrades, v.String())
+		}
+	}
+	if !found {
+		return nil, errorf(http.StatusNotFound, "version %q not found", id)
+	}
+
+	versionID := rosa.CreateVersionID(rawID, channelGroup, "")
+	return map[string]any{
+		"kind":                         "Version",
+		"id":                           versionID,
+		"href":                         apiPrefix + "/versions/" + versionID,
+		"raw_id":                       rawID,
+		"channel_group":                channelGroup,
+		"enabled":                      true,
+		"rosa_enabled":                 true,
+		"hosted_control_plane_enabled": true,
+		"available_upgrades":           availableUpgrades,
+		"available_channels":           []string{fmt.Sprintf("%s-%d.%d", channelGroup, parsed.Major, parsed.Minor)},
+	}, nil
+}
+
+// kubeconfig returns a kubeconfig for the API of a cluster.
+func (s *Server) kubeconfig(cluster map[string]any, username string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: %[2]s
+users:
+- name: %[3]s
+  user:
+    token: %[4]s
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[3]s
+current-context: %[1]s
+`, str(cluster, "name"), str(cluster, "api.url"), username, s.newID())
+}
+
+func setClusterState(cluster map[string]any, state cmv1.ClusterState, description string) {
+	cluster["state"] = string(state)
+	status := child(cluster, "status")
+	status["state"] = string(state)
+	status["description"] = description
+}
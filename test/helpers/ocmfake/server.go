@@ -0,0 +1,346 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocmfake provides an in-process fake of the OCM clusters management API. The fake holds the state of the
+// clusters, node pools, upgrade policies, identity providers, external auth providers, log forwarders and OIDC
+// configs created through it, and moves them through their lifecycle each time it is stepped, so that the ROSA controllers can be
+// tested against create, upgrade and delete flows without reaching OCM. It also serves the token endpoint and the
+// current account of the accounts management API, so that the connections of OCM service accounts can reach it.
+package ocmfake
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blang/semver"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ocmlogging "github.com/openshift-online/ocm-sdk-go/logging"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+)
+
+// DefaultVersions are the OpenShift versions offered by a server created without versions.
+var DefaultVersions = []string{"4.17.0", "4.17.1", "4.18.0", "4.18.1"}
+
+// Server is a fake OCM clusters management API served over HTTP.
+type Server struct {
+	server *httptest.Server
+	token  string
+
+	mu        sync.Mutex
+	resources map[string]*resource
+	versions  []semver.Version
+	gates     []map[string]any
+	sequence  int
+	// tokenRequests is the number of access tokens issued to service accounts.
+	tokenRequests int
+}
+
+// resource is an object of the API, stored as its JSON representation.
+type resource struct {
+	body     map[string]any
+	sequence int
+	// transitions are the changes applied to the object when the server is stepped, one per step.
+	transitions []func()
+}
+
+// NewServer starts a fake OCM API offering the given OpenShift versions, or DefaultVersions when none is given.
+func NewServer(versions ...string) (*Server, error) {
+	if len(versions) == 0 {
+		versions = DefaultVersions
+	}
+
+	s := &Server{
+		token:     accessToken(),
+		resources: map[string]*resource{},
+	}
+	for _, version := range versions {
+		v, err := semver.Parse(version)
+		if err != nil {
+			return nil, fmt.Errorf("parsing version %q: %w", version, err)
+		}
+		s.versions = append(s.versions, v)
+	}
+	semver.Sort(s.versions)
+
+	s.server = httptest.NewServer(s)
+	return s, nil
+}
+
+// accessToken returns an access token which doesn't expire. The token isn't signed, as OCM connections only parse
+// the tokens they are given.
+func accessToken() string {
+	encode := func(value map[string]any) string {
+		data, err := json.Marshal(value)
+		if err != nil {
+			panic(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	header := encode(map[string]any{"alg": "HS256", "typ": "JWT"})
+	claims := encode(map[string]any{
+		"iss": "https://sso.redhat.com/auth/realms/redhat-external",
+		"iat": time.Now().Unix(),
+		"typ": "Bearer",
+	})
+	return header + "." + claims + "." + base64.RawURLEncoding.EncodeToString([]byte("ocmfake"))
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Token returns an access token accepted by the server.
+func (s *Server) Token() string {
+	return s.token
+}
+
+// Connection returns a new OCM connection to the server.
+func (s *Server) Connection() (*sdk.Connection, error) {
+	logger, err := ocmlogging.NewGoLoggerBuilder().Debug(false).Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build logger: %w", err)
+	}
+
+	return sdk.NewConnectionBuilder().
+		Logger(logger).
+		Tokens(s.token).
+		URL(s.URL()).
+		Build()
+}
+
+// NewOCMClient returns an OCM client of the server. It can be used as the NewOCMClient function of the ROSA
+// reconcilers.
+func (s *Server) NewOCMClient(ctx context.Context, _ *scope.ROSAControlPlaneScope) (rosa.OCMClient, error) {
+	connection, err := s.Connection()
+	if err != nil {
+		return nil, err
+	}
+	return rosa.NewWrappedOCMClientFromOCMClient(ctx, ocm.NewClientWithConnection(connection))
+}
+
+// NewOCMClientWithoutControlPlane returns an OCM client of the server. It can be used as the NewOCMClient function of
+// the ROSA reconcilers which don't belong to a control plane, such as the ROSARoleConfig reconciler.
+func (s *Server) NewOCMClientWithoutControlPlane(ctx context.Context, _ rosa.OCMSecretsRetriever) (rosa.OCMClient, error) {
+	connection, err := s.Connection()
+	if err != nil {
+		return nil, err
+	}
+	return rosa.NewWrappedOCMClientFromOCMClient(ctx, ocm.NewClientWithConnection(connection))
+}
+
+// CredentialsSecret returns a secret holding the credentials of the server, which can be referenced by the
+// credentialsSecretRef of a ROSAControlPlane so that the connections built by the controllers reach the server.
+func (s *Server) CredentialsSecret(name, namespace string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"ocmToken":  []byte(s.token),
+			"ocmApiUrl": []byte(s.URL()),
+		},
+	}
+}
+
+// Step moves every object of the server one step further in its lifecycle.
+func (s *Server) Step() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.step()
+}
+
+// Settle steps the server until every object has completed its lifecycle.
+func (s *Server) Settle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stepped := true
+	for stepped {
+		stepped = s.step()
+	}
+}
+
+// StepEvery steps the server at the given interval until the context is done, letting objects progress while
+// controllers run against the server.
+func (s *Server) StepEvery(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Step()
+			}
+		}
+	}()
+}
+
+// AddVersionGate adds a version gate which has to be acknowledged before upgrading a cluster to a version starting
+// with the raw ID prefix of the gate.
+func (s *Server) AddVersionGate(id, versionRawIDPrefix, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.gates = append(s.gates, map[string]any{
+		"kind":                  "VersionGate",
+		"id":                    id,
+		"href":                  apiPrefix + "/version_gates/" + id,
+		"version_raw_id_prefix": versionRawIDPrefix,
+		"description":           description,
+		"label":                 "api.openshift.com/gate-ocp",
+		"value":                 versionRawIDPrefix,
+	})
+}
+
+// FailCluster moves a cluster to the error state with the given provision error.
+func (s *Server) FailCluster(clusterID, code, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cluster, ok := s.resources[clusterPath(clusterID)]
+	if !ok {
+		return fmt.Errorf("cluster %q not found", clusterID)
+	}
+	cluster.transitions = nil
+	setClusterState(cluster.body, cmv1.ClusterStateError, message)
+	status := cluster.body["status"].(map[string]any)
+	status["provision_error_code"] = code
+	status["provision_error_message"] = message
+	return nil
+}
+
+// Cluster returns the cluster with the given ID, or nil when it doesn't exist.
+func (s *Server) Cluster(clusterID string) *cmv1.Cluster {
+	var cluster *cmv1.Cluster
+	s.unmarshal(clusterPath(clusterID), func(data []byte) (err error) {
+		cluster, err = cmv1.UnmarshalCluster(data)
+		return err
+	})
+	return cluster
+}
+
+// NodePool returns the node pool with the given ID, or nil when it doesn't exist.
+func (s *Server) NodePool(clusterID, nodePoolID string) *cmv1.NodePool {
+	var nodePool *cmv1.NodePool
+	s.unmarshal(clusterPath(clusterID)+"/node_pools/"+nodePoolID, func(data []byte) (err error) {
+		nodePool, err = cmv1.UnmarshalNodePool(data)
+		return err
+	})
+	return nodePool
+}
+
+// OidcConfig returns the OIDC config with the given ID, or nil when it doesn't exist.
+func (s *Server) OidcConfig(id string) *cmv1.OidcConfig {
+	var oidcConfig *cmv1.OidcConfig
+	s.unmarshal("/oidc_configs/"+id, func(data []byte) (err error) {
+		oidcConfig, err = cmv1.UnmarshalOidcConfig(data)
+		return err
+	})
+	return oidcConfig
+}
+
+func (s *Server) unmarshal(path string, unmarshal func([]byte) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.resources[path]
+	if !ok {
+		return
+	}
+	data, err := json.Marshal(r.body)
+	if err != nil {
+		panic(err)
+	}
+	if err := unmarshal(data); err != nil {
+		panic(err)
+	}
+}
+
+// step applies the next transition of every object, and returns whether any transition was applied.
+func (s *Server) step() bool {
+	paths := make([]string, 0, len(s.resources))
+	for path := range s.resources {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool { return s.resources[paths[i]].sequence < s.resources[paths[j]].sequence })
+
+	stepped := false
+	for _, path := range paths {
+		// Objects may have been removed by the transitions of other objects.
+		r, ok := s.resources[path]
+		if !ok || len(r.transitions) == 0 {
+			continue
+		}
+		transition := r.transitions[0]
+		r.transitions = r.transitions[1:]
+		transition()
+		stepped = true
+	}
+	return stepped
+}
+
+// add stores a new object at the given path.
+func (s *Server) add(path string, body map[string]any, transitions ...func()) *resource {
+	s.sequence++
+	body["href"] = apiPrefix + path
+	r := &resource{
+		body:        body,
+		sequence:    s.sequence,
+		transitions: transitions,
+	}
+	s.resources[path] = r
+	return r
+}
+
+// remove removes the object at the given path and the objects it contains.
+func (s *Server) remove(path string) {
+	for p := range s.resources {
+		if p == path || strings.HasPrefix(p, path+"/") {
+			delete(s.resources, p)
+		}
+	}
+}
+
+// newID returns a new object ID in the format used by OCM.
+func (s *Server) newID() string {
+	s.sequence++
+	return fmt.Sprintf("%032x", s.sequence)
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocmfake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rosacontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/rosa/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/rosa"
+)
+
+func setupServer(t *testing.T) (*Server, rosa.OCMClient) {
+	t.Helper()
+	g := NewWithT(t)
+
+	server, err := NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	t.Cleanup(server.Close)
+
+	ocmClient, err := server.NewOCMClient(context.TODO(), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	return server, ocmClient
+}
+
+func clusterSpec(name string) ocm.Spec {
+	return ocm.Spec{
+		DryRun:         ptr.To(false),
+		Name:           name,
+		Region:         "us-east-1",
+		Version:        "openshift-v4.17.0",
+		IsSTS:          true,
+		RoleARN:        "arn:aws:iam::123456789012:role/installer",
+		SupportRoleARN: "arn:aws:iam::123456789012:role/support",
+		WorkerRoleARN:  "arn:aws:iam::123456789012:role/worker",
+		Hypershift:     ocm.Hypershift{Enabled: true},
+		AWSCreator: &rosaaws.Creator{
+			ARN:       "arn:aws:iam::123456789012:user/test",
+			AccountID: "123456789012",
+		},
+	}
+}
+
+func createCluster(g *WithT, server *Server, ocmClient rosa.OCMClient) *cmv1.Cluster {
+	cluster, err := ocmClient.CreateCluster(clusterSpec("test-cluster"))
+	g.Expect(err).ToNot(HaveOccurred())
+	server.Settle()
+	return server.Cluster(cluster.ID())
+}
+
+func TestClusterLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	server, ocmClient := setupServer(t)
+
+	cluster, err := ocmClient.CreateCluster(clusterSpec("test-cluster"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.Status().State()).To(Equal(cmv1.ClusterStatePending))
+	g.Expect(rosa.RawVersionID(cluster.Version())).To(Equal("4.17.0"))
+	g.Expect(cluster.Version().AvailableUpgrades()).To(Equal([]string{"4.17.1", "4.18.0", "4.18.1"}))
+
+	_, err = ocmClient.CreateCluster(clusterSpec("test-cluster"))
+	g.Expect(err).To(MatchError(ContainSubstring("already exists")))
+
+	server.Step()
+	cluster, err = ocmClient.GetCluster("test-cluster", nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.Status().State()).To(Equal(cmv1.ClusterStateInstalling))
+
+	server.Step()
+	cluster, err = ocmClient.GetCluster(cluster.ID(), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.Status().State()).To(Equal(cmv1.ClusterStateReady))
+	g.Expect(cluster.API().URL()).To(HavePrefix("https://api.test-cluster."))
+
+	g.Expect(ocmClient.UpdateClusterDeletionProtection(cluster.ID(), true)).To(Succeed())
+	_, err = ocmClient.DeleteCluster(cluster.ID(), false, nil)
+	g.Expect(err).To(MatchError(ContainSubstring("delete protection")))
+
+	g.Expect(ocmClient.UpdateClusterDeletionProtection(cluster.ID(), false)).To(Succeed())
+	_, err = ocmClient.DeleteCluster(cluster.ID(), false, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(server.Cluster(cluster.ID()).Status().State()).To(Equal(cmv1.ClusterStateUninstalling))
+
+	server.Step()
+	_, err = ocmClient.GetCluster(cluster.ID(), nil)
+	g.Expect(err).To(MatchError(ContainSubstring("There is no cluster")))
+}
+
+func TestFailCluster(t *testing.T) {
+	g := NewWithT(t)
+	server, ocmClient := setupServer(t)
+
+	cluster, err := ocmClient.CreateCluster(clusterSpec("test-cluster"))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(server.FailCluster(cluster.ID(), "OCM3055", "failed to install")).To(Succeed())
+	server.Settle()
+
+	cluster, err = ocmClient.GetCluster(cluster.ID(), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cluster.Status().State()).To(Equal(cmv1.ClusterStateError))
+	g.Expect(cluster.Status().ProvisionErrorCode()).To(Equal("OCM3055"))
+}
+
+func TestControlPlaneUpgrade(t *testing.T) {
+	g := NewWithT(t)
+	server, ocmClient := setupServer(t)
+	cluster := createCluster(g, server, ocmClient)
+
+	server.AddVersionGate("gate-4.18", "4.18", "Kubernetes 1.31 removes deprecated APIs")
+
+	_, err := rosa.ScheduleControlPlaneUpgrade(ocmClient, cluster, "4.19.0", time.Now(), true)
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+	_, err = rosa.ScheduleControlPlaneUpgrade(ocmClient, cluster, "4.18.0", time.Now(), false)
+	g.Expect(err).To(MatchError(ContainSubstring("version gate acknowledgment required")))
+
+	policy, err := rosa.ScheduleControlPlaneUpgrade(ocmClient, cluster, "4.18.0", time.Now(), true)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy.State().Value()).To(Equal(cmv1.UpgradePolicyStateValueScheduled))
+
+	_, err = rosa.ScheduleControlPlaneUpgrade(ocmClient, cluster, "4.18.0", time.Now(), true)
+	g.Expect(err).To(MatchError(ContainSubstring("already a scheduled upgrade")))
+
+	server.Step()
+	policy, err = rosa.CheckExistingScheduledUpgrade(ocmClient, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy.State().Value()).To(Equal(cmv1.UpgradePolicyStateValueStarted))
+
+	server.Step()
+	policy, err = rosa.CheckExistingScheduledUpgrade(ocmClient, cluster)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(BeNil())
+
+	cluster, err = ocmClient.GetCluster(cluster.ID(), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rosa.RawVersionID(cluster.Version())).To(Equal("4.18.0"))
+	g.Expect(cluster.Version().AvailableUpgrades()).To(Equal([]string{"4.18.1"}))
+
+	channels, err := ocmClient.GetAvailableChannels(cluster.Version().ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(channels).To(Equal([]string{"stable-4.18"}))
+
+	valid, err := ocmClient.ValidateHypershiftVersion("4.18.1", "stable")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(valid).To(BeTrue())
+	_, err = ocmClient.ValidateHypershiftVersion("4.19.0", "stable")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestNodePoolLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	server, ocmClient := setupServer(t)
+	cluster := createCluster(g, server, ocmClient)
+
+	nodePool, err := cmv1.NewNodePool().ID("workers").Replicas(2).Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	nodePool, err = ocmClient.CreateNodePool(cluster.ID(), nodePool)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rosa.IsNodePoolReady(nodePool)).To(BeFalse())
+	g.Expect(rosa.RawVersionID(nodePool.Version())).To(Equal("4.17.0"))
+
+	server.Step()
+	nodePool, exists, err := ocmClient.GetNodePool(cluster.ID(), "workers")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+	g.Expect(rosa.IsNodePoolReady(nodePool)).To(BeTrue())
+
+	update, err := cmv1.NewNodePool().ID("workers").Replicas(3).Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	nodePool, err = ocmClient.UpdateNodePool(cluster.ID(), update)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rosa.IsNodePoolReady(nodePool)).To(BeFalse())
+	server.Step()
+	g.Expect(server.NodePool(cluster.ID(), "workers").Status().CurrentReplicas()).To(Equal(3))
+
+	_, err = rosa.ScheduleNodePoolUpgrade(ocmClient, cluster.ID(), nodePool, "4.17.1", time.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	_, policy, err := ocmClient.GetHypershiftNodePoolUpgrade(cluster.ID(), cluster.ID(), "workers")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy.Version()).To(Equal("4.17.1"))
+
+	server.Settle()
+	nodePool, policy, err = ocmClient.GetHypershiftNodePoolUpgrade(cluster.ID(), cluster.ID(), "workers")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(BeNil())
+	g.Expect(rosa.RawVersionID(nodePool.Version())).To(Equal("4.17.1"))
+
+	g.Expect(ocmClient.DeleteNodePool(cluster.ID(), "workers")).To(Succeed())
+	server.Step()
+	_, exists, err = ocmClient.GetNodePool(cluster.ID(), "workers")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
+
+func TestIdentityProvidersAndLogForwarders(t *testing.T) {
+	g := NewWithT(t)
+	server, ocmClient := setupServer(t)
+	cluster := createCluster(g, server, ocmClient)
+
+	idp, err := cmv1.NewIdentityProvider().
+		Name("htpasswd").
+		Type(cmv1.IdentityProviderTypeHtpasswd).
+		Htpasswd(cmv1.NewHTPasswdIdentityProvider().Users(cmv1.NewHTPasswdUserList().Items(
+			cmv1.NewHTPasswdUser().Username("admin").Password("password"),
+		))).
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	idp, err = ocmClient.CreateIdentityProvider(cluster.ID(), idp)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ocmClient.AddHTPasswdUser("developer", "password", cluster.ID(), idp.ID())).To(Succeed())
+
+	users, err := ocmClient.GetHTPasswdUserList(cluster.ID(), idp.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(users.Len()).To(Equal(1))
+	g.Expect(users.Get(0).Username()).To(Equal("developer"))
+
+	user, err := cmv1.NewUser().ID("developer").Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	user, err = ocmClient.CreateUser(cluster.ID(), "cluster-admins", user)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(user.ID()).To(Equal("developer"))
+	user, err = ocmClient.GetUser(cluster.ID(), "cluster-admins", "developer")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(user).ToNot(BeNil())
+	g.Expect(ocmClient.DeleteUser(cluster.ID(), "cluster-admins", "developer")).To(Succeed())
+	user, err = ocmClient.GetUser(cluster.ID(), "cluster-admins", "developer")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(user).To(BeNil())
+
+	idps, err := ocmClient.GetIdentityProviders(cluster.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(idps).To(HaveLen(1))
+	g.Expect(idps[0].Name()).To(Equal("htpasswd"))
+
+	logForwarder, err := cmv1.NewLogForwarder().
+		Applications("audit-webhook").
+		Cloudwatch(cmv1.NewLogForwarderCloudWatchConfig().LogGroupName("audit")).
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	logForwarder, err = ocmClient.SetLogForwarder(cluster.ID(), logForwarder)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	update, err := cmv1.NewLogForwarder().Cloudwatch(cmv1.NewLogForwarderCloudWatchConfig().LogGroupName("audit-logs")).Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ocmClient.UpdateLogForwarder(update, logForwarder.ID(), cluster.ID())).To(Succeed())
+
+	logForwarders, err := ocmClient.GetLogForwarders(cluster.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(logForwarders).To(HaveLen(1))
+	g.Expect(logForwarders[0].Cloudwatch().LogGroupName()).To(Equal("audit-logs"))
+	g.Expect(logForwarders[0].Applications()).To(Equal([]string{"audit-webhook"}))
+
+	g.Expect(ocmClient.DeleteLogForwarder(cluster.ID(), logForwarder.ID())).To(Succeed())
+	logForwarders, err = ocmClient.GetLogForwarders(cluster.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(logForwarders).To(BeEmpty())
+}
+
+func TestExternalAuth(t *testing.T) {
+	g := NewWithT(t)
+	server, ocmClient := setupServer(t)
+	cluster := createCluster(g, server, ocmClient)
+
+	// The external auth client connects with the credentials of the control plane.
+	secret := server.CredentialsSecret("rosa-creds-secret", "default")
+	rosaScope := &scope.ROSAControlPlaneScope{
+		Client: fake.NewClientBuilder().WithObjects(secret).Build(),
+		ControlPlane: &rosacontrolplanev1.ROSAControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "default"},
+			Spec: rosacontrolplanev1.RosaControlPlaneSpec{
+				CredentialsSecretRef: &corev1.LocalObjectReference{Name: secret.Name},
+			},
+		},
+		Logger: *logger.NewLogger(klog.Background()),
+	}
+	externalAuthClient, err := rosa.NewExternalAuthClient(context.TODO(), rosaScope)
+	g.Expect(err).ToNot(HaveOccurred())
+	defer externalAuthClient.Close()
+
+	externalAuth, err := cmv1.NewExternalAuth().
+		ID("oidc").
+		Issuer(cmv1.NewTokenIssuer().URL("https://issuer.example.com").Audiences("audience")).
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = externalAuthClient.CreateExternalAuth(cluster.ID(), externalAuth)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	externalAuths, err := externalAuthClient.ListExternalAuths(cluster.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(externalAuths).To(HaveLen(1))
+	g.Expect(externalAuths[0].Issuer().URL()).To(Equal("https://issuer.example.com"))
+
+	breakGlassCredential, err := cmv1.NewBreakGlassCredential().Username("admin").Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	credential, err := externalAuthClient.CreateBreakGlassCredential(cluster.ID(), breakGlassCredential)
+	g.Expect(err).ToNot(HaveOccurred())
+	kubeconfig, err := externalAuthClient.PollKubeconfig(context.TODO(), cluster.ID(), credential.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(kubeconfig).To(ContainSubstring(cluster.API().URL()))
+
+	g.Expect(externalAuthClient.DeleteExternalAuth(cluster.ID(), "oidc")).To(Succeed())
+	_, exists, err := externalAuthClient.GetExternalAuth(cluster.ID(), "oidc")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
+
+func TestOidcConfigs(t *testing.T) {
+	g := NewWithT(t)
+	server, err := NewServer()
+	g.Expect(err).ToNot(HaveOccurred())
+	defer server.Close()
+
+	// The clients of the reconcilers which don't belong to a control plane reach the same server.
+	wrapped, err := server.NewOCMClientWithoutControlPlane(context.TODO(), nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	ocmClient, err := rosa.ConvertToRosaOcmClient(wrapped)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	managed, err := cmv1.NewOidcConfig().Managed(true).Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	created, err := ocmClient.CreateOidcConfig(managed)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created.ID()).ToNot(BeEmpty())
+	g.Expect(created.IssuerUrl()).To(HavePrefix(server.URL()))
+
+	oidcConfig, err := ocmClient.GetOidcConfig(created.ID())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(oidcConfig.IssuerUrl()).To(Equal(created.IssuerUrl()))
+	g.Expect(server.OidcConfig(created.ID())).ToNot(BeNil())
+
+	g.Expect(ocmClient.DeleteOidcConfig(created.ID())).To(Succeed())
+	g.Expect(server.OidcConfig(created.ID())).To(BeNil())
+}
@@ -27,8 +27,9 @@ import (
 // ErrorConditionAfterInit returns severity error, if the control plane is initialized; otherwise, returns severity warning.
 // Failures after control plane is initialized is likely to be non-transient,
 // hence conditions severities should be set to Error.
+// Objects that aren't tied to a cluster pass a nil cluster, and get severity warning.
 func ErrorConditionAfterInit(cluster *clusterv1.Cluster) clusterv1beta1.ConditionSeverity {
-	if ptr.Deref(cluster.Status.Initialization.ControlPlaneInitialized, false) {
+	if cluster != nil && ptr.Deref(cluster.Status.Initialization.ControlPlaneInitialized, false) {
 		return clusterv1beta1.ConditionSeverityError
 	}
 	return clusterv1beta1.ConditionSeverityWarning
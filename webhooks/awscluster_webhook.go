@@ -73,6 +73,7 @@ func (w *AWSCluster) ValidateCreate(_ context.Context, obj runtime.Object) (admi
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
 	allErrs = append(allErrs, w.validateNetwork(r)...)
+	allErrs = append(allErrs, w.validateKarpenter(r)...)
 
 	warnings, errs := w.validateControlPlaneLBs(r)
 	if len(errs) > 0 {
@@ -161,6 +162,14 @@ func (w *AWSCluster) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Ob
 		)
 	}
 
+	// The cluster KMS key may be in use by volumes and secrets, do not allow removal of it.
+	if oldC.Spec.KMSKey != nil && r.Spec.KMSKey == nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "kmsKey"),
+				r.Spec.KMSKey, "field cannot be set to nil"),
+		)
+	}
+
 	if annotations.IsExternallyManaged(oldC) && !annotations.IsExternallyManaged(r) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("metadata", "annotations"),
@@ -171,6 +180,7 @@ func (w *AWSCluster) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Ob
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, w.validateKarpenter(r)...)
 
 	if r.Spec.ControlPlaneLoadBalancer != nil {
 		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == infrav1.LoadBalancerTypeClassic {
@@ -316,6 +326,20 @@ func (w *AWSCluster) validateSSHKeyName(r *infrav1.AWSCluster) field.ErrorList {
 	return validateSSHKeyName(r.Spec.SSHKeyName)
 }
 
+// validateKarpenter validates the Karpenter configuration. Without EKS Pod Identity, the controller role can only
+// be assumed by the principals it trusts.
+func (w *AWSCluster) validateKarpenter(r *infrav1.AWSCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.Karpenter != nil && len(r.Spec.Karpenter.TrustedPrincipalARNs) == 0 {
+		allErrs = append(allErrs,
+			field.Required(field.NewPath("spec", "karpenter", "trustedPrincipalARNs"), "trustedPrincipalARNs is required to run Karpenter in a cluster not managed by EKS"),
+		)
+	}
+
+	return allErrs
+}
+
 func (w *AWSCluster) validateNetwork(r *infrav1.AWSCluster) field.ErrorList {
 	var allErrs field.ErrorList
 
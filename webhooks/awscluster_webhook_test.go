@@ -1159,6 +1159,29 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Karpenter with trusted principals is accepted",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Karpenter: &infrav1.KarpenterSpec{
+						NodeRole:             "karpenter-node",
+						TrustedPrincipalARNs: []string{"arn:aws:iam::123456789012:role/management"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Karpenter without trusted principals is rejected",
+			cluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					Karpenter: &infrav1.KarpenterSpec{
+						NodeRole: "karpenter-node",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1443,6 +1466,28 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "KMS key cannot be removed once set",
+			oldCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					KMSKey: &infrav1.KMSKeySpec{NodeRoles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}},
+				},
+			},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "KMS key can be added",
+			oldCluster: &infrav1.AWSCluster{},
+			newCluster: &infrav1.AWSCluster{
+				Spec: infrav1.AWSClusterSpec{
+					KMSKey: &infrav1.KMSKeySpec{NodeRoles: []string{"nodes.cluster-api-provider-aws.sigs.k8s.io"}},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid keys are not accepted during update",
 			oldCluster: &infrav1.AWSCluster{
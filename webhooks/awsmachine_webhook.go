@@ -82,9 +82,12 @@ func (w *AWSMachine) ValidateCreate(_ context.Context, obj runtime.Object) (admi
 	allErrs = append(allErrs, w.validateAdditionalSecurityGroups(r)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validateNetworkElasticIPPool(r)...)
+	allErrs = append(allErrs, w.validateElasticIPClaim(r)...)
 	allErrs = append(allErrs, w.validateInstanceMarketType(r)...)
 	allErrs = append(allErrs, w.validateCapacityReservation(r)...)
 	allErrs = append(allErrs, w.validateHostAllocation(r)...)
+	allErrs = append(allErrs, w.validateAdditionalNetworkInterfaces(r)...)
+	allErrs = append(allErrs, w.validateRolesAnywhere(r)...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -392,6 +395,36 @@ func (w *AWSMachine) validateNetworkElasticIPPool(r *infrav1.AWSMachine) field.E
 	return allErrs
 }
 
+func (w *AWSMachine) validateElasticIPClaim(r *infrav1.AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.ElasticIPClaim == nil {
+		return allErrs
+	}
+	if !ptr.Deref(r.Spec.PublicIP, false) {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec.elasticIpClaim"), "publicIp must be set to 'true' to associate an Elastic IP claim with elasticIpClaim"))
+	}
+
+	return allErrs
+}
+
+func (w *AWSMachine) validateRolesAnywhere(r *infrav1.AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.RolesAnywhere == nil {
+		return allErrs
+	}
+	// The private key of the node certificate is part of the bootstrap data, which must not be readable from the instance metadata.
+	if r.Spec.CloudInit.InsecureSkipSecretsManager {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "rolesAnywhere"), "rolesAnywhere cannot be used when cloudInit.insecureSkipSecretsManager is set"))
+	}
+	if r.Spec.Ignition != nil && r.Spec.Ignition.StorageType == infrav1.IgnitionStorageTypeOptionUnencryptedUserData {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "rolesAnywhere"), "rolesAnywhere cannot be used when ignition.storageType is UnencryptedUserData"))
+	}
+
+	return allErrs
+}
+
 func (w *AWSMachine) validateCapacityReservation(r *infrav1.AWSMachine) field.ErrorList {
 	var allErrs field.ErrorList
 	if r.Spec.CapacityReservationID != nil && r.Spec.CapacityReservationPreference != infrav1.CapacityReservationPreferenceOnly && r.Spec.CapacityReservationPreference != "" {
@@ -485,6 +518,20 @@ func (w *AWSMachine) validateAdditionalSecurityGroups(r *infrav1.AWSMachine) fie
 	return allErrs
 }
 
+func (w *AWSMachine) validateAdditionalNetworkInterfaces(r *infrav1.AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(r.Spec.AdditionalNetworkInterfaces) == 0 {
+		return allErrs
+	}
+	if ptr.Deref(r.Spec.PublicIP, false) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "publicIP"), "a public IP cannot be assigned on launch when additionalNetworkInterfaces are specified"))
+	}
+	allErrs = append(allErrs, infrav1.ValidateNetworkInterfaceSpecs(field.NewPath("spec", "additionalNetworkInterfaces"), r.Spec.AdditionalNetworkInterfaces, len(r.Spec.NetworkInterfaces))...)
+
+	return allErrs
+}
+
 func (w *AWSMachine) validateHostAllocation(r *infrav1.AWSMachine) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -722,6 +722,116 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Elastic IP claim with public IP set is accepted",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:   "type",
+					PublicIP:       aws.Bool(true),
+					ElasticIPClaim: &infrav1.ElasticIPClaim{Name: "sftp-gateway", Slots: 3},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error when Elastic IP claim with non-public IP set",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:   "type",
+					ElasticIPClaim: &infrav1.ElasticIPClaim{Name: "sftp-gateway"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IAM Roles Anywhere with the secrets manager is accepted",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:  "type",
+					RolesAnywhere: &infrav1.MachineRolesAnywhere{Role: "nodes"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error when IAM Roles Anywhere with insecureSkipSecretsManager",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:  "type",
+					CloudInit:     infrav1.CloudInit{InsecureSkipSecretsManager: true},
+					RolesAnywhere: &infrav1.MachineRolesAnywhere{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error when IAM Roles Anywhere with unencrypted ignition user data",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:  "type",
+					Ignition:      &infrav1.Ignition{Version: "3.4", StorageType: infrav1.IgnitionStorageTypeOptionUnencryptedUserData},
+					RolesAnywhere: &infrav1.MachineRolesAnywhere{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interfaces with unique device indexes are accepted",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "type",
+					AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+						{DeviceIndex: 1, Subnet: &infrav1.AWSResourceReference{ID: aws.String("subnet-storage")}},
+						{DeviceIndex: 2, SecondaryPrivateIPAddressCount: ptr.To[int32](2)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional network interfaces cannot use a device index taken by pre-existing network interfaces",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType:      "type",
+					NetworkInterfaces: []string{"eni-1", "eni-2"},
+					AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interfaces cannot be combined with a public IP",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "type",
+					PublicIP:     aws.Bool(true),
+					AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+						{DeviceIndex: 1},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additional network interface subnet cannot have both ID and filters",
+			machine: &infrav1.AWSMachine{
+				Spec: infrav1.AWSMachineSpec{
+					InstanceType: "type",
+					AdditionalNetworkInterfaces: []infrav1.NetworkInterfaceSpec{
+						{
+							DeviceIndex: 1,
+							Subnet: &infrav1.AWSResourceReference{
+								ID:      aws.String("subnet-storage"),
+								Filters: []infrav1.Filter{{Name: "tag:Name", Values: []string{"storage"}}},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -24,6 +24,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -116,6 +117,56 @@ func (w *AWSMachineTemplate) validateAdditionalSecurityGroups(r *infrav1.AWSMach
 	return allErrs
 }
 
+func (w *AWSMachineTemplate) validateAdditionalNetworkInterfaces(r *infrav1.AWSMachineTemplate) field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.Template.Spec
+
+	if len(spec.AdditionalNetworkInterfaces) == 0 {
+		return allErrs
+	}
+	if ptr.Deref(spec.PublicIP, false) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "publicIP"), "a public IP cannot be assigned on launch when additionalNetworkInterfaces are specified"))
+	}
+	allErrs = append(allErrs, infrav1.ValidateNetworkInterfaceSpecs(field.NewPath("spec", "template", "spec", "additionalNetworkInterfaces"), spec.AdditionalNetworkInterfaces, len(spec.NetworkInterfaces))...)
+
+	return allErrs
+}
+
+func (w *AWSMachineTemplate) validateElasticIPClaim(r *infrav1.AWSMachineTemplate) field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.Template.Spec
+
+	if spec.ElasticIPClaim == nil {
+		return allErrs
+	}
+	if !ptr.Deref(spec.PublicIP, false) {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "template", "spec", "elasticIpClaim"), "publicIp must be set to 'true' to associate an Elastic IP claim with elasticIpClaim"))
+	}
+
+	return allErrs
+}
+
+func (w *AWSMachineTemplate) validateRolesAnywhere(r *infrav1.AWSMachineTemplate) field.ErrorList {
+	var allErrs field.ErrorList
+
+	spec := r.Spec.Template.Spec
+
+	if spec.RolesAnywhere == nil {
+		return allErrs
+	}
+	// The private key of the node certificate is part of the bootstrap data, which must not be readable from the instance metadata.
+	if spec.CloudInit.InsecureSkipSecretsManager {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "rolesAnywhere"), "rolesAnywhere cannot be used when cloudInit.insecureSkipSecretsManager is set"))
+	}
+	if spec.Ignition != nil && spec.Ignition.StorageType == infrav1.IgnitionStorageTypeOptionUnencryptedUserData {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "rolesAnywhere"), "rolesAnywhere cannot be used when ignition.storageType is UnencryptedUserData"))
+	}
+
+	return allErrs
+}
+
 func (w *AWSMachineTemplate) validateCloudInitSecret(r *infrav1.AWSMachineTemplate) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -245,6 +296,9 @@ func (w *AWSMachineTemplate) ValidateCreate(_ context.Context, raw runtime.Objec
 	allErrs = append(allErrs, w.validateAdditionalSecurityGroups(obj)...)
 	allErrs = append(allErrs, obj.Spec.Template.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, w.validateHostAllocation(obj)...)
+	allErrs = append(allErrs, w.validateAdditionalNetworkInterfaces(obj)...)
+	allErrs = append(allErrs, w.validateElasticIPClaim(obj)...)
+	allErrs = append(allErrs, w.validateRolesAnywhere(obj)...)
 
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
 }
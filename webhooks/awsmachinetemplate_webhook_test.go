@@ -221,6 +221,84 @@ func TestAWSMachineTemplateValidateCreate(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name: "elasticIpClaim requires publicIp",
+			inputTemplate: &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType:   "test",
+							ElasticIPClaim: &infrav1.ElasticIPClaim{Name: "sftp-gateway"},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "elasticIpClaim with publicIp is valid",
+			inputTemplate: &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType:   "test",
+							PublicIP:       ptr.To(true),
+							ElasticIPClaim: &infrav1.ElasticIPClaim{Name: "sftp-gateway", Slots: 3},
+						},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "IAM Roles Anywhere with the secrets manager is valid",
+			inputTemplate: &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType:  "test",
+							RolesAnywhere: &infrav1.MachineRolesAnywhere{Role: "nodes"},
+						},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "IAM Roles Anywhere with insecureSkipSecretsManager is invalid",
+			inputTemplate: &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType:  "test",
+							CloudInit:     infrav1.CloudInit{InsecureSkipSecretsManager: true},
+							RolesAnywhere: &infrav1.MachineRolesAnywhere{},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "IAM Roles Anywhere with unencrypted ignition user data is invalid",
+			inputTemplate: &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType:  "test",
+							Ignition:      &infrav1.Ignition{Version: "3.4", StorageType: infrav1.IgnitionStorageTypeOptionUnencryptedUserData},
+							RolesAnywhere: &infrav1.MachineRolesAnywhere{},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {